@@ -1,9 +1,14 @@
 package main
 
 import (
+	"archive/zip"
 	"context"
+	"fmt"
 	"os"
+	"strings"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/digest"
@@ -70,7 +75,8 @@ func main() {
 		nestedReplicator := replication.NewNestedBlobReplicator(
 			replicator,
 			sink.DigestKeyFormat,
-			int(configuration.MaximumMessageSizeBytes))
+			int(configuration.MaximumMessageSizeBytes),
+			configuration.TraversalMaximumInFlightBytes)
 
 		instanceName, err := digest.NewInstanceName(configuration.InstanceName)
 		if err != nil {
@@ -81,36 +87,29 @@ func main() {
 			return util.StatusWrap(err, "Invalid digest function")
 		}
 
-		// Enqueue objects for replication.
-		for i, action := range configuration.Actions {
-			actionDigest, err := digestFunction.NewDigestFromProto(action)
-			if err != nil {
-				return util.StatusWrapf(err, "Invalid action digest at index %d", i)
-			}
-			nestedReplicator.EnqueueAction(actionDigest)
-		}
-		for i, blob := range configuration.Blobs {
-			blobDigest, err := digestFunction.NewDigestFromProto(blob)
-			if err != nil {
-				return util.StatusWrapf(err, "Invalid blob digest at index %d", i)
-			}
-			if err := replicator.ReplicateMultiple(ctx, blobDigest.ToSingletonSet()); err != nil {
-				return util.StatusWrapf(err, "Failed to schedule replication of blob with digest %#v", blobDigest.String())
-			}
+		digests, err := getConfiguredDigests(&configuration, digestFunction)
+		if err != nil {
+			return err
 		}
-		for i, directory := range configuration.Directories {
-			directoryDigest, err := digestFunction.NewDigestFromProto(directory)
-			if err != nil {
-				return util.StatusWrapf(err, "Invalid directory digest at index %d", i)
-			}
-			nestedReplicator.EnqueueDirectory(directoryDigest)
+
+		// Enqueue objects for traversal.
+		nestedReplicator.EnqueueActions(digests.actions)
+		nestedReplicator.EnqueueDirectories(digests.directories)
+		nestedReplicator.EnqueueTrees(digests.trees)
+
+		if err := replication.ReplicateConcurrently(
+			ctx,
+			replicator,
+			digests.blobs,
+			max(int(configuration.BlobsConcurrency), 1),
+			util.DefaultErrorLogger,
+		); err != nil {
+			return err
 		}
-		for i, tree := range configuration.Trees {
-			treeDigest, err := digestFunction.NewDigestFromProto(tree)
-			if err != nil {
-				return util.StatusWrapf(err, "Invalid tree digest at index %d", i)
+		for i, zipArchive := range configuration.ZipArchives {
+			if err := replicateZIPArchive(ctx, replicator, zipArchive, instanceName); err != nil {
+				return util.StatusWrapf(err, "Failed to replicate contents of ZIP archive at index %d", i)
 			}
-			nestedReplicator.EnqueueTree(treeDigest)
 		}
 
 		// Perform replication of nested objects.
@@ -122,3 +121,70 @@ func main() {
 		return nil
 	})
 }
+
+// configuredDigests contains the digests of the objects listed in the
+// configuration file, grouped by the way they need to be replicated.
+type configuredDigests struct {
+	actions     []digest.Digest
+	blobs       []digest.Digest
+	directories []digest.Digest
+	trees       []digest.Digest
+}
+
+// getConfiguredDigests converts all digests listed in the configuration
+// file. All digests are validated up front, so that all invalid digests
+// are reported at once, as opposed to only the first one.
+func getConfiguredDigests(configuration *bb_copy.ApplicationConfiguration, digestFunction digest.Function) (configuredDigests, error) {
+	var invalidDigests []string
+	newDigestsFromProtos := func(digests []*remoteexecution.Digest, kind string) []digest.Digest {
+		newDigests, err := digestFunction.NewDigestsFromProtos(digests)
+		if err != nil {
+			invalidDigests = append(invalidDigests, fmt.Sprintf("Invalid %s digests: %s", kind, status.Convert(err).Message()))
+		}
+		return newDigests
+	}
+	digests := configuredDigests{
+		actions:     newDigestsFromProtos(configuration.Actions, "action"),
+		blobs:       newDigestsFromProtos(configuration.Blobs, "blob"),
+		directories: newDigestsFromProtos(configuration.Directories, "directory"),
+		trees:       newDigestsFromProtos(configuration.Trees, "tree"),
+	}
+	if len(invalidDigests) > 0 {
+		return configuredDigests{}, status.Error(codes.InvalidArgument, strings.Join(invalidDigests, "; "))
+	}
+	return digests, nil
+}
+
+// replicateZIPArchive replicates all objects contained in a ZIP archive
+// whose hashes match the configured prefixes. The list of objects is
+// obtained from the ZIP archive's central directory.
+func replicateZIPArchive(ctx context.Context, replicator replication.BlobReplicator, configuration *bb_copy.ZIPArchiveConfiguration, instanceName digest.InstanceName) error {
+	zipReader, err := zip.OpenReader(configuration.Path)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to open ZIP file %#v", configuration.Path)
+	}
+	defer zipReader.Close()
+
+	digests, err := blobstore.GetZIPFileDigests(
+		blobstore.FilterZIPFilesByHashPrefix(zipReader.File, configuration.HashPrefixes),
+		instanceName)
+	if err != nil {
+		return err
+	}
+
+	// Replicate objects in batches, so that sinks don't receive
+	// excessively large FindMissing() requests.
+	items := digests.Items()
+	for len(items) > 0 {
+		batchSize := min(len(items), blobstore.RecommendedFindMissingDigestsCount)
+		batch := digest.NewSetBuilder()
+		for _, blobDigest := range items[:batchSize] {
+			batch.Add(blobDigest)
+		}
+		if err := replicator.ReplicateMultiple(ctx, batch.Build()); err != nil {
+			return util.StatusWrap(err, "Failed to replicate objects")
+		}
+		items = items[batchSize:]
+	}
+	return nil
+}
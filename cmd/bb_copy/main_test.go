@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_copy"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetConfiguredDigests(t *testing.T) {
+	digestFunction := digest.MustNewFunction("example", remoteexecution.DigestFunction_MD5)
+
+	t.Run("ManyTrees", func(t *testing.T) {
+		treeProtos := make([]*remoteexecution.Digest, 0, 1000)
+		treeDigests := make([]digest.Digest, 0, 1000)
+		for i := 0; i < 1000; i++ {
+			hash := fmt.Sprintf("%032x", i)
+			treeProtos = append(treeProtos, &remoteexecution.Digest{Hash: hash, SizeBytes: int64(i)})
+			treeDigests = append(treeDigests, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, hash, int64(i)))
+		}
+
+		digests, err := getConfiguredDigests(&bb_copy.ApplicationConfiguration{
+			Actions: []*remoteexecution.Digest{
+				{Hash: "8b1a9953c4611296a827abf8c47804d7", SizeBytes: 5},
+			},
+			Blobs: []*remoteexecution.Digest{
+				{Hash: "6cd3556deb0da54bca060b4c39479839", SizeBytes: 13},
+			},
+			Trees: treeProtos,
+		}, digestFunction)
+		require.NoError(t, err)
+		require.Equal(t, configuredDigests{
+			actions: []digest.Digest{
+				digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+			},
+			blobs: []digest.Digest{
+				digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "6cd3556deb0da54bca060b4c39479839", 13),
+			},
+			directories: []digest.Digest{},
+			trees:       treeDigests,
+		}, digests)
+	})
+
+	t.Run("InvalidDigests", func(t *testing.T) {
+		// Invalid digests should be reported for all kinds of
+		// objects, as opposed to only the first kind for which
+		// an invalid digest is encountered.
+		_, err := getConfiguredDigests(&bb_copy.ApplicationConfiguration{
+			Actions: []*remoteexecution.Digest{
+				{Hash: "8b1a9953", SizeBytes: 5},
+			},
+			Blobs: []*remoteexecution.Digest{
+				{Hash: "6cd3556deb0da54bca060b4c39479839", SizeBytes: 13},
+			},
+			Trees: []*remoteexecution.Digest{
+				{Hash: "6cd3556deb0da54bca060b4c39479839", SizeBytes: 13},
+				{Hash: "6cd3556deb0da54bca060b4c39479839", SizeBytes: -1},
+				nil,
+			},
+		}, digestFunction)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid action digests: 1 out of 1 digests are invalid: index 0: Hash has length 8, while 32 characters were expected; Invalid tree digests: 2 out of 3 digests are invalid: index 1: Invalid digest size: -1 bytes; index 2: No digest provided"), err)
+	})
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/trace"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/proto/configuration/bb_replay"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A utility for replaying operations that were recorded using the
+// 'recording' storage backend against a Content Addressable Storage.
+// This can be used to reproduce issues observed in production, or to
+// perform load tests using realistic access patterns.
+//
+// Recordings don't contain the contents of objects. Objects that were
+// written during the recording are replaced by synthetic objects of
+// the same size.
+
+func main() {
+	program.RunMain(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		if len(os.Args) != 2 {
+			return status.Error(codes.InvalidArgument, "Usage: bb_replay bb_replay.jsonnet")
+		}
+		var configuration bb_replay.ApplicationConfiguration
+		if err := util.UnmarshalConfigurationFromFile(os.Args[1], &configuration); err != nil {
+			return util.StatusWrapf(err, "Failed to read configuration from %s", os.Args[1])
+		}
+		if configuration.Speed < 0 {
+			return status.Error(codes.InvalidArgument, "Speed cannot be negative")
+		}
+
+		backend, err := blobstore_configuration.NewBlobAccessFromConfiguration(
+			dependenciesGroup,
+			configuration.Backend,
+			blobstore_configuration.NewCASBlobAccessCreator(
+				grpc.NewBaseClientFactory(grpc.BaseClientDialer, nil, nil),
+				int(configuration.MaximumMessageSizeBytes)))
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create backend")
+		}
+
+		recording, err := os.Open(configuration.RecordingPath)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to open recording %#v", configuration.RecordingPath)
+		}
+		defer recording.Close()
+
+		replayer := trace.NewReplayer(
+			backend.BlobAccess,
+			clock.SystemClock,
+			configuration.Speed,
+			max(int(configuration.Concurrency), 1),
+			util.DefaultErrorLogger)
+		if err := replayer.Replay(ctx, recording); err != nil {
+			return util.StatusWrapf(err, "Failed to replay recording %#v", configuration.RecordingPath)
+		}
+		return nil
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
@@ -59,11 +60,33 @@ func main() {
 			return util.StatusWrap(err, "Failed to create replicator")
 		}
 
+		if continuousSynchronization := configuration.ContinuousSynchronization; continuousSynchronization != nil {
+			if continuousSynchronization.BatchSize <= 0 {
+				return status.Error(codes.InvalidArgument, "Continuous synchronization batch size must be positive")
+			}
+			if continuousSynchronization.MaximumConcurrency <= 0 {
+				return status.Error(codes.InvalidArgument, "Continuous synchronization maximum concurrency must be positive")
+			}
+			if err := continuousSynchronization.ScanInterval.CheckValid(); err != nil {
+				return util.StatusWrap(err, "Failed to parse continuous synchronization scan interval")
+			}
+			siblingsGroup.Go(replication.NewContinuousSynchronizer(
+				replication.NewFileDigestLister(continuousSynchronization.DigestListPath),
+				sink.BlobAccess,
+				replicator,
+				clock.SystemClock,
+				util.DefaultErrorLogger,
+				int(continuousSynchronization.BatchSize),
+				int(continuousSynchronization.MaximumConcurrency),
+				continuousSynchronization.ScanInterval.AsDuration()).Run)
+		}
+
 		if err := bb_grpc.NewServersFromConfigurationAndServe(
 			configuration.GrpcServers,
 			func(s grpc.ServiceRegistrar) {
 				replicator_pb.RegisterReplicatorServer(s, replication.NewReplicatorServer(replicator))
 			},
+			configuration.MaximumMessageSizeBytes,
 			siblingsGroup,
 		); err != nil {
 			return util.StatusWrap(err, "gRPC server failure")
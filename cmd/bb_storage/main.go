@@ -11,6 +11,9 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
 	"github.com/buildbarn/bb-storage/pkg/builder"
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
 	"github.com/buildbarn/bb-storage/pkg/global"
 	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
@@ -20,6 +23,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"golang.org/x/sync/semaphore"
 	"google.golang.org/genproto/googleapis/bytestream"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -40,10 +44,29 @@ func main() {
 			return util.StatusWrap(err, "Failed to apply global configuration options")
 		}
 
+		digestFunctionInferenceOverrides := make(map[string][]remoteexecution.DigestFunction_Value, len(configuration.DigestFunctionInferenceOverrides))
+		for instanceNamePrefix, overrides := range configuration.DigestFunctionInferenceOverrides {
+			digestFunctionInferenceOverrides[instanceNamePrefix] = overrides.GetDigestFunctions()
+		}
+		defaultDigestFunctions, err := digest.NewDefaultFunctionResolver(configuration.DefaultDigestFunctions, digestFunctionInferenceOverrides)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create default digest functions")
+		}
+		maximumMessageSizeResolver, err := digest.NewMaximumMessageSizeResolver(
+			configuration.MaximumMessageSizeBytes,
+			configuration.MaximumMessageSizeBytesPerInstanceName)
+		if err != nil {
+			return util.StatusWrap(err, "Failed to create maximum message sizes")
+		}
+		// Storage backends and the gRPC server need to be able to
+		// process messages for any of the instance names.
+		maximumMessageSizeBytes := maximumMessageSizeResolver.GetLargestMaximumMessageSizeBytes()
+
 		// Providers for data returned by ServerCapabilities.cache_capabilities
-		// as part of the GetCapabilities() call. We permit these calls
-		// if the client is permitted to at least one method against one
-		// of the data stores described in REv2.
+		// as part of the GetCapabilities() call. Unless a separate
+		// authorizer is configured, we permit these calls if the
+		// client is permitted to at least one method against one of
+		// the data stores described in REv2.
 		var cacheCapabilitiesProviders []capabilities.Provider
 		var cacheCapabilitiesAuthorizers []auth.Authorizer
 
@@ -56,34 +79,70 @@ func main() {
 				configuration.ContentAddressableStorage,
 				blobstore_configuration.NewCASBlobAccessCreator(
 					grpcClientFactory,
-					int(configuration.MaximumMessageSizeBytes)))
+					int(maximumMessageSizeBytes)))
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create Content Addressable Storage")
 			}
 			cacheCapabilitiesProviders = append(cacheCapabilitiesProviders, info.BlobAccess)
 			cacheCapabilitiesAuthorizers = append(cacheCapabilitiesAuthorizers, allAuthorizers...)
 			contentAddressableStorageInfo = &info
-			contentAddressableStorage = authorizedBackend
+			contentAddressableStorage = blobstore.NewServerTimingBlobAccess(authorizedBackend, clock.SystemClock)
 		}
 
 		// Action Cache (AC).
+		var actionCacheInfo *blobstore_configuration.BlobAccessInfo
 		var actionCache blobstore.BlobAccess
+		var maximumOffloadedActionResultSizeBytes int64
 		if configuration.ActionCache != nil {
+			acBlobAccessCreator := blobstore_configuration.NewACBlobAccessCreator(
+				contentAddressableStorageInfo,
+				grpcClientFactory,
+				int(maximumMessageSizeBytes))
 			info, authorizedBackend, allAuthorizers, putAuthorizer, err := newNonScannableBlobAccess(
 				dependenciesGroup,
 				configuration.ActionCache,
-				blobstore_configuration.NewACBlobAccessCreator(
-					contentAddressableStorageInfo,
-					grpcClientFactory,
-					int(configuration.MaximumMessageSizeBytes)))
+				acBlobAccessCreator)
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create Action Cache")
 			}
+			maximumOffloadedActionResultSizeBytes = int64(acBlobAccessCreator.GetMaximumOffloadedActionResultSizeBytes())
 			cacheCapabilitiesProviders = append(
 				cacheCapabilitiesProviders,
 				capabilities.NewActionCacheUpdateEnabledClearingProvider(info.BlobAccess, putAuthorizer))
 			cacheCapabilitiesAuthorizers = append(cacheCapabilitiesAuthorizers, allAuthorizers...)
-			actionCache = authorizedBackend
+			actionCacheInfo = &info
+			actionCache = blobstore.NewServerTimingBlobAccess(authorizedBackend, clock.SystemClock)
+		}
+
+		// Optional: validate that the storage backends are
+		// configured properly before accepting any requests.
+		if selfTestConfiguration := configuration.StartupSelfTest; selfTestConfiguration != nil {
+			instanceName, err := digest.NewInstanceName(selfTestConfiguration.InstanceName)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid startup self-test instance name %#v", selfTestConfiguration.InstanceName)
+			}
+			digestFunction, err := instanceName.GetDigestFunction(selfTestConfiguration.DigestFunction, 0)
+			if err != nil {
+				return util.StatusWrap(err, "Invalid startup self-test digest function")
+			}
+			if err := selfTestConfiguration.Timeout.CheckValid(); err != nil {
+				return util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid startup self-test timeout")
+			}
+			timeout := selfTestConfiguration.Timeout.AsDuration()
+			if timeout <= 0 {
+				return status.Error(codes.InvalidArgument, "Startup self-test timeout must be positive")
+			}
+			selfTestMaximumMessageSizeBytes := int(maximumMessageSizeResolver.GetMaximumMessageSizeBytes(instanceName))
+			if contentAddressableStorageInfo != nil {
+				if err := blobstore.RunStartupSelfTest(ctx, "Content Addressable Storage", contentAddressableStorageInfo.BlobAccess, clock.SystemClock, digestFunction, timeout, selfTestMaximumMessageSizeBytes); err != nil {
+					return err
+				}
+			}
+			if actionCacheInfo != nil {
+				if err := blobstore.RunStartupSelfTest(ctx, "Action Cache", actionCacheInfo.BlobAccess, clock.SystemClock, digestFunction, timeout, selfTestMaximumMessageSizeBytes); err != nil {
+					return err
+				}
+			}
 		}
 
 		// Buildbarn extension: Indirect Content Addressable Storage (ICAS).
@@ -94,7 +153,7 @@ func main() {
 				configuration.IndirectContentAddressableStorage,
 				blobstore_configuration.NewICASBlobAccessCreator(
 					grpcClientFactory,
-					int(configuration.MaximumMessageSizeBytes)))
+					int(maximumMessageSizeBytes)))
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create Indirect Content Addressable Storage")
 			}
@@ -109,7 +168,7 @@ func main() {
 				configuration.InitialSizeClassCache,
 				blobstore_configuration.NewISCCBlobAccessCreator(
 					grpcClientFactory,
-					int(configuration.MaximumMessageSizeBytes)))
+					int(maximumMessageSizeBytes)))
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create Initial Size Class Cache")
 			}
@@ -124,7 +183,7 @@ func main() {
 				configuration.FileSystemAccessCache,
 				blobstore_configuration.NewFSACBlobAccessCreator(
 					grpcClientFactory,
-					int(configuration.MaximumMessageSizeBytes)))
+					int(maximumMessageSizeBytes)))
 			if err != nil {
 				return util.StatusWrap(err, "Failed to create File System Access Cache")
 			}
@@ -133,11 +192,20 @@ func main() {
 
 		var capabilitiesProviders []capabilities.Provider
 		if len(cacheCapabilitiesProviders) > 0 {
+			capabilitiesAuthorizer := auth.NewAnyAuthorizer(cacheCapabilitiesAuthorizers)
+			if configuration.CapabilitiesAuthorizer != nil {
+				capabilitiesAuthorizer, err = auth.DefaultAuthorizerFactory.NewAuthorizerFromConfiguration(configuration.CapabilitiesAuthorizer)
+				if err != nil {
+					return util.StatusWrap(err, "Failed to create capabilities authorizer")
+				}
+			}
 			capabilitiesProviders = append(
 				capabilitiesProviders,
 				capabilities.NewAuthorizingProvider(
-					capabilities.NewMergingProvider(cacheCapabilitiesProviders),
-					auth.NewAnyAuthorizer(cacheCapabilitiesAuthorizers)))
+					capabilities.NewMaxBatchTotalSizeSettingProvider(
+						capabilities.NewMergingProvider(cacheCapabilitiesProviders),
+						maximumMessageSizeResolver),
+					capabilitiesAuthorizer))
 		}
 
 		// Create a demultiplexing build queue that forwards traffic to
@@ -156,6 +224,62 @@ func main() {
 			capabilitiesProviders = append(capabilitiesProviders, buildQueue)
 		}
 
+		byteStreamConfiguration := configuration.ByteStream
+		byteStreamReadChunkSizeBytes := 1 << 16
+		if chunkSize := byteStreamConfiguration.GetReadChunkSizeBytes(); chunkSize < 0 {
+			return status.Error(codes.InvalidArgument, "ByteStream read chunk size cannot be negative")
+		} else if chunkSize > 0 {
+			byteStreamReadChunkSizeBytes = int(chunkSize)
+		}
+
+		var byteStreamBandwidthLimiter *grpcservers.BandwidthLimiter
+		if bandwidthLimit := byteStreamConfiguration.GetBandwidthLimit(); bandwidthLimit != nil {
+			if bandwidthLimit.BytesPerSecond <= 0 {
+				return status.Error(codes.InvalidArgument, "ByteStream bandwidth limit must be positive")
+			}
+			if bandwidthLimit.BurstBytes <= 0 {
+				return status.Error(codes.InvalidArgument, "ByteStream bandwidth limit burst must be positive")
+			}
+			if bandwidthLimit.MaximumPrincipals <= 0 {
+				return status.Error(codes.InvalidArgument, "ByteStream bandwidth limit maximum number of principals must be positive")
+			}
+			byteStreamBandwidthLimiter = grpcservers.NewBandwidthLimiter(
+				clock.SystemClock,
+				eviction.NewMetricsSet(eviction.NewLRUSet[string](), "ByteStreamBandwidthLimiter"),
+				int(bandwidthLimit.MaximumPrincipals),
+				bandwidthLimit.BytesPerSecond,
+				bandwidthLimit.BurstBytes)
+		}
+
+		// The stream and in-flight write limits apply to the process
+		// as a whole, as opposed to being enforced for each listener
+		// separately.
+		var byteStreamStreams *semaphore.Weighted
+		if maximumConcurrentStreams := byteStreamConfiguration.GetMaximumConcurrentStreams(); maximumConcurrentStreams < 0 {
+			return status.Error(codes.InvalidArgument, "ByteStream maximum number of concurrent streams cannot be negative")
+		} else if maximumConcurrentStreams > 0 {
+			byteStreamStreams = semaphore.NewWeighted(int64(maximumConcurrentStreams))
+		}
+
+		var byteStreamInFlightBytesLimiter *grpcservers.InFlightBytesLimiter
+		if maximumInFlightWriteBytes := byteStreamConfiguration.GetMaximumInFlightWriteBytes(); maximumInFlightWriteBytes < 0 {
+			return status.Error(codes.InvalidArgument, "ByteStream maximum number of in-flight write bytes cannot be negative")
+		} else if maximumInFlightWriteBytes > 0 {
+			byteStreamInFlightBytesLimiter = grpcservers.NewInFlightBytesLimiter(maximumInFlightWriteBytes)
+		}
+
+		if configuration.MaximumFindMissingBlobsDigests < 0 {
+			return status.Error(codes.InvalidArgument, "Maximum number of FindMissingBlobs() digests cannot be negative")
+		}
+
+		// ActionResult messages offloaded to the CAS may exceed the
+		// maximum message size. Ensure that the gRPC server is
+		// capable of receiving and sending them.
+		grpcMaximumMessageSizeBytes := maximumMessageSizeBytes
+		if grpcMaximumMessageSizeBytes < maximumOffloadedActionResultSizeBytes {
+			grpcMaximumMessageSizeBytes = maximumOffloadedActionResultSizeBytes
+		}
+
 		if err := bb_grpc.NewServersFromConfigurationAndServe(
 			configuration.GrpcServers,
 			func(s grpc.ServiceRegistrar) {
@@ -164,40 +288,48 @@ func main() {
 						s,
 						grpcservers.NewContentAddressableStorageServer(
 							contentAddressableStorage,
-							configuration.MaximumMessageSizeBytes))
+							maximumMessageSizeResolver,
+							defaultDigestFunctions,
+							int(configuration.MaximumFindMissingBlobsDigests)))
 					bytestream.RegisterByteStreamServer(
 						s,
 						grpcservers.NewByteStreamServer(
 							contentAddressableStorage,
-							1<<16))
+							byteStreamReadChunkSizeBytes,
+							byteStreamStreams,
+							byteStreamInFlightBytesLimiter,
+							defaultDigestFunctions,
+							byteStreamBandwidthLimiter))
 				}
 				if actionCache != nil {
 					remoteexecution.RegisterActionCacheServer(
 						s,
 						grpcservers.NewActionCacheServer(
 							actionCache,
-							int(configuration.MaximumMessageSizeBytes)))
+							maximumMessageSizeResolver,
+							maximumOffloadedActionResultSizeBytes,
+							defaultDigestFunctions))
 				}
 				if indirectContentAddressableStorage != nil {
 					icas.RegisterIndirectContentAddressableStorageServer(
 						s,
 						grpcservers.NewIndirectContentAddressableStorageServer(
 							indirectContentAddressableStorage,
-							int(configuration.MaximumMessageSizeBytes)))
+							int(maximumMessageSizeBytes)))
 				}
 				if initialSizeClassCache != nil {
 					iscc.RegisterInitialSizeClassCacheServer(
 						s,
 						grpcservers.NewInitialSizeClassCacheServer(
 							initialSizeClassCache,
-							int(configuration.MaximumMessageSizeBytes)))
+							int(maximumMessageSizeBytes)))
 				}
 				if fileSystemAccessCache != nil {
 					fsac.RegisterFileSystemAccessCacheServer(
 						s,
 						grpcservers.NewFileSystemAccessCacheServer(
 							fileSystemAccessCache,
-							int(configuration.MaximumMessageSizeBytes)))
+							int(maximumMessageSizeBytes)))
 				}
 				if buildQueue != nil {
 					remoteexecution.RegisterExecutionServer(s, buildQueue)
@@ -209,6 +341,7 @@ func main() {
 							capabilities.NewMergingProvider(capabilitiesProviders)))
 				}
 			},
+			grpcMaximumMessageSizeBytes,
 			siblingsGroup,
 		); err != nil {
 			return util.StatusWrap(err, "gRPC server failure")
@@ -234,8 +367,11 @@ func newNonScannableBlobAccess(dependenciesGroup program.Group, configuration *b
 		return blobstore_configuration.BlobAccessInfo{}, nil, nil, nil, util.StatusWrap(err, "Failed to create Put() authorizer")
 	}
 
+	// Existence checks against non-scannable storage, such as those
+	// performed through the batch existence checking RPCs of the
+	// ISCC and FSAC, are authorized as if they were reads.
 	return info,
-		blobstore.NewAuthorizingBlobAccess(info.BlobAccess, getAuthorizer, putAuthorizer, nil),
+		blobstore.NewAuthorizingBlobAccess(info.BlobAccess, getAuthorizer, putAuthorizer, getAuthorizer),
 		[]auth.Authorizer{getAuthorizer, putAuthorizer},
 		putAuthorizer,
 		nil
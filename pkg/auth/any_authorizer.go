@@ -32,36 +32,51 @@ func NewAnyAuthorizer(authorizers []Authorizer) Authorizer {
 }
 
 func (a *anyAuthorizer) Authorize(ctx context.Context, instanceNames []digest.InstanceName) []error {
+	return authorizeAny(a.authorizers, instanceNames, func(authorizer Authorizer, instanceNames []digest.InstanceName) []error {
+		return authorizer.Authorize(ctx, instanceNames)
+	})
+}
+
+func (a *anyAuthorizer) AuthorizeDigestFunctions(ctx context.Context, digestFunctions []digest.Function) []error {
+	return authorizeAny(a.authorizers, digestFunctions, func(authorizer Authorizer, digestFunctions []digest.Function) []error {
+		return authorizer.AuthorizeDigestFunctions(ctx, digestFunctions)
+	})
+}
+
+// authorizeAny contains the logic shared by Authorize() and
+// AuthorizeDigestFunctions(), calling into successive backends for
+// the items for which access has been denied so far.
+func authorizeAny[T any](authorizers []Authorizer, items []T, authorize func(Authorizer, []T) []error) []error {
 	// Authorize against the first backend.
-	errs := a.authorizers[0].Authorize(ctx, instanceNames)
+	errs := authorize(authorizers[0], items)
 
-	// Determine which instance names need to be provided to
-	// successive backends.
-	var currentInstanceNames []digest.InstanceName
+	// Determine which items need to be provided to successive
+	// backends.
+	var currentItems []T
 	var currentErrsIndex []int
 	for i, err := range errs {
 		if status.Code(err) == codes.PermissionDenied {
-			currentInstanceNames = append(currentInstanceNames, instanceNames[i])
+			currentItems = append(currentItems, items[i])
 			currentErrsIndex = append(currentErrsIndex, i)
 		}
 	}
 
 	// Call into successive backends, each time filtering the list
-	// of instance names to request.
-	for _, authorizer := range a.authorizers[1:] {
-		if len(currentInstanceNames) == 0 {
+	// of items to request.
+	for _, authorizer := range authorizers[1:] {
+		if len(currentItems) == 0 {
 			break
 		}
-		nextInstanceNames, nextErrsIndex := currentInstanceNames[:0], currentErrsIndex[:0]
-		for i, err := range authorizer.Authorize(ctx, currentInstanceNames) {
+		nextItems, nextErrsIndex := currentItems[:0], currentErrsIndex[:0]
+		for i, err := range authorize(authorizer, currentItems) {
 			if status.Code(err) == codes.PermissionDenied {
-				nextInstanceNames = append(nextInstanceNames, currentInstanceNames[i])
+				nextItems = append(nextItems, currentItems[i])
 				nextErrsIndex = append(nextErrsIndex, currentErrsIndex[i])
 			} else {
 				errs[currentErrsIndex[i]] = err
 			}
 		}
-		currentInstanceNames, currentErrsIndex = nextInstanceNames, nextErrsIndex
+		currentItems, currentErrsIndex = nextItems, nextErrsIndex
 	}
 
 	return errs
@@ -20,6 +20,16 @@ type Authorizer interface {
 	// Note that this function may block, and should not be called while
 	// locks are held which may be contended.
 	Authorize(ctx context.Context, instanceNames []digest.InstanceName) []error
+
+	// AuthorizeDigestFunctions is identical to Authorize, except
+	// that it is provided digest functions instead of instance
+	// names. As digest functions contain both an instance name and
+	// the hashing algorithm used by the client, this permits making
+	// authorization decisions at a finer granularity. For example,
+	// access may be granted to blobs using SHA-256 within a given
+	// instance name, while access to blobs using other hashing
+	// algorithms is denied.
+	AuthorizeDigestFunctions(ctx context.Context, digestFunctions []digest.Function) []error
 }
 
 // AuthorizeSingleInstanceName is a convenience function to authorize a
@@ -27,3 +37,9 @@ type Authorizer interface {
 func AuthorizeSingleInstanceName(ctx context.Context, authorizer Authorizer, instanceName digest.InstanceName) error {
 	return authorizer.Authorize(ctx, []digest.InstanceName{instanceName})[0]
 }
+
+// AuthorizeSingleDigestFunction is a convenience function to authorize
+// a single digest function with an Authorizer.
+func AuthorizeSingleDigestFunction(ctx context.Context, authorizer Authorizer, digestFunction digest.Function) error {
+	return authorizer.AuthorizeDigestFunctions(ctx, []digest.Function{digestFunction})[0]
+}
@@ -39,15 +39,25 @@ func (f BaseAuthorizerFactory) NewAuthorizerFromConfiguration(config *pb.Authori
 	case *pb.AuthorizerConfiguration_Deny:
 		return NewStaticAuthorizer(func(in digest.InstanceName) bool { return false }), nil
 	case *pb.AuthorizerConfiguration_InstanceNamePrefix:
-		trie := digest.NewInstanceNameTrie()
+		prefixTrie := digest.NewInstanceNameTrie()
 		for _, i := range policy.InstanceNamePrefix.AllowedInstanceNamePrefixes {
 			instanceNamePrefix, err := digest.NewInstanceName(i)
 			if err != nil {
-				return nil, err
+				return nil, util.StatusWrapf(err, "Invalid instance name prefix %#v", i)
 			}
-			trie.Set(instanceNamePrefix, 0)
+			prefixTrie.Set(instanceNamePrefix, 0)
 		}
-		return NewStaticAuthorizer(trie.ContainsPrefix), nil
+		exactTrie := digest.NewInstanceNameTrie()
+		for _, i := range policy.InstanceNamePrefix.AllowedInstanceNames {
+			instanceName, err := digest.NewInstanceName(i)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Invalid instance name %#v", i)
+			}
+			exactTrie.Set(instanceName, 0)
+		}
+		return NewStaticAuthorizer(func(in digest.InstanceName) bool {
+			return prefixTrie.ContainsPrefix(in) || exactTrie.ContainsExact(in)
+		}), nil
 	case *pb.AuthorizerConfiguration_JmespathExpression:
 		expression, err := jmespath.Compile(policy.JmespathExpression)
 		if err != nil {
@@ -0,0 +1,66 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/auth"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBaseAuthorizerFactoryInstanceNamePrefix(t *testing.T) {
+	a, err := auth.BaseAuthorizerFactory{}.NewAuthorizerFromConfiguration(&pb.AuthorizerConfiguration{
+		Policy: &pb.AuthorizerConfiguration_InstanceNamePrefix{
+			InstanceNamePrefix: &pb.InstanceNameAuthorizer{
+				AllowedInstanceNamePrefixes: []string{"team"},
+				AllowedInstanceNames:        []string{"other/exact"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	authorize := func(instanceName string) error {
+		return auth.AuthorizeSingleInstanceName(context.Background(), a, digest.MustNewInstanceName(instanceName))
+	}
+	errPermissionDenied := status.Error(codes.PermissionDenied, "Permission denied")
+
+	t.Run("ParentAuthorizesDescendants", func(t *testing.T) {
+		require.NoError(t, authorize("team"))
+		require.NoError(t, authorize("team/subteam"))
+		require.NoError(t, authorize("team/subteam/project"))
+	})
+
+	t.Run("SiblingDenied", func(t *testing.T) {
+		// Matching is performed on a per-component basis, so
+		// instance names that merely share a string prefix
+		// should be denied.
+		testutil.RequireEqualStatus(t, errPermissionDenied, authorize("team2"))
+		testutil.RequireEqualStatus(t, errPermissionDenied, authorize("teams/subteam"))
+		testutil.RequireEqualStatus(t, errPermissionDenied, authorize(""))
+	})
+
+	t.Run("ExactMatch", func(t *testing.T) {
+		// Exact-match rules should neither authorize their
+		// parents, nor their descendants.
+		require.NoError(t, authorize("other/exact"))
+		testutil.RequireEqualStatus(t, errPermissionDenied, authorize("other"))
+		testutil.RequireEqualStatus(t, errPermissionDenied, authorize("other/exact/child"))
+	})
+}
+
+func TestBaseAuthorizerFactoryInvalidInstanceName(t *testing.T) {
+	_, err := auth.BaseAuthorizerFactory{}.NewAuthorizerFromConfiguration(&pb.AuthorizerConfiguration{
+		Policy: &pb.AuthorizerConfiguration_InstanceNamePrefix{
+			InstanceNamePrefix: &pb.InstanceNameAuthorizer{
+				AllowedInstanceNames: []string{"/invalid"},
+			},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
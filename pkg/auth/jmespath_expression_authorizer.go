@@ -14,25 +14,42 @@ type jmespathExpressionAuthorizer struct {
 // NewJMESPathExpressionAuthorizer creates an Authorizer that evaluates
 // a JMESPath expression to make an authorization decision. The JMESpath
 // expression is called with a JSON object that includes both the REv2
-// instance name and authentication metadata.
+// instance name and authentication metadata. If the authorizer is
+// invoked through AuthorizeDigestFunctions(), the name of the digest
+// function is provided as well.
 func NewJMESPathExpressionAuthorizer(expression *jmespath.JMESPath) Authorizer {
 	return &jmespathExpressionAuthorizer{
 		expression: expression,
 	}
 }
 
+func (a *jmespathExpressionAuthorizer) evaluate(authenticationMetadata *AuthenticationMetadata, input map[string]interface{}) error {
+	input["authenticationMetadata"] = authenticationMetadata.GetRaw()
+	if result, err := a.expression.Search(input); err == nil && result == true {
+		return nil
+	}
+	return errPermissionDenied
+}
+
 func (a *jmespathExpressionAuthorizer) Authorize(ctx context.Context, instanceNames []digest.InstanceName) []error {
 	authenticationMetadata := AuthenticationMetadataFromContext(ctx)
 	errs := make([]error, 0, len(instanceNames))
 	for _, instanceName := range instanceNames {
-		if result, err := a.expression.Search(map[string]interface{}{
-			"authenticationMetadata": authenticationMetadata.GetRaw(),
-			"instanceName":           instanceName.String(),
-		}); err == nil && result == true {
-			errs = append(errs, nil)
-		} else {
-			errs = append(errs, errPermissionDenied)
-		}
+		errs = append(errs, a.evaluate(authenticationMetadata, map[string]interface{}{
+			"instanceName": instanceName.String(),
+		}))
+	}
+	return errs
+}
+
+func (a *jmespathExpressionAuthorizer) AuthorizeDigestFunctions(ctx context.Context, digestFunctions []digest.Function) []error {
+	authenticationMetadata := AuthenticationMetadataFromContext(ctx)
+	errs := make([]error, 0, len(digestFunctions))
+	for _, digestFunction := range digestFunctions {
+		errs = append(errs, a.evaluate(authenticationMetadata, map[string]interface{}{
+			"instanceName":   digestFunction.GetInstanceName().String(),
+			"digestFunction": digestFunction.GetEnumValue().String(),
+		}))
 	}
 	return errs
 }
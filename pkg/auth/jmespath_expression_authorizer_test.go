@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/auth"
@@ -61,3 +62,24 @@ func TestJMESPathExpressionAuthorizer(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Permission denied"), errs[1])
 	})
 }
+
+func TestJMESPathExpressionAuthorizerDigestFunctions(t *testing.T) {
+	a := auth.NewJMESPathExpressionAuthorizer(jmespath.MustCompile("instanceName == 'allowed' && digestFunction == 'SHA256'"))
+
+	errs := a.AuthorizeDigestFunctions(context.Background(), []digest.Function{
+		digest.MustNewFunction("allowed", remoteexecution.DigestFunction_SHA256),
+		digest.MustNewFunction("allowed", remoteexecution.DigestFunction_MD5),
+		digest.MustNewFunction("forbidden", remoteexecution.DigestFunction_SHA256),
+	})
+	require.Len(t, errs, 3)
+	require.NoError(t, errs[0])
+	testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Permission denied"), errs[1])
+	testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Permission denied"), errs[2])
+
+	// When called through Authorize(), no digest function is
+	// provided. The expression should thus evaluate to false.
+	errs = a.Authorize(context.Background(), []digest.InstanceName{
+		digest.MustNewInstanceName("allowed"),
+	})
+	testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Permission denied"), errs[0])
+}
@@ -33,3 +33,15 @@ func (a *staticAuthorizer) Authorize(ctx context.Context, instanceNames []digest
 	}
 	return errs
 }
+
+func (a *staticAuthorizer) AuthorizeDigestFunctions(ctx context.Context, digestFunctions []digest.Function) []error {
+	errs := make([]error, 0, len(digestFunctions))
+	for _, digestFunction := range digestFunctions {
+		if a.matcher(digestFunction.GetInstanceName()) {
+			errs = append(errs, nil)
+		} else {
+			errs = append(errs, errPermissionDenied)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,52 @@
+package blobstore
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type actionResultExitCodeFilteringBlobAccess struct {
+	BlobAccess
+	maximumMessageSizeBytes int
+	rejectNonzeroExitCodes  bool
+}
+
+// NewActionResultExitCodeFilteringBlobAccess creates a decorator for an
+// Action Cache (AC) that prevents ActionResult messages having a
+// nonzero exit code from being stored. Such ActionResults are either
+// discarded silently, or rejected with INVALID_ARGUMENT.
+//
+// The REv2 specification permits caching failed actions, but doing so
+// is rarely desirable. Failures may be caused by flakiness, and caching
+// them prevents subsequent builds from retrying them.
+func NewActionResultExitCodeFilteringBlobAccess(blobAccess BlobAccess, maximumMessageSizeBytes int, rejectNonzeroExitCodes bool) BlobAccess {
+	return &actionResultExitCodeFilteringBlobAccess{
+		BlobAccess:              blobAccess,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+		rejectNonzeroExitCodes:  rejectNonzeroExitCodes,
+	}
+}
+
+func (ba *actionResultExitCodeFilteringBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	b1, b2 := b.CloneCopy(ba.maximumMessageSizeBytes)
+	actionResultMessage, err := b1.ToProto(&remoteexecution.ActionResult{}, ba.maximumMessageSizeBytes)
+	if err != nil {
+		b2.Discard()
+		return err
+	}
+
+	if exitCode := actionResultMessage.(*remoteexecution.ActionResult).ExitCode; exitCode != 0 {
+		b2.Discard()
+		if ba.rejectNonzeroExitCodes {
+			return status.Errorf(codes.InvalidArgument, "Action result has exit code %d, while only action results with exit code 0 may be stored", exitCode)
+		}
+		return nil
+	}
+	return ba.BlobAccess.Put(ctx, digest, b2)
+}
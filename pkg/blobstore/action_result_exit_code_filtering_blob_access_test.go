@@ -0,0 +1,78 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestActionResultExitCodeFilteringBlobAccessPut(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "d3b7ed68c99422eaa8ab8184949cba84dd46ddb1b7cf8c777547866d54ebb081", 123)
+
+	for name, rejectNonzeroExitCodes := range map[string]bool{
+		"Drop":   false,
+		"Reject": true,
+	} {
+		blobAccess := blobstore.NewActionResultExitCodeFilteringBlobAccess(baseBlobAccess, 1000, rejectNonzeroExitCodes)
+
+		t.Run(name+"ZeroExitCode", func(t *testing.T) {
+			// Successful ActionResults should be forwarded
+			// to the backend unaltered.
+			actionResult := &remoteexecution.ActionResult{
+				StdoutRaw: []byte("Hello"),
+			}
+			baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+					m, err := b.ToProto(&remoteexecution.ActionResult{}, 1000)
+					require.NoError(t, err)
+					testutil.RequireEqualProto(t, actionResult, m)
+					return nil
+				})
+
+			require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+		})
+
+		t.Run(name+"NonzeroExitCode", func(t *testing.T) {
+			// ActionResults of failed actions should never
+			// be forwarded to the backend. Depending on the
+			// policy, they are either dropped or rejected.
+			err := blobAccess.Put(
+				ctx,
+				blobDigest,
+				buffer.NewProtoBufferFromProto(
+					&remoteexecution.ActionResult{
+						ExitCode: 667,
+					},
+					buffer.UserProvided))
+			if rejectNonzeroExitCodes {
+				testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Action result has exit code 667, while only action results with exit code 0 may be stored"), err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("InvalidActionResult", func(t *testing.T) {
+		blobAccess := blobstore.NewActionResultExitCodeFilteringBlobAccess(baseBlobAccess, 1000, true)
+
+		testutil.RequirePrefixedStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Failed to unmarshal message: "),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
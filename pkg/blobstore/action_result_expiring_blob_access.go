@@ -21,6 +21,7 @@ type actionResultExpiringBlobAccess struct {
 	minimumTimestamp        time.Time
 	minimumValidity         time.Duration
 	maximumValidityJitter   uint64
+	expireWithoutTimestamp  bool
 }
 
 // NewActionResultExpiringBlobAccess creates a decorator for an Action
@@ -33,7 +34,11 @@ type actionResultExpiringBlobAccess struct {
 // is added to the expiration time to amortize rebuilds. The process for
 // determining the amount of jitter is deterministic, meaning that it is
 // safe to use this decorator in a distributed setting.
-func NewActionResultExpiringBlobAccess(blobAccess BlobAccess, clock clock.Clock, maximumMessageSizeBytes int, minimumTimestamp time.Time, minimumValidity, maximumValidityJitter time.Duration) BlobAccess {
+//
+// ActionResults that lack a 'worker_completed_timestamp' field are
+// either returned as is, or treated as being expired, depending on
+// whether expireWithoutTimestamp is set.
+func NewActionResultExpiringBlobAccess(blobAccess BlobAccess, clock clock.Clock, maximumMessageSizeBytes int, minimumTimestamp time.Time, minimumValidity, maximumValidityJitter time.Duration, expireWithoutTimestamp bool) BlobAccess {
 	return &actionResultExpiringBlobAccess{
 		BlobAccess:              blobAccess,
 		clock:                   clock,
@@ -41,6 +46,7 @@ func NewActionResultExpiringBlobAccess(blobAccess BlobAccess, clock clock.Clock,
 		minimumTimestamp:        minimumTimestamp,
 		minimumValidity:         minimumValidity,
 		maximumValidityJitter:   uint64(maximumValidityJitter),
+		expireWithoutTimestamp:  expireWithoutTimestamp,
 	}
 }
 
@@ -49,7 +55,10 @@ func (ba *actionResultExpiringBlobAccess) checkWorkerCompletedTimestamp(t time.T
 		return status.Errorf(codes.NotFound, "Action result has worker completed timestamp %s, which is below the minimum of %s", t.Format(time.RFC3339), ba.minimumTimestamp.Format(time.RFC3339))
 	}
 	// Pick an expiration time that includes jitter.
-	expirationTime := t.Add(ba.minimumValidity).Add(time.Duration(uint64(t.Unix()) * 0x936a0d2a41e8c779 % ba.maximumValidityJitter))
+	expirationTime := t.Add(ba.minimumValidity)
+	if ba.maximumValidityJitter > 0 {
+		expirationTime = expirationTime.Add(time.Duration(uint64(t.Unix()) * 0x936a0d2a41e8c779 % ba.maximumValidityJitter))
+	}
 	if ba.clock.Now().After(expirationTime) {
 		return status.Errorf(codes.NotFound, "Action result with worker completed timestamp %s expired at %s", t.Format(time.RFC3339), expirationTime.Format(time.RFC3339))
 	}
@@ -70,6 +79,9 @@ func (ba *actionResultExpiringBlobAccess) Get(ctx context.Context, digest digest
 			b2.Discard()
 			return buffer.NewBufferFromError(err)
 		}
+	} else if ba.expireWithoutTimestamp {
+		b2.Discard()
+		return buffer.NewBufferFromError(status.Error(codes.NotFound, "Action result has no worker completed timestamp, meaning its age cannot be determined"))
 	}
 	return b2
 }
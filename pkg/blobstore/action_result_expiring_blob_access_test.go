@@ -31,7 +31,8 @@ func TestActionResultExpiringBlobAccess(t *testing.T) {
 		/* maximumMessageSizeBytes = */ 10000,
 		/* minimumTimestamp = */ time.Unix(1641325785, 0),
 		/* minimumValidity = */ 28*24*time.Hour,
-		/* maximumValidityJitter = */ 28*24*time.Hour)
+		/* maximumValidityJitter = */ 28*24*time.Hour,
+		/* expireWithoutTimestamp = */ false)
 
 	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "09b6c5db18b5e8db9ca5400c5ced1a0f", 123)
 
@@ -122,3 +123,61 @@ func TestActionResultExpiringBlobAccess(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Action result with worker completed timestamp 2022-01-04T19:49:46Z expired at 2022-02-06T22:50:55Z"), err)
 	})
 }
+
+func TestActionResultExpiringBlobAccessMaximumAge(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Use the decorator to enforce a fixed maximum age, without
+	// any jitter. ActionResults without a timestamp are treated as
+	// being expired.
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewActionResultExpiringBlobAccess(
+		baseBlobAccess,
+		clock,
+		/* maximumMessageSizeBytes = */ 10000,
+		/* minimumTimestamp = */ time.Unix(0, 0),
+		/* minimumValidity = */ 7*24*time.Hour,
+		/* maximumValidityJitter = */ 0,
+		/* expireWithoutTimestamp = */ true)
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "09b6c5db18b5e8db9ca5400c5ced1a0f", 123)
+
+	t.Run("Fresh", func(t *testing.T) {
+		desiredActionResult := &remoteexecution.ActionResult{
+			ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+				WorkerCompletedTimestamp: &timestamppb.Timestamp{Seconds: 1700000000},
+			},
+		}
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(desiredActionResult, buffer.UserProvided))
+		clock.EXPECT().Now().Return(time.Unix(1700604800, 0))
+
+		actualActionResult, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, desiredActionResult, actualActionResult)
+	})
+
+	t.Run("Old", func(t *testing.T) {
+		desiredActionResult := &remoteexecution.ActionResult{
+			ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+				WorkerCompletedTimestamp: &timestamppb.Timestamp{Seconds: 1700000000},
+			},
+		}
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(desiredActionResult, buffer.UserProvided))
+		clock.EXPECT().Now().Return(time.Unix(1700604801, 0))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Action result with worker completed timestamp 2023-11-14T22:13:20Z expired at 2023-11-21T22:13:20Z"), err)
+	})
+
+	t.Run("NoTimestamp", func(t *testing.T) {
+		desiredActionResult := &remoteexecution.ActionResult{
+			ExitCode:          1,
+			ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{},
+		}
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(desiredActionResult, buffer.UserProvided))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Action result has no worker completed timestamp, meaning its age cannot be determined"), err)
+	})
+}
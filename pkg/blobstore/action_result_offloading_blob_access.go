@@ -0,0 +1,128 @@
+package blobstore
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/blobstore/actionresultoffloading"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type actionResultOffloadingBlobAccess struct {
+	BlobAccess
+	contentAddressableStorage BlobAccess
+	maximumMessageSizeBytes   int
+	maximumOffloadedSizeBytes int
+}
+
+// NewActionResultOffloadingBlobAccess creates a decorator for an Action
+// Cache (AC) that permits storing ActionResult messages that exceed the
+// maximum message size. Such messages are stored in the Content
+// Addressable Storage (CAS). The AC only stores a small ActionResult
+// that refers to the object in the CAS, which is expanded transparently
+// when read.
+//
+// ActionResult messages whose size does not exceed the maximum message
+// size are stored in the AC directly. Messages that exceed
+// maximumOffloadedSizeBytes are rejected.
+func NewActionResultOffloadingBlobAccess(base, contentAddressableStorage BlobAccess, maximumMessageSizeBytes, maximumOffloadedSizeBytes int) BlobAccess {
+	return &actionResultOffloadingBlobAccess{
+		BlobAccess:                base,
+		contentAddressableStorage: contentAddressableStorage,
+		maximumMessageSizeBytes:   maximumMessageSizeBytes,
+		maximumOffloadedSizeBytes: maximumOffloadedSizeBytes,
+	}
+}
+
+// getOffloadedActionResult returns the reference to an offloaded
+// ActionResult, if the ActionResult stored in the AC is a reference.
+func getOffloadedActionResult(actionResult *remoteexecution.ActionResult) (*actionresultoffloading.OffloadedActionResult, bool, error) {
+	auxiliaryMetadata := actionResult.GetExecutionMetadata().GetAuxiliaryMetadata()
+	if len(auxiliaryMetadata) != 1 || !auxiliaryMetadata[0].MessageIs(&actionresultoffloading.OffloadedActionResult{}) {
+		return nil, false, nil
+	}
+	var offloadedActionResult actionresultoffloading.OffloadedActionResult
+	if err := auxiliaryMetadata[0].UnmarshalTo(&offloadedActionResult); err != nil {
+		return nil, false, util.StatusWrapWithCode(err, codes.Internal, "Failed to unmarshal offloaded action result reference")
+	}
+	return &offloadedActionResult, true, nil
+}
+
+func (ba *actionResultOffloadingBlobAccess) Get(ctx context.Context, actionDigest digest.Digest) buffer.Buffer {
+	b1, b2 := ba.BlobAccess.Get(ctx, actionDigest).CloneCopy(ba.maximumMessageSizeBytes)
+	actionResultMessage, err := b1.ToProto(&remoteexecution.ActionResult{}, ba.maximumMessageSizeBytes)
+	if err != nil {
+		b2.Discard()
+		return buffer.NewBufferFromError(err)
+	}
+	offloadedActionResult, ok, err := getOffloadedActionResult(actionResultMessage.(*remoteexecution.ActionResult))
+	if err != nil {
+		b2.Discard()
+		return buffer.NewBufferFromError(err)
+	}
+	if !ok {
+		// ActionResult is stored in the AC directly.
+		return b2
+	}
+
+	// ActionResult is stored in the CAS. Load it from there.
+	b2.Discard()
+	offloadedDigest, err := actionDigest.GetDigestFunction().NewDigestFromProto(offloadedActionResult.ActionResultDigest)
+	if err != nil {
+		return buffer.NewBufferFromError(util.StatusWrapWithCode(err, codes.Internal, "Invalid offloaded action result digest"))
+	}
+	actionResultMessage, err = ba.contentAddressableStorage.Get(ctx, offloadedDigest).ToProto(&remoteexecution.ActionResult{}, ba.maximumOffloadedSizeBytes)
+	if err != nil {
+		return buffer.NewBufferFromError(util.StatusWrapf(err, "Failed to load offloaded action result %#v", offloadedDigest.String()))
+	}
+	return buffer.NewProtoBufferFromProto(actionResultMessage, buffer.BackendProvided(buffer.Irreparable(actionDigest)))
+}
+
+func (ba *actionResultOffloadingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.Get(ctx, parentDigest), childDigest)
+	return b
+}
+
+func (ba *actionResultOffloadingBlobAccess) Put(ctx context.Context, actionDigest digest.Digest, b buffer.Buffer) error {
+	sizeBytes, err := b.GetSizeBytes()
+	if err != nil {
+		b.Discard()
+		return err
+	}
+	if sizeBytes <= int64(ba.maximumMessageSizeBytes) {
+		return ba.BlobAccess.Put(ctx, actionDigest, b)
+	}
+
+	// ActionResult exceeds the maximum message size. Store it in
+	// the CAS, and only store a reference to it in the AC.
+	data, err := b.ToByteSlice(ba.maximumOffloadedSizeBytes)
+	if err != nil {
+		return err
+	}
+	generator := actionDigest.GetDigestFunction().NewGenerator(int64(len(data)))
+	if _, err := generator.Write(data); err != nil {
+		panic(err)
+	}
+	offloadedDigest := generator.Sum()
+	if err := ba.contentAddressableStorage.Put(ctx, offloadedDigest, buffer.NewValidatedBufferFromByteSlice(data)); err != nil {
+		return util.StatusWrapf(err, "Failed to store offloaded action result %#v", offloadedDigest.String())
+	}
+
+	reference, err := anypb.New(&actionresultoffloading.OffloadedActionResult{
+		ActionResultDigest: offloadedDigest.GetProto(),
+	})
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal offloaded action result reference")
+	}
+	return ba.BlobAccess.Put(ctx, actionDigest, buffer.NewProtoBufferFromProto(&remoteexecution.ActionResult{
+		ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+			AuxiliaryMetadata: []*anypb.Any{reference},
+		},
+	}, buffer.UserProvided))
+}
@@ -0,0 +1,106 @@
+package blobstore_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestActionResultOffloadingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	actionCache := mock.NewMockBlobAccess(ctrl)
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewActionResultOffloadingBlobAccess(actionCache, contentAddressableStorage, 200, 10000)
+
+	actionDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "a5a3b0b3f7bd3f2a6f2b5e44fde89e1d5cfd8b7bb3a6c4a2f5bb8c1f52f0c1e1", 123)
+
+	t.Run("SmallActionResult", func(t *testing.T) {
+		// ActionResults that don't exceed the maximum message
+		// size should be stored in the AC directly.
+		actionResult := &remoteexecution.ActionResult{ExitCode: 1}
+		actionCache.EXPECT().Put(ctx, actionDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				m, err := b.ToProto(&remoteexecution.ActionResult{}, 100)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, actionResult, m)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, actionDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+
+		actionCache.EXPECT().Get(ctx, actionDigest).
+			Return(buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided))
+
+		m, err := blobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("LargeActionResult", func(t *testing.T) {
+		// ActionResults that exceed the maximum message size
+		// should be stored in the CAS, while the AC only
+		// contains a reference to it.
+		actionResult := &remoteexecution.ActionResult{}
+		for i := 0; i < 20; i++ {
+			actionResult.OutputFiles = append(actionResult.OutputFiles, &remoteexecution.OutputFile{
+				Path: fmt.Sprintf("bazel-out/k8-fastbuild/bin/output%d", i),
+				Digest: &remoteexecution.Digest{
+					Hash:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					SizeBytes: 0,
+				},
+			})
+		}
+		data, err := proto.Marshal(actionResult)
+		require.NoError(t, err)
+		require.Greater(t, len(data), 200)
+
+		var storedData []byte
+		var storedDigest digest.Digest
+		contentAddressableStorage.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				storedDigest = blobDigest
+				var err error
+				storedData, err = b.ToByteSlice(10000)
+				require.NoError(t, err)
+				return nil
+			})
+		var storedReference []byte
+		actionCache.EXPECT().Put(ctx, actionDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				var err error
+				storedReference, err = b.ToByteSlice(200)
+				require.NoError(t, err)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, actionDigest, buffer.NewValidatedBufferFromByteSlice(data)))
+		require.Equal(t, data, storedData)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256, storedDigest.GetDigestFunction().GetEnumValue())
+		require.Equal(t, "instance", storedDigest.GetInstanceName().String())
+		require.Equal(t, int64(len(data)), storedDigest.GetSizeBytes())
+
+		// Reading the ActionResult should cause it to be
+		// reconstructed from the CAS.
+		actionCache.EXPECT().Get(ctx, actionDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice(storedReference))
+		contentAddressableStorage.EXPECT().Get(ctx, storedDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice(storedData))
+
+		m, err := blobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+}
@@ -0,0 +1,63 @@
+package blobstore
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type actionResultPinningBlobAccess struct {
+	BlobAccess
+	pinnedActionResults map[string]*remoteexecution.ActionResult
+	rejectPuts          bool
+}
+
+// NewActionResultPinningBlobAccess creates a decorator for an Action
+// Cache (AC) that returns fixed ActionResult messages for a set of
+// actions, without consulting the backend. This can be used to pin the
+// results of actions that would otherwise never yield a cache hit, such
+// as ones that are used as cache-busting no-ops.
+//
+// Pinned ActionResults are keyed by action digest, including the
+// instance name. Writes of ActionResults for pinned actions are either
+// discarded silently, or rejected with INVALID_ARGUMENT.
+func NewActionResultPinningBlobAccess(base BlobAccess, pinnedActionResults map[digest.Digest]*remoteexecution.ActionResult, rejectPuts bool) BlobAccess {
+	keyedActionResults := make(map[string]*remoteexecution.ActionResult, len(pinnedActionResults))
+	for actionDigest, actionResult := range pinnedActionResults {
+		keyedActionResults[actionDigest.GetKey(digest.KeyWithInstance)] = actionResult
+	}
+	return &actionResultPinningBlobAccess{
+		BlobAccess:          base,
+		pinnedActionResults: keyedActionResults,
+		rejectPuts:          rejectPuts,
+	}
+}
+
+func (ba *actionResultPinningBlobAccess) Get(ctx context.Context, actionDigest digest.Digest) buffer.Buffer {
+	if actionResult, ok := ba.pinnedActionResults[actionDigest.GetKey(digest.KeyWithInstance)]; ok {
+		return buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)
+	}
+	return ba.BlobAccess.Get(ctx, actionDigest)
+}
+
+func (ba *actionResultPinningBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.Get(ctx, parentDigest), childDigest)
+	return b
+}
+
+func (ba *actionResultPinningBlobAccess) Put(ctx context.Context, actionDigest digest.Digest, b buffer.Buffer) error {
+	if _, ok := ba.pinnedActionResults[actionDigest.GetKey(digest.KeyWithInstance)]; ok {
+		b.Discard()
+		if ba.rejectPuts {
+			return status.Errorf(codes.InvalidArgument, "The action result for action %#v is pinned, meaning it cannot be overwritten", actionDigest.String())
+		}
+		return nil
+	}
+	return ba.BlobAccess.Put(ctx, actionDigest, b)
+}
@@ -0,0 +1,76 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestActionResultPinningBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	pinnedDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "d3b7ed68c99422eaa8ab8184949cba84dd46ddb1b7cf8c777547866d54ebb081", 123)
+	pinnedActionResult := &remoteexecution.ActionResult{
+		StdoutRaw: []byte("Pinned"),
+	}
+	otherDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "e811818f80d9c3c22d577ba83d6196788e553bb408535bb42105cdff726a60ab", 123)
+
+	for name, rejectPuts := range map[string]bool{
+		"Ignore": false,
+		"Reject": true,
+	} {
+		blobAccess := blobstore.NewActionResultPinningBlobAccess(
+			baseBlobAccess,
+			map[digest.Digest]*remoteexecution.ActionResult{
+				pinnedDigest: pinnedActionResult,
+			},
+			rejectPuts)
+
+		t.Run(name+"GetPinned", func(t *testing.T) {
+			// Reads of pinned actions should not be forwarded
+			// to the backend.
+			actionResult, err := blobAccess.Get(ctx, pinnedDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, pinnedActionResult, actionResult)
+		})
+
+		t.Run(name+"GetOther", func(t *testing.T) {
+			baseBlobAccess.EXPECT().Get(ctx, otherDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+			_, err := blobAccess.Get(ctx, otherDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+			testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+		})
+
+		t.Run(name+"PutPinned", func(t *testing.T) {
+			err := blobAccess.Put(ctx, pinnedDigest, buffer.NewProtoBufferFromProto(&remoteexecution.ActionResult{}, buffer.UserProvided))
+			if rejectPuts {
+				testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "The action result for action \"1-d3b7ed68c99422eaa8ab8184949cba84dd46ddb1b7cf8c777547866d54ebb081-123-hello\" is pinned, meaning it cannot be overwritten"), err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+
+		t.Run(name+"PutOther", func(t *testing.T) {
+			baseBlobAccess.EXPECT().Put(ctx, otherDigest, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+					b.Discard()
+					return nil
+				})
+
+			require.NoError(t, blobAccess.Put(ctx, otherDigest, buffer.NewProtoBufferFromProto(&remoteexecution.ActionResult{}, buffer.UserProvided)))
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package blobstore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type actionResultRetentionClassRoutingBlobAccess struct {
+	BlobAccess
+	metadataHeader          string
+	classBackends           map[string]BlobAccess
+	classNames              []string
+	maximumMessageSizeBytes int
+}
+
+// NewActionResultRetentionClassRoutingBlobAccess creates a decorator
+// for the Action Cache (AC) that stores ActionResult messages in
+// different backends, based on a retention class provided by the
+// client through gRPC request metadata. This makes it possible to
+// retain entries of some builds (e.g., release builds) for a longer
+// amount of time than others. Entries written without a retention
+// class are stored in the default backend. Entries written with a
+// retention class that is not known are rejected.
+//
+// As the retention class of an entry is not known when reading, Get()
+// consults all backends. Entries are not removed from backends when
+// they are overwritten using a different retention class, meaning
+// that multiple backends may contain an entry for the same action.
+// In that case the entry with the most recent worker completed
+// timestamp is returned, so that stale entries don't shadow newer
+// ones. Ties are broken by consulting the backend of the retention
+// class carried by the read request first, followed by the default
+// backend and the backends of the remaining retention classes.
+func NewActionResultRetentionClassRoutingBlobAccess(defaultBackend BlobAccess, metadataHeader string, classBackends map[string]BlobAccess, maximumMessageSizeBytes int) BlobAccess {
+	classNames := make([]string, 0, len(classBackends))
+	for className := range classBackends {
+		classNames = append(classNames, className)
+	}
+	sort.Strings(classNames)
+	return &actionResultRetentionClassRoutingBlobAccess{
+		BlobAccess:              defaultBackend,
+		metadataHeader:          metadataHeader,
+		classBackends:           classBackends,
+		classNames:              classNames,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+	}
+}
+
+// getRetentionClass returns the retention class that is provided as
+// part of the request metadata, if any.
+func (ba *actionResultRetentionClassRoutingBlobAccess) getRetentionClass(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(ba.metadataHeader); len(values) > 0 {
+		return values[0], true
+	}
+	return "", false
+}
+
+// getReadBackends returns the list of backends in the order in which
+// they should be consulted when reading.
+func (ba *actionResultRetentionClassRoutingBlobAccess) getReadBackends(ctx context.Context) []BlobAccess {
+	var requestedBackend BlobAccess
+	if className, ok := ba.getRetentionClass(ctx); ok {
+		requestedBackend = ba.classBackends[className]
+	}
+
+	backends := make([]BlobAccess, 0, len(ba.classNames)+1)
+	if requestedBackend != nil {
+		backends = append(backends, requestedBackend)
+	}
+	backends = append(backends, ba.BlobAccess)
+	for _, className := range ba.classNames {
+		if backend := ba.classBackends[className]; backend != requestedBackend {
+			backends = append(backends, backend)
+		}
+	}
+	return backends
+}
+
+func (ba *actionResultRetentionClassRoutingBlobAccess) Get(ctx context.Context, actionDigest digest.Digest) buffer.Buffer {
+	var newestBuffer buffer.Buffer
+	var newestTimestamp time.Time
+	for _, backend := range ba.getReadBackends(ctx) {
+		b1, b2 := backend.Get(ctx, actionDigest).CloneCopy(ba.maximumMessageSizeBytes)
+		actionResultMessage, err := b1.ToProto(&remoteexecution.ActionResult{}, ba.maximumMessageSizeBytes)
+		if err != nil {
+			b2.Discard()
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			if newestBuffer != nil {
+				newestBuffer.Discard()
+			}
+			return buffer.NewBufferFromError(err)
+		}
+
+		// Entries without a valid worker completed timestamp
+		// are considered to be older than any other entry.
+		var timestamp time.Time
+		if workerCompletedTimestamp := actionResultMessage.(*remoteexecution.ActionResult).ExecutionMetadata.GetWorkerCompletedTimestamp(); workerCompletedTimestamp.CheckValid() == nil {
+			timestamp = workerCompletedTimestamp.AsTime()
+		}
+		if newestBuffer == nil || timestamp.After(newestTimestamp) {
+			if newestBuffer != nil {
+				newestBuffer.Discard()
+			}
+			newestBuffer = b2
+			newestTimestamp = timestamp
+		} else {
+			b2.Discard()
+		}
+	}
+	if newestBuffer == nil {
+		return buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found"))
+	}
+	return newestBuffer
+}
+
+func (ba *actionResultRetentionClassRoutingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.Get(ctx, parentDigest), childDigest)
+	return b
+}
+
+func (ba *actionResultRetentionClassRoutingBlobAccess) Put(ctx context.Context, actionDigest digest.Digest, b buffer.Buffer) error {
+	className, ok := ba.getRetentionClass(ctx)
+	if !ok {
+		return ba.BlobAccess.Put(ctx, actionDigest, b)
+	}
+	backend, ok := ba.classBackends[className]
+	if !ok {
+		b.Discard()
+		return status.Errorf(codes.InvalidArgument, "Unknown retention class %#v", className)
+	}
+	if err := backend.Put(ctx, actionDigest, b); err != nil {
+		return util.StatusWrapf(err, "Retention class %#v", className)
+	}
+	return nil
+}
+
+func (ba *actionResultRetentionClassRoutingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	// Call FindMissing() on the backends sequentially, only
+	// forwarding the digests that are still missing.
+	for _, backend := range ba.getReadBackends(ctx) {
+		if digests.Empty() {
+			break
+		}
+		var err error
+		digests, err = backend.FindMissing(ctx, digests)
+		if err != nil {
+			return digest.EmptySet, err
+		}
+	}
+	return digests, nil
+}
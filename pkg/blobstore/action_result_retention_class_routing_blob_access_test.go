@@ -0,0 +1,199 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestActionResultRetentionClassRoutingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	defaultBackend := mock.NewMockBlobAccess(ctrl)
+	releaseBackend := mock.NewMockBlobAccess(ctrl)
+	scratchBackend := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewActionResultRetentionClassRoutingBlobAccess(
+		defaultBackend,
+		"build.buildbarn.retention-class",
+		map[string]blobstore.BlobAccess{
+			"release": releaseBackend,
+			"scratch": scratchBackend,
+		},
+		10000)
+
+	actionDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "d3b7ed68c99422eaa8ab8184949cba84dd46ddb1b7cf8c777547866d54ebb081", 123)
+	actionResult := &remoteexecution.ActionResult{ExitCode: 1}
+	releaseCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("build.buildbarn.retention-class", "release"))
+
+	expectPut := func(backend *mock.MockBlobAccess, ctx context.Context) {
+		backend.EXPECT().Put(ctx, actionDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, actionDigest digest.Digest, b buffer.Buffer) error {
+				m, err := b.ToProto(&remoteexecution.ActionResult{}, 10000)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, actionResult, m)
+				return nil
+			})
+	}
+
+	t.Run("PutWithoutClass", func(t *testing.T) {
+		// Entries without a retention class should be stored in
+		// the default backend.
+		expectPut(defaultBackend, ctx)
+
+		require.NoError(t, blobAccess.Put(ctx, actionDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+	})
+
+	t.Run("PutWithClass", func(t *testing.T) {
+		expectPut(releaseBackend, releaseCtx)
+
+		require.NoError(t, blobAccess.Put(releaseCtx, actionDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+	})
+
+	t.Run("PutWithUnknownClass", func(t *testing.T) {
+		unknownCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("build.buildbarn.retention-class", "forever"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Unknown retention class \"forever\""),
+			blobAccess.Put(unknownCtx, actionDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+	})
+
+	t.Run("PutFailure", func(t *testing.T) {
+		releaseBackend.EXPECT().Put(releaseCtx, actionDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, actionDigest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.Unavailable, "Server offline")
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Retention class \"release\": Server offline"),
+			blobAccess.Put(releaseCtx, actionDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+	})
+
+	notFound := func() buffer.Buffer {
+		return buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found"))
+	}
+	newActionResultBuffer := func(actionResult *remoteexecution.ActionResult) buffer.Buffer {
+		return buffer.NewProtoBufferFromProto(actionResult, buffer.BackendProvided(buffer.Irreparable(actionDigest)))
+	}
+
+	t.Run("GetWithoutClass", func(t *testing.T) {
+		// Without a retention class, the default backend should
+		// be consulted first, followed by all other backends.
+		gomock.InOrder(
+			defaultBackend.EXPECT().Get(ctx, actionDigest).Return(notFound()),
+			releaseBackend.EXPECT().Get(ctx, actionDigest).Return(newActionResultBuffer(actionResult)),
+			scratchBackend.EXPECT().Get(ctx, actionDigest).Return(notFound()))
+
+		m, err := blobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("GetWithClass", func(t *testing.T) {
+		// The backend of the retention class provided by the
+		// client should be consulted first. If multiple
+		// backends contain an entry without a timestamp, the
+		// one consulted first should be returned.
+		otherActionResult := &remoteexecution.ActionResult{ExitCode: 2}
+		gomock.InOrder(
+			releaseBackend.EXPECT().Get(releaseCtx, actionDigest).Return(newActionResultBuffer(actionResult)),
+			defaultBackend.EXPECT().Get(releaseCtx, actionDigest).Return(newActionResultBuffer(otherActionResult)),
+			scratchBackend.EXPECT().Get(releaseCtx, actionDigest).Return(notFound()))
+
+		m, err := blobAccess.Get(releaseCtx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("GetAcrossClasses", func(t *testing.T) {
+		// Entries written using a different retention class
+		// should still be found.
+		gomock.InOrder(
+			releaseBackend.EXPECT().Get(releaseCtx, actionDigest).Return(notFound()),
+			defaultBackend.EXPECT().Get(releaseCtx, actionDigest).Return(notFound()),
+			scratchBackend.EXPECT().Get(releaseCtx, actionDigest).Return(newActionResultBuffer(actionResult)))
+
+		m, err := blobAccess.Get(releaseCtx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("GetNewest", func(t *testing.T) {
+		// If an entry was overwritten using a different
+		// retention class, the stale entry remains present in
+		// the original backend. The entry with the most recent
+		// worker completed timestamp should be returned, even
+		// if it's stored in a backend that is consulted later.
+		olderActionResult := &remoteexecution.ActionResult{
+			ExitCode: 1,
+			ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+				WorkerCompletedTimestamp: &timestamppb.Timestamp{Seconds: 1000},
+			},
+		}
+		newerActionResult := &remoteexecution.ActionResult{
+			ExitCode: 2,
+			ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+				WorkerCompletedTimestamp: &timestamppb.Timestamp{Seconds: 2000},
+			},
+		}
+		gomock.InOrder(
+			releaseBackend.EXPECT().Get(releaseCtx, actionDigest).Return(newActionResultBuffer(olderActionResult)),
+			defaultBackend.EXPECT().Get(releaseCtx, actionDigest).Return(newActionResultBuffer(actionResult)),
+			scratchBackend.EXPECT().Get(releaseCtx, actionDigest).Return(newActionResultBuffer(newerActionResult)))
+
+		m, err := blobAccess.Get(releaseCtx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newerActionResult, m)
+	})
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		gomock.InOrder(
+			defaultBackend.EXPECT().Get(ctx, actionDigest).Return(notFound()),
+			releaseBackend.EXPECT().Get(ctx, actionDigest).Return(notFound()),
+			scratchBackend.EXPECT().Get(ctx, actionDigest).Return(notFound()))
+
+		_, err := blobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("GetFailure", func(t *testing.T) {
+		// Errors other than NOT_FOUND should not cause other
+		// backends to be consulted, as that could cause stale
+		// entries to be returned.
+		gomock.InOrder(
+			defaultBackend.EXPECT().Get(ctx, actionDigest).Return(newActionResultBuffer(actionResult)),
+			releaseBackend.EXPECT().Get(ctx, actionDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline"))))
+
+		_, err := blobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		otherDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+		allDigests := digest.NewSetBuilder().Add(actionDigest).Add(otherDigest).Build()
+		gomock.InOrder(
+			defaultBackend.EXPECT().FindMissing(ctx, allDigests).Return(allDigests, nil),
+			releaseBackend.EXPECT().FindMissing(ctx, allDigests).Return(otherDigest.ToSingletonSet(), nil),
+			scratchBackend.EXPECT().FindMissing(ctx, otherDigest.ToSingletonSet()).Return(otherDigest.ToSingletonSet(), nil))
+
+		missing, err := blobAccess.FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, otherDigest.ToSingletonSet(), missing)
+	})
+}
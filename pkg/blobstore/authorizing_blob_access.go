@@ -22,6 +22,14 @@ type authorizingBlobAccess struct {
 // accesses by checks with Authorizers. Calls to GetCapabilities() are
 // not checked, for the reason that the exact logic for this differs
 // between the Action Cache (AC) and Content Addressable Storage (CAS).
+//
+// Authorization is performed against the digest function of each
+// request, meaning that Authorizers may make decisions based on both
+// the instance name and the hashing algorithm in use. Requests that are
+// denied are never forwarded to the backend. Calls to FindMissing() are
+// only forwarded if all digest functions in the request are authorized.
+// As empty FindMissing() requests cannot be authorized against any
+// instance name, they are answered without contacting the backend.
 func NewAuthorizingBlobAccess(base BlobAccess, getAuthorizer, putAuthorizer, findMissingAuthorizer auth.Authorizer) BlobAccess {
 	return &authorizingBlobAccess{
 		BlobAccess:            base,
@@ -32,40 +40,44 @@ func NewAuthorizingBlobAccess(base BlobAccess, getAuthorizer, putAuthorizer, fin
 }
 
 func (ba *authorizingBlobAccess) Get(ctx context.Context, d digest.Digest) buffer.Buffer {
-	if err := auth.AuthorizeSingleInstanceName(ctx, ba.getAuthorizer, d.GetInstanceName()); err != nil {
+	if err := auth.AuthorizeSingleDigestFunction(ctx, ba.getAuthorizer, d.GetDigestFunction()); err != nil {
 		return buffer.NewBufferFromError(util.StatusWrap(err, "Authorization"))
 	}
 	return ba.BlobAccess.Get(ctx, d)
 }
 
 func (ba *authorizingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
-	if err := auth.AuthorizeSingleInstanceName(ctx, ba.getAuthorizer, parentDigest.GetInstanceName()); err != nil {
+	if err := auth.AuthorizeSingleDigestFunction(ctx, ba.getAuthorizer, parentDigest.GetDigestFunction()); err != nil {
 		return buffer.NewBufferFromError(util.StatusWrap(err, "Authorization"))
 	}
 	return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
 }
 
 func (ba *authorizingBlobAccess) Put(ctx context.Context, d digest.Digest, b buffer.Buffer) error {
-	if err := auth.AuthorizeSingleInstanceName(ctx, ba.putAuthorizer, d.GetInstanceName()); err != nil {
+	if err := auth.AuthorizeSingleDigestFunction(ctx, ba.putAuthorizer, d.GetDigestFunction()); err != nil {
 		return util.StatusWrap(err, "Authorization")
 	}
 	return ba.BlobAccess.Put(ctx, d, b)
 }
 
 func (ba *authorizingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
-	instanceNamesSet := make(map[digest.InstanceName]struct{})
+	if digests.Empty() {
+		return digest.EmptySet, nil
+	}
+
+	digestFunctionsSet := make(map[digest.Function]struct{})
 	for _, digest := range digests.Items() {
-		instanceNamesSet[digest.GetInstanceName()] = struct{}{}
+		digestFunctionsSet[digest.GetDigestFunction()] = struct{}{}
 	}
-	instanceNames := make([]digest.InstanceName, 0, len(instanceNamesSet))
-	for instanceName := range instanceNamesSet {
-		instanceNames = append(instanceNames, instanceName)
+	digestFunctions := make([]digest.Function, 0, len(digestFunctionsSet))
+	for digestFunction := range digestFunctionsSet {
+		digestFunctions = append(digestFunctions, digestFunction)
 	}
 
-	errs := ba.findMissingAuthorizer.Authorize(ctx, instanceNames)
+	errs := ba.findMissingAuthorizer.AuthorizeDigestFunctions(ctx, digestFunctions)
 	for i, err := range errs {
 		if err != nil {
-			return digest.EmptySet, util.StatusWrapf(err, "Authorization of instance name %#v", instanceNames[i].String())
+			return digest.EmptySet, util.StatusWrapf(err, "Authorization of instance name %#v with digest function %s", digestFunctions[i].GetInstanceName().String(), digestFunctions[i].GetEnumValue())
 		}
 	}
 	return ba.BlobAccess.FindMissing(ctx, digests)
@@ -9,12 +9,17 @@ import (
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/auth"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/jmespath/go-jmespath"
 	"github.com/stretchr/testify/require"
 
+	"google.golang.org/protobuf/types/known/structpb"
+
 	"go.uber.org/mock/gomock"
 )
 
@@ -32,13 +37,13 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 	wantBytes := []byte("European Burmese")
 	wantBuf := buffer.NewValidatedBufferFromByteSlice(wantBytes)
 
-	beep := digest.MustNewInstanceName("beep")
-	beepSlice := []digest.InstanceName{beep}
-	bopBip := digest.MustNewInstanceName("bop/bip")
-	beepBopBipSlice := []digest.InstanceName{beep, bopBip}
+	beep := d.GetDigestFunction()
+	beepSlice := []digest.Function{beep}
+	bopBip := d2.GetDigestFunction()
+	beepBopBipSlice := []digest.Function{beep, bopBip}
 
 	t.Run("Get-Allowed", func(t *testing.T) {
-		getAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{nil})
+		getAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, beepSlice).Return([]error{nil})
 		baseBlobAccess.EXPECT().Get(ctx, d).Return(wantBuf)
 
 		gotBuf, err := ba.Get(ctx, d).ToByteSlice(30)
@@ -47,14 +52,14 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 	})
 
 	t.Run("Get-Denied", func(t *testing.T) {
-		getAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+		getAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
 
 		_, err := ba.Get(ctx, d).ToByteSlice(30)
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
 	})
 
 	t.Run("GetFromComposite-Allowed", func(t *testing.T) {
-		getAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{nil})
+		getAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, beepSlice).Return([]error{nil})
 		blobSlicer := mock.NewMockBlobSlicer(ctrl)
 		baseBlobAccess.EXPECT().GetFromComposite(ctx, d, d2, blobSlicer).Return(wantBuf)
 
@@ -65,14 +70,14 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 
 	t.Run("GetFromComposite-Denied", func(t *testing.T) {
 		blobSlicer := mock.NewMockBlobSlicer(ctrl)
-		getAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+		getAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
 
 		_, err := ba.GetFromComposite(ctx, d, d2, blobSlicer).ToByteSlice(30)
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
 	})
 
 	t.Run("Put-Allowed", func(t *testing.T) {
-		putAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{nil})
+		putAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, beepSlice).Return([]error{nil})
 		baseBlobAccess.EXPECT().Put(ctx, d, wantBuf).Return(nil)
 
 		err := ba.Put(ctx, d, wantBuf)
@@ -80,14 +85,14 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 	})
 
 	t.Run("Put-Denied", func(t *testing.T) {
-		putAuthorizer.EXPECT().Authorize(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+		putAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
 
 		err := ba.Put(ctx, d, wantBuf)
 		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
 	})
 
 	t.Run("FindMissing-Allowed", func(t *testing.T) {
-		findMissingAuthorizer.EXPECT().Authorize(ctx, gomock.InAnyOrder(beepBopBipSlice)).Return([]error{nil, nil})
+		findMissingAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, gomock.InAnyOrder(beepBopBipSlice)).Return([]error{nil, nil})
 		baseBlobAccess.EXPECT().FindMissing(ctx, digests).Return(d2.ToSingletonSet(), nil)
 
 		missing, err := ba.FindMissing(ctx, digests)
@@ -96,10 +101,10 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 	})
 
 	t.Run("FindMissing-PartiallyDenied", func(t *testing.T) {
-		findMissingAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, instanceNames []digest.InstanceName) []error {
+		findMissingAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, digestFunctions []digest.Function) []error {
 			wantErr := status.Error(codes.PermissionDenied, "You shall not pass")
-			require.ElementsMatch(t, instanceNames, beepBopBipSlice)
-			if instanceNames[0] == beep {
+			require.ElementsMatch(t, digestFunctions, beepBopBipSlice)
+			if digestFunctions[0] == beep {
 				return []error{nil, wantErr}
 			} else {
 				return []error{wantErr, nil}
@@ -107,6 +112,87 @@ func TestAuthorizingBlobAccess(t *testing.T) {
 		})
 
 		_, err := ba.FindMissing(ctx, digests)
-		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization of instance name \"bop/bip\": You shall not pass"), err)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization of instance name \"bop/bip\" with digest function SHA256: You shall not pass"), err)
+	})
+
+	t.Run("FindMissing-Denied", func(t *testing.T) {
+		// Denied requests should not be forwarded to the
+		// backend.
+		findMissingAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, beepSlice).Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+
+		_, err := ba.FindMissing(ctx, d.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization of instance name \"beep\" with digest function SHA256: You shall not pass"), err)
+	})
+
+	t.Run("FindMissing-Empty", func(t *testing.T) {
+		// Empty requests cannot be authorized against any
+		// instance name. They should be answered without
+		// contacting the backend.
+		missing, err := ba.FindMissing(ctx, digest.EmptySet)
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+}
+
+func TestAuthorizingBlobAccessPerDigestFunction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Grant access based on both the instance name and the digest
+	// function, as opposed to only permitting or denying the Get()
+	// method as a whole.
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	getAuthorizer := auth.NewJMESPathExpressionAuthorizer(jmespath.MustCompile(
+		"contains(authenticationMetadata.private.permitted, join(':', [instanceName, digestFunction]))"))
+	denyAuthorizer := auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return false })
+	ba := blobstore.NewAuthorizingBlobAccess(baseBlobAccess, getAuthorizer, denyAuthorizer, denyAuthorizer)
+
+	ctx := auth.NewContextWithAuthenticationMetadata(context.Background(), auth.MustNewAuthenticationMetadataFromProto(&auth_pb.AuthenticationMetadata{
+		Private: structpb.NewStructValue(&structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"permitted": structpb.NewListValue(&structpb.ListValue{
+					Values: []*structpb.Value{
+						structpb.NewStringValue("beep:SHA256"),
+					},
+				}),
+			},
+		}),
+	}))
+
+	t.Run("Allowed", func(t *testing.T) {
+		d := digest.MustNewDigest("beep", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+		baseBlobAccess.EXPECT().Get(ctx, d).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := ba.Get(ctx, d).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("DeniedDigestFunction", func(t *testing.T) {
+		// The instance name matches, but blobs using MD5 may
+		// not be accessed.
+		d := digest.MustNewDigest("beep", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+		_, err := ba.Get(ctx, d).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("DeniedInstanceName", func(t *testing.T) {
+		d := digest.MustNewDigest("bop", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+		_, err := ba.Get(ctx, d).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+	})
+
+	t.Run("DeniedMethods", func(t *testing.T) {
+		// Denials of Put() and FindMissing() should be reported
+		// with the same code as denials of Get(), without
+		// contacting the backend.
+		d := digest.MustNewDigest("beep", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+		err := ba.Put(ctx, d, buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: Permission denied"), err)
+
+		_, err = ba.FindMissing(ctx, d.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization of instance name \"beep\" with digest function SHA256: Permission denied"), err)
 	})
 }
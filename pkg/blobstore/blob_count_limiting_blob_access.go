@@ -0,0 +1,131 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type blobCountLimit struct {
+	instanceName digest.InstanceName
+	maximumBlobs int64
+
+	lock         sync.Mutex
+	windowStart  time.Time
+	windowIndex  uint64
+	blobsWritten int64
+}
+
+type blobCountLimitingBlobAccess struct {
+	BlobAccess
+	clock      clock.Clock
+	window     time.Duration
+	limitsTrie *digest.InstanceNameTrie
+	limits     []*blobCountLimit
+}
+
+// NewBlobCountLimitingBlobAccess creates a decorator for BlobAccess
+// that limits the number of objects that may be written for a given
+// instance name within a window of time. This can be used to prevent
+// individual tenants from creating excessive numbers of small objects,
+// which may bloat the index of the storage backend. Once a limit has
+// been reached, Put() operations fail with code RESOURCE_EXHAUSTED
+// until the current window ends. Reads are permitted to continue.
+//
+// Limits are keyed by instance name prefix, where the longest
+// matching prefix is used. Objects written for instance names that
+// don't match any of the prefixes are not limited.
+//
+// This decorator acts as a rate limit on the creation of new objects,
+// as opposed to limiting the number of objects that are present in
+// storage. Prior to counting a write, FindMissing() is called to
+// determine whether the object is already present. Writes of objects
+// that are already present are forwarded without being counted.
+func NewBlobCountLimitingBlobAccess(base BlobAccess, clock clock.Clock, window time.Duration, maximumBlobs map[digest.InstanceName]int64) BlobAccess {
+	ba := &blobCountLimitingBlobAccess{
+		BlobAccess: base,
+		clock:      clock,
+		window:     window,
+		limitsTrie: digest.NewInstanceNameTrie(),
+		limits:     make([]*blobCountLimit, 0, len(maximumBlobs)),
+	}
+	now := clock.Now()
+	for instanceName, maximum := range maximumBlobs {
+		ba.limitsTrie.Set(instanceName, len(ba.limits))
+		ba.limits = append(ba.limits, &blobCountLimit{
+			instanceName: instanceName,
+			maximumBlobs: maximum,
+			windowStart:  now,
+		})
+	}
+	return ba
+}
+
+// reserve a slot for writing an object within the current window. It
+// returns the index of the window in which the slot was reserved, so
+// that it can be released if writing the object fails.
+func (ba *blobCountLimitingBlobAccess) reserve(limit *blobCountLimit) (uint64, bool) {
+	now := ba.clock.Now()
+	limit.lock.Lock()
+	defer limit.lock.Unlock()
+
+	if !now.Before(limit.windowStart.Add(ba.window)) {
+		limit.windowStart = now
+		limit.windowIndex++
+		limit.blobsWritten = 0
+	}
+	if limit.blobsWritten >= limit.maximumBlobs {
+		return 0, false
+	}
+	limit.blobsWritten++
+	return limit.windowIndex, true
+}
+
+func (ba *blobCountLimitingBlobAccess) release(limit *blobCountLimit, windowIndex uint64) {
+	limit.lock.Lock()
+	defer limit.lock.Unlock()
+
+	if limit.windowIndex == windowIndex {
+		limit.blobsWritten--
+	}
+}
+
+func (ba *blobCountLimitingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	idx := ba.limitsTrie.GetLongestPrefix(blobDigest.GetInstanceName())
+	if idx < 0 {
+		return ba.BlobAccess.Put(ctx, blobDigest, b)
+	}
+
+	// Writes of objects that are already present don't cause the
+	// number of objects to increase. Don't count these.
+	missing, err := ba.BlobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+	if err != nil {
+		b.Discard()
+		return util.StatusWrap(err, "Failed to determine whether object is present")
+	}
+	if missing.Empty() {
+		return ba.BlobAccess.Put(ctx, blobDigest, b)
+	}
+
+	// Reserve a slot prior to writing the object, so that
+	// concurrent writes cannot cause the limit to be exceeded.
+	limit := ba.limits[idx]
+	windowIndex, ok := ba.reserve(limit)
+	if !ok {
+		b.Discard()
+		return status.Errorf(codes.ResourceExhausted, "Instance name %#v has reached its limit of %d new objects per %s", limit.instanceName.String(), limit.maximumBlobs, ba.window)
+	}
+	if err := ba.BlobAccess.Put(ctx, blobDigest, b); err != nil {
+		ba.release(limit, windowIndex)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBlobCountLimitingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	blobAccess := blobstore.NewBlobCountLimitingBlobAccess(
+		baseBlobAccess,
+		clock,
+		time.Hour,
+		map[digest.InstanceName]int64{
+			digest.MustNewInstanceName("tenant1"):       2,
+			digest.MustNewInstanceName("tenant1/small"): 1,
+		})
+
+	newDigest := func(instanceName, hash string) digest.Digest {
+		return digest.MustNewDigest(instanceName, remoteexecution.DigestFunction_MD5, hash, 5)
+	}
+	expectMissing := func(blobDigest digest.Digest) {
+		baseBlobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+		clock.EXPECT().Now().Return(time.Unix(1100, 0))
+	}
+	expectPut := func(blobDigest digest.Digest, err error) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, readErr := b.ToByteSlice(100)
+				require.NoError(t, readErr)
+				require.Equal(t, []byte("Hello"), data)
+				return err
+			})
+	}
+
+	t.Run("UnderLimit", func(t *testing.T) {
+		blobDigest := newDigest("tenant1", "8b1a9953c4611296a827abf8c47804d7")
+		expectMissing(blobDigest)
+		expectPut(blobDigest, nil)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("FindMissingFailure", func(t *testing.T) {
+		blobDigest := newDigest("tenant1", "8b1a9953c4611296a827abf8c47804d7")
+		baseBlobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Failed to determine whether object is present: Server offline"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		// Failed writes should not count towards the limit.
+		blobDigest := newDigest("tenant1/a", "8b1a9953c4611296a827abf8c47804d7")
+		expectMissing(blobDigest)
+		expectPut(blobDigest, status.Error(codes.Unavailable, "Server offline"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Server offline"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("ReachingLimit", func(t *testing.T) {
+		// Instance names are matched by prefix, meaning that
+		// writes for "tenant1/a" count towards the limit of
+		// "tenant1".
+		blobDigest := newDigest("tenant1/a", "8b1a9953c4611296a827abf8c47804d7")
+		expectMissing(blobDigest)
+		expectPut(blobDigest, nil)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("OverLimit", func(t *testing.T) {
+		blobDigest := newDigest("tenant1/b", "8b1a9953c4611296a827abf8c47804d7")
+		expectMissing(blobDigest)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Instance name \"tenant1\" has reached its limit of 2 new objects per 1h0m0s"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("AlreadyPresent", func(t *testing.T) {
+		// Writes of objects that are already present should
+		// not be counted, and should be permitted even if the
+		// limit has been reached.
+		blobDigest := newDigest("tenant1/b", "8b1a9953c4611296a827abf8c47804d7")
+		baseBlobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		expectPut(blobDigest, nil)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("SeparateLimits", func(t *testing.T) {
+		// The limit of "tenant1/small" should be tracked
+		// separately from the one of "tenant1".
+		blobDigest := newDigest("tenant1/small", "8b1a9953c4611296a827abf8c47804d7")
+		expectMissing(blobDigest)
+		expectPut(blobDigest, nil)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		blobDigest = newDigest("tenant1/small/x", "8b1a9953c4611296a827abf8c47804d7")
+		expectMissing(blobDigest)
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Instance name \"tenant1/small\" has reached its limit of 1 new objects per 1h0m0s"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("NextWindow", func(t *testing.T) {
+		// Once the window has ended, the count should be
+		// reset, permitting new objects to be written again.
+		blobDigest := newDigest("tenant1/small", "8b1a9953c4611296a827abf8c47804d7")
+		baseBlobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+		clock.EXPECT().Now().Return(time.Unix(4600, 0))
+		expectPut(blobDigest, nil)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("Unlimited", func(t *testing.T) {
+		// Instance names that don't match any of the prefixes
+		// should not be limited.
+		for i := 0; i < 5; i++ {
+			blobDigest := newDigest("tenant2", "8b1a9953c4611296a827abf8c47804d7")
+			expectPut(blobDigest, nil)
+
+			require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		}
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		// Reads should continue to work for instance names
+		// that have reached their limit.
+		blobDigest := newDigest("tenant1", "8b1a9953c4611296a827abf8c47804d7")
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+}
@@ -0,0 +1,98 @@
+package buffer
+
+import (
+	"io"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type releaseHandlingBuffer struct {
+	base      Buffer
+	onRelease func()
+}
+
+// WithReleaseHandler wraps a Buffer, so that a callback is invoked
+// every time one of the functions that release the buffer is called.
+// This includes functions that consume the buffer's contents (e.g.,
+// ToByteSlice() and ToReader()), functions that hand off ownership to
+// new buffers (CloneCopy() and CloneStream()) and Discard().
+//
+// This function can be used to detect buffer lifecycle bugs, such as
+// buffers that are leaked or released multiple times. It should not be
+// used for other purposes.
+func WithReleaseHandler(b Buffer, onRelease func()) Buffer {
+	return &releaseHandlingBuffer{
+		base:      b,
+		onRelease: onRelease,
+	}
+}
+
+func (b *releaseHandlingBuffer) GetSizeBytes() (int64, error) {
+	return b.base.GetSizeBytes()
+}
+
+func (b *releaseHandlingBuffer) IntoWriter(w io.Writer) error {
+	b.onRelease()
+	return b.base.IntoWriter(w)
+}
+
+func (b *releaseHandlingBuffer) ReadAt(p []byte, off int64) (int, error) {
+	b.onRelease()
+	return b.base.ReadAt(p, off)
+}
+
+func (b *releaseHandlingBuffer) ToProto(m proto.Message, maximumSizeBytes int) (proto.Message, error) {
+	b.onRelease()
+	return b.base.ToProto(m, maximumSizeBytes)
+}
+
+func (b *releaseHandlingBuffer) ToByteSlice(maximumSizeBytes int) ([]byte, error) {
+	b.onRelease()
+	return b.base.ToByteSlice(maximumSizeBytes)
+}
+
+func (b *releaseHandlingBuffer) ToChunkReader(off int64, maximumChunkSizeBytes int) ChunkReader {
+	b.onRelease()
+	return b.base.ToChunkReader(off, maximumChunkSizeBytes)
+}
+
+func (b *releaseHandlingBuffer) ToReader() io.ReadCloser {
+	b.onRelease()
+	return b.base.ToReader()
+}
+
+func (b *releaseHandlingBuffer) CloneCopy(maximumSizeBytes int) (Buffer, Buffer) {
+	b.onRelease()
+	return b.base.CloneCopy(maximumSizeBytes)
+}
+
+func (b *releaseHandlingBuffer) CloneStream() (Buffer, Buffer) {
+	b.onRelease()
+	return b.base.CloneStream()
+}
+
+func (b *releaseHandlingBuffer) WithTask(task func() error) Buffer {
+	// Attaching a task does not release the buffer. Ensure that the
+	// resulting buffer continues to be tracked.
+	return WithReleaseHandler(b.base.WithTask(task), b.onRelease)
+}
+
+func (b *releaseHandlingBuffer) Discard() {
+	b.onRelease()
+	b.base.Discard()
+}
+
+func (b *releaseHandlingBuffer) applyErrorHandler(errorHandler ErrorHandler) (Buffer, bool) {
+	replacement, shouldRetry := b.base.applyErrorHandler(errorHandler)
+	return WithReleaseHandler(replacement, b.onRelease), shouldRetry
+}
+
+func (b *releaseHandlingBuffer) toUnvalidatedChunkReader(off int64, maximumChunkSizeBytes int) ChunkReader {
+	b.onRelease()
+	return b.base.toUnvalidatedChunkReader(off, maximumChunkSizeBytes)
+}
+
+func (b *releaseHandlingBuffer) toUnvalidatedReader(off int64) io.ReadCloser {
+	b.onRelease()
+	return b.base.toUnvalidatedReader(off)
+}
@@ -0,0 +1,69 @@
+package buffer_test
+
+import (
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestWithReleaseHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Run("ToByteSlice", func(t *testing.T) {
+		releaseCount := 0
+		b := buffer.WithReleaseHandler(
+			buffer.NewValidatedBufferFromByteSlice([]byte("Hello")),
+			func() { releaseCount++ })
+		size, err := b.GetSizeBytes()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), size)
+		require.Equal(t, 0, releaseCount)
+
+		data, err := b.ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.Equal(t, 1, releaseCount)
+	})
+
+	t.Run("WithErrorHandler", func(t *testing.T) {
+		// Buffers that are returned by error handlers should
+		// continue to be tracked.
+		errorHandler := mock.NewMockErrorHandler(ctrl)
+		errorHandler.EXPECT().OnError(testutil.EqStatus(t, status.Error(codes.Internal, "Disk on fire"))).
+			Return(nil, status.Error(codes.Internal, "Disk on fire"))
+		errorHandler.EXPECT().Done()
+
+		releaseCount := 0
+		b := buffer.WithErrorHandler(
+			buffer.WithReleaseHandler(
+				buffer.NewBufferFromError(status.Error(codes.Internal, "Disk on fire")),
+				func() { releaseCount++ }),
+			errorHandler)
+		require.Equal(t, 0, releaseCount)
+
+		_, err := b.ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Disk on fire"), err)
+		require.Equal(t, 1, releaseCount)
+	})
+
+	t.Run("CloneStream", func(t *testing.T) {
+		// Cloning hands off ownership to the resulting
+		// buffers, meaning the original buffer is released.
+		releaseCount := 0
+		b1, b2 := buffer.WithReleaseHandler(
+			buffer.NewValidatedBufferFromByteSlice([]byte("Hello")),
+			func() { releaseCount++ }).CloneStream()
+		require.Equal(t, 1, releaseCount)
+		b1.Discard()
+		b2.Discard()
+		require.Equal(t, 1, releaseCount)
+	})
+}
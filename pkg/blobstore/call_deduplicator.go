@@ -0,0 +1,84 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type inFlightCall[T any] struct {
+	finished <-chan struct{}
+	result   T
+	err      error
+}
+
+// callDeduplicator is used by decorators for BlobAccess to merge
+// concurrent calls against a backend that are identified by the same
+// key. Only a single call is made against the backend, whose results
+// are shared with all callers. Calls are only merged while a call
+// against the backend is in flight. Results are not cached afterwards.
+type callDeduplicator[K comparable, T any] struct {
+	lock     sync.Mutex
+	inFlight map[K]*inFlightCall[T]
+}
+
+func newCallDeduplicator[K comparable, T any]() callDeduplicator[K, T] {
+	return callDeduplicator[K, T]{
+		inFlight: map[K]*inFlightCall[T]{},
+	}
+}
+
+// call performs a call against the backend by invoking execute, unless
+// a call for the same key is already in flight. In that case it waits
+// for that call to complete and returns its results. If the call in
+// flight fails with an error for which shouldRetry returns true, the
+// call is retried. This ensures that the cancelation of one client's
+// request does not cause requests of other clients to fail.
+//
+// In addition to the results of the call, it is returned whether
+// execute was invoked by the current caller.
+func (d *callDeduplicator[K, T]) call(ctx context.Context, key K, shouldRetry func(err error) bool, execute func() (T, error)) (T, bool, error) {
+	d.lock.Lock()
+	for {
+		call, ok := d.inFlight[key]
+		if !ok {
+			// No identical call is in flight right now,
+			// meaning that it's our turn.
+			break
+		}
+		d.lock.Unlock()
+
+		// Wait for the identical call to complete. Share its
+		// results, unless it needs to be retried.
+		select {
+		case <-call.finished:
+			if call.err == nil || !shouldRetry(call.err) {
+				return call.result, false, call.err
+			}
+			d.lock.Lock()
+		case <-ctx.Done():
+			var zero T
+			return zero, false, util.StatusFromContext(ctx)
+		}
+	}
+	finished := make(chan struct{})
+	call := &inFlightCall[T]{finished: finished}
+	d.inFlight[key] = call
+	d.lock.Unlock()
+
+	call.result, call.err = execute()
+
+	// Wake up other callers.
+	d.lock.Lock()
+	delete(d.inFlight, key)
+	d.lock.Unlock()
+	close(finished)
+	return call.result, true, call.err
+}
+
+// alwaysRetry can be provided to callDeduplicator.call() to let callers
+// retry whenever the call in flight fails.
+func alwaysRetry(err error) bool {
+	return true
+}
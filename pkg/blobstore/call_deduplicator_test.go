@@ -0,0 +1,71 @@
+package blobstore_test
+
+import (
+	"context"
+	"sync"
+)
+
+// Test fixtures shared by the tests of decorators that merge
+// concurrent calls against a backend, such as
+// FindMissingDeduplicatingBlobAccess, GetDeduplicatingBlobAccess and
+// PutDeduplicatingBlobAccess.
+
+// waitingContext is a decorator for Context that reports when Done()
+// is called for the first time. This is used to determine when a call
+// has started waiting for an identical call to complete.
+type waitingContext struct {
+	context.Context
+	once    sync.Once
+	waiting chan struct{}
+}
+
+func newWaitingContext(ctx context.Context) *waitingContext {
+	return &waitingContext{
+		Context: ctx,
+		waiting: make(chan struct{}),
+	}
+}
+
+func (ctx *waitingContext) Done() <-chan struct{} {
+	ctx.once.Do(func() { close(ctx.waiting) })
+	return ctx.Context.Done()
+}
+
+// blockingCall can be used by mocked backends to block until released,
+// so that other calls can be made while a call is in flight.
+type blockingCall struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func newBlockingCall() blockingCall {
+	return blockingCall{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+}
+
+// block is called by the mocked backend. It reports that the call has
+// started, and waits for it to be released.
+func (c blockingCall) block() {
+	close(c.started)
+	<-c.release
+}
+
+// callAsync invokes a function asynchronously, returning a channel
+// that yields its result.
+func callAsync[T any](f func() T) <-chan T {
+	results := make(chan T, 1)
+	go func() {
+		results <- f()
+	}()
+	return results
+}
+
+// attachAsync invokes a function asynchronously using a waitingContext,
+// and waits until it has started waiting for a call that is in flight.
+func attachAsync[T any](ctx *waitingContext, f func(ctx context.Context) T) <-chan T {
+	results := callAsync(func() T { return f(ctx) })
+	<-ctx.waiting
+	return results
+}
@@ -11,22 +11,47 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protowire"
 )
 
 // findMissingQueue is a helper for calling BlobAccess.FindMissing() in
-// batches, as opposed to calling it for individual digests.
+// batches, as opposed to calling it for individual digests. If a
+// group is provided, batches are checked concurrently.
 type findMissingQueue struct {
 	context                   context.Context
 	digestFunction            digest.Function
 	contentAddressableStorage blobstore.BlobAccess
 	batchSize                 int
 
+	group        *errgroup.Group
+	groupContext context.Context
+	cancel       context.CancelFunc
+
 	pending digest.SetBuilder
 }
 
+func newFindMissingQueue(ctx context.Context, digestFunction digest.Function, contentAddressableStorage blobstore.BlobAccess, batchSize, maximumConcurrency int) *findMissingQueue {
+	q := &findMissingQueue{
+		context:                   ctx,
+		digestFunction:            digestFunction,
+		contentAddressableStorage: contentAddressableStorage,
+		batchSize:                 batchSize,
+		pending:                   digest.NewSetBuilder(),
+	}
+	if maximumConcurrency > 1 {
+		// Cancel calls that are still in flight as soon as one
+		// of them reports that an object is missing.
+		groupContext, cancel := context.WithCancel(ctx)
+		q.group, q.groupContext = errgroup.WithContext(groupContext)
+		q.group.SetLimit(maximumConcurrency)
+		q.cancel = cancel
+	}
+	return q
+}
+
 // deriveDigest converts a digest embedded into an action result from
 // the wire format to an in-memory representation. If that fails, we
 // assume that some data corruption has occurred. In that case, we
@@ -49,19 +74,18 @@ func (q *findMissingQueue) add(blobDigest *remoteexecution.Digest) error {
 		}
 
 		if q.pending.Length() >= q.batchSize {
-			if err := q.finalize(); err != nil {
+			if err := q.flush(); err != nil {
 				return err
 			}
-			q.pending = digest.NewSetBuilder()
 		}
 		q.pending.Add(derivedDigest)
 	}
 	return nil
 }
 
-// Finalize by checking the last batch of digests for existence.
-func (q *findMissingQueue) finalize() error {
-	missing, err := q.contentAddressableStorage.FindMissing(q.context, q.pending.Build())
+// checkBatch calls FindMissing() for a single batch of digests.
+func (q *findMissingQueue) checkBatch(ctx context.Context, digests digest.Set) error {
+	missing, err := q.contentAddressableStorage.FindMissing(ctx, digests)
 	if err != nil {
 		return util.StatusWrap(err, "Failed to determine existence of child objects")
 	}
@@ -71,12 +95,77 @@ func (q *findMissingQueue) finalize() error {
 	return nil
 }
 
+// flush the pending batch of digests, either by checking it directly,
+// or by scheduling it to be checked concurrently. If one of the
+// batches checked previously contained missing objects, this function
+// fails without scheduling any further work.
+func (q *findMissingQueue) flush() error {
+	digests := q.pending.Build()
+	q.pending = digest.NewSetBuilder()
+	if q.group == nil {
+		return q.checkBatch(q.context, digests)
+	}
+	if q.groupContext.Err() != nil {
+		return q.group.Wait()
+	}
+	q.group.Go(func() error {
+		// Don't perform any work if another batch reported
+		// missing objects while this batch was waiting to be
+		// scheduled.
+		if err := q.groupContext.Err(); err != nil {
+			return err
+		}
+		return q.checkBatch(q.groupContext, digests)
+	})
+	return nil
+}
+
+// Finalize by checking the last batch of digests for existence, and
+// waiting for all batches to be checked.
+func (q *findMissingQueue) finalize() error {
+	if err := q.flush(); err != nil {
+		return err
+	}
+	if q.group == nil {
+		return nil
+	}
+	return q.group.Wait()
+}
+
+// close cancels any calls that are still in flight, and waits for them
+// to complete. This needs to be called to prevent leaking goroutines
+// when checking completes prematurely.
+func (q *findMissingQueue) close() {
+	if q.group != nil {
+		q.cancel()
+		q.group.Wait()
+	}
+}
+
+// Level determines which objects referenced by an ActionResult are
+// checked for existence by CompletenessCheckingBlobAccess.
+type Level int
+
+const (
+	// LevelFull causes all objects referenced by the ActionResult
+	// to be checked, including standard output, standard error and
+	// the contents of output directories.
+	LevelFull Level = iota
+	// LevelOutputFiles causes only output files to be checked.
+	// Standard output, standard error and output directories are
+	// returned without checking their existence.
+	LevelOutputFiles
+)
+
 type completenessCheckingBlobAccess struct {
 	blobstore.BlobAccess
 	contentAddressableStorage blobstore.BlobAccess
 	batchSize                 int
+	maximumConcurrency        int
 	maximumMessageSizeBytes   int
 	maximumTotalTreeSizeBytes int64
+	isRelaxed                 digest.InstanceNameMatcher
+	level                     Level
 }
 
 // NewCompletenessCheckingBlobAccess creates a wrapper around
@@ -93,24 +182,38 @@ type completenessCheckingBlobAccess struct {
 // needs to be rebuilt. By calling it, Bazel indicates that all
 // associated output files must remain present during the build for
 // forward progress to be made.
-func NewCompletenessCheckingBlobAccess(actionCache, contentAddressableStorage blobstore.BlobAccess, batchSize, maximumMessageSizeBytes int, maximumTotalTreeSizeBytes int64) blobstore.BlobAccess {
+//
+// Completeness checking can be disabled for a subset of instance names
+// by providing a matcher. ActionResult entries for instance names that
+// match are returned without checking the existence of the objects
+// they reference. This can be used for caches where speed is preferred
+// over correctness, such as ones used for development.
+//
+// Objects are checked for existence by calling FindMissing() against
+// the CAS in batches of at most batchSize digests. If
+// maximumConcurrency is larger than one, multiple batches are checked
+// concurrently. Checking stops as soon as one batch reports an object
+// as missing.
+//
+// The level determines which of the referenced objects are checked.
+// Only checking output files is less thorough, but avoids loading Tree
+// objects from the CAS.
+func NewCompletenessCheckingBlobAccess(actionCache, contentAddressableStorage blobstore.BlobAccess, batchSize, maximumConcurrency, maximumMessageSizeBytes int, maximumTotalTreeSizeBytes int64, isRelaxed digest.InstanceNameMatcher, level Level) blobstore.BlobAccess {
 	return &completenessCheckingBlobAccess{
 		BlobAccess:                actionCache,
 		contentAddressableStorage: contentAddressableStorage,
 		batchSize:                 batchSize,
+		maximumConcurrency:        maximumConcurrency,
 		maximumMessageSizeBytes:   maximumMessageSizeBytes,
 		maximumTotalTreeSizeBytes: maximumTotalTreeSizeBytes,
+		isRelaxed:                 isRelaxed,
+		level:                     level,
 	}
 }
 
 func (ba *completenessCheckingBlobAccess) checkCompleteness(ctx context.Context, digestFunction digest.Function, actionResult *remoteexecution.ActionResult) error {
-	findMissingQueue := findMissingQueue{
-		context:                   ctx,
-		digestFunction:            digestFunction,
-		contentAddressableStorage: ba.contentAddressableStorage,
-		batchSize:                 ba.batchSize,
-		pending:                   digest.NewSetBuilder(),
-	}
+	findMissingQueue := newFindMissingQueue(ctx, digestFunction, ba.contentAddressableStorage, ba.batchSize, ba.maximumConcurrency)
+	defer findMissingQueue.close()
 
 	// Iterate over all remoteexecution.Digest fields contained
 	// within the ActionResult. Check the existence of output
@@ -122,6 +225,9 @@ func (ba *completenessCheckingBlobAccess) checkCompleteness(ctx context.Context,
 			return err
 		}
 	}
+	if ba.level == LevelOutputFiles {
+		return findMissingQueue.finalize()
+	}
 	for _, outputDirectory := range actionResult.OutputDirectories {
 		if err := findMissingQueue.add(outputDirectory.TreeDigest); err != nil {
 			return err
@@ -201,6 +307,9 @@ func (ba *completenessCheckingBlobAccess) checkCompleteness(ctx context.Context,
 }
 
 func (ba *completenessCheckingBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
+	if ba.isRelaxed(digest.GetInstanceName()) {
+		return ba.BlobAccess.Get(ctx, digest)
+	}
 	b1, b2 := ba.BlobAccess.Get(ctx, digest).CloneCopy(ba.maximumMessageSizeBytes)
 	actionResult, err := b1.ToProto(&remoteexecution.ActionResult{}, ba.maximumMessageSizeBytes)
 	if err != nil {
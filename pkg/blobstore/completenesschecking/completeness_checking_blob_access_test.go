@@ -2,8 +2,11 @@ package completenesschecking_test
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"sync"
 	"testing"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
@@ -11,6 +14,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/completenesschecking"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/grpc/codes"
@@ -25,12 +29,17 @@ func TestCompletenessCheckingBlobAccess(t *testing.T) {
 
 	actionCache := mock.NewMockBlobAccess(ctrl)
 	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	relaxedInstanceNames := digest.NewInstanceNameTrie()
+	relaxedInstanceNames.Set(digest.MustNewInstanceName("dev"), 0)
 	completenessCheckingBlobAccess := completenesschecking.NewCompletenessCheckingBlobAccess(
 		actionCache,
 		contentAddressableStorage,
 		/* batchSize = */ 5,
+		/* maximumConcurrency = */ 1,
 		/* maximumMessageSizeBytes = */ 1000,
-		/* maximumTotalTreeSizeBytes = */ 10000)
+		/* maximumTotalTreeSizeBytes = */ 10000,
+		relaxedInstanceNames.ContainsPrefix,
+		completenesschecking.LevelFull)
 
 	actionDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "d41d8cd98f00b204e9800998ecf8427e", 123)
 
@@ -392,4 +401,230 @@ func TestCompletenessCheckingBlobAccess(t *testing.T) {
 		require.NoError(t, err)
 		testutil.RequireEqualProto(t, &actionResult, actualResult)
 	})
+
+	t.Run("RelaxedInstanceName", func(t *testing.T) {
+		// Instance names for which completeness checking is
+		// relaxed should have their ActionResult returned as
+		// is, without checking the existence of the objects
+		// that it references.
+		relaxedActionDigest := digest.MustNewDigest("dev/alice", remoteexecution.DigestFunction_MD5, "d41d8cd98f00b204e9800998ecf8427e", 123)
+		actionResult := &remoteexecution.ActionResult{
+			StdoutDigest: &remoteexecution.Digest{
+				Hash:      "136de6de72514772b9302d4776e5c3d2",
+				SizeBytes: 4,
+			},
+		}
+		actionCache.EXPECT().Get(ctx, relaxedActionDigest).Return(buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided))
+
+		m, err := completenessCheckingBlobAccess.Get(ctx, relaxedActionDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("CompleteInstanceName", func(t *testing.T) {
+		// Instance names that merely share a string prefix
+		// with a relaxed instance name should still be checked.
+		completeActionDigest := digest.MustNewDigest("devtools", remoteexecution.DigestFunction_MD5, "d41d8cd98f00b204e9800998ecf8427e", 123)
+		actionResult := &remoteexecution.ActionResult{
+			StdoutDigest: &remoteexecution.Digest{
+				Hash:      "136de6de72514772b9302d4776e5c3d2",
+				SizeBytes: 4,
+			},
+		}
+		actionCache.EXPECT().Get(ctx, completeActionDigest).Return(buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided))
+		contentAddressableStorage.EXPECT().FindMissing(
+			ctx,
+			digest.MustNewDigest("devtools", remoteexecution.DigestFunction_MD5, "136de6de72514772b9302d4776e5c3d2", 4).ToSingletonSet(),
+		).Return(digest.EmptySet, nil)
+
+		m, err := completenessCheckingBlobAccess.Get(ctx, completeActionDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+}
+
+func TestCompletenessCheckingBlobAccessConcurrent(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	actionCache := mock.NewMockBlobAccess(ctrl)
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	actionDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "d41d8cd98f00b204e9800998ecf8427e", 123)
+
+	// Create an ActionResult with 20 output files, which are
+	// checked for existence in four batches of five.
+	actionResult := &remoteexecution.ActionResult{}
+	batches := make([]digest.Set, 4)
+	for i := range batches {
+		batch := digest.NewSetBuilder()
+		for j := 0; j < 5; j++ {
+			hash := fmt.Sprintf("%032x", i*5+j+1)
+			actionResult.OutputFiles = append(actionResult.OutputFiles, &remoteexecution.OutputFile{
+				Path:   fmt.Sprintf("bazel-out/%d.o", i*5+j),
+				Digest: &remoteexecution.Digest{Hash: hash, SizeBytes: 1},
+			})
+			batch.Add(digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, hash, 1))
+		}
+		batches[i] = batch.Build()
+	}
+	expectActionCacheGet := func() {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+		actionCache.EXPECT().Get(ctx, actionDigest).Return(
+			buffer.NewProtoBufferFromProto(actionResult, buffer.BackendProvided(dataIntegrityCallback.Call)))
+	}
+
+	t.Run("Parallel", func(t *testing.T) {
+		// With a concurrency of four, all batches should be
+		// checked at the same time. Let every call block until
+		// all of them are in flight.
+		completenessCheckingBlobAccess := completenesschecking.NewCompletenessCheckingBlobAccess(
+			actionCache,
+			contentAddressableStorage,
+			/* batchSize = */ 5,
+			/* maximumConcurrency = */ 4,
+			/* maximumMessageSizeBytes = */ 10000,
+			/* maximumTotalTreeSizeBytes = */ 10000,
+			func(digest.InstanceName) bool { return false },
+			completenesschecking.LevelFull)
+
+		expectActionCacheGet()
+		var inFlight sync.WaitGroup
+		inFlight.Add(len(batches))
+		allInFlight := make(chan struct{})
+		go func() {
+			inFlight.Wait()
+			close(allInFlight)
+		}()
+		for _, batch := range batches {
+			contentAddressableStorage.EXPECT().FindMissing(gomock.Any(), batch).DoAndReturn(
+				func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+					inFlight.Done()
+					select {
+					case <-allInFlight:
+					case <-time.After(10 * time.Second):
+						t.Error("Calls to FindMissing() were not performed concurrently")
+					}
+					return digest.EmptySet, nil
+				})
+		}
+
+		m, err := completenessCheckingBlobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("EarlyExit", func(t *testing.T) {
+		// With a concurrency of two, the first two batches are
+		// checked at the same time. As soon as the first batch
+		// reports a missing object, the second batch should be
+		// canceled, and no further batches should be checked.
+		completenessCheckingBlobAccess := completenesschecking.NewCompletenessCheckingBlobAccess(
+			actionCache,
+			contentAddressableStorage,
+			/* batchSize = */ 5,
+			/* maximumConcurrency = */ 2,
+			/* maximumMessageSizeBytes = */ 10000,
+			/* maximumTotalTreeSizeBytes = */ 10000,
+			func(digest.InstanceName) bool { return false },
+			completenesschecking.LevelFull)
+
+		expectActionCacheGet()
+		secondBatchStarted := make(chan struct{})
+		contentAddressableStorage.EXPECT().FindMissing(gomock.Any(), batches[1]).DoAndReturn(
+			func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+				close(secondBatchStarted)
+				<-ctx.Done()
+				return digest.EmptySet, util.StatusFromContext(ctx)
+			})
+		contentAddressableStorage.EXPECT().FindMissing(gomock.Any(), batches[0]).DoAndReturn(
+			func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+				<-secondBatchStarted
+				missing, _ := digests.First()
+				return missing.ToSingletonSet(), nil
+			})
+
+		_, err := completenessCheckingBlobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 10000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object 3-00000000000000000000000000000001-1-hello referenced by the action result is not present in the Content Addressable Storage"), err)
+	})
+}
+
+func TestCompletenessCheckingBlobAccessOutputFilesLevel(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	actionCache := mock.NewMockBlobAccess(ctrl)
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	completenessCheckingBlobAccess := completenesschecking.NewCompletenessCheckingBlobAccess(
+		actionCache,
+		contentAddressableStorage,
+		/* batchSize = */ 5,
+		/* maximumConcurrency = */ 1,
+		/* maximumMessageSizeBytes = */ 1000,
+		/* maximumTotalTreeSizeBytes = */ 10000,
+		func(digest.InstanceName) bool { return false },
+		completenesschecking.LevelOutputFiles)
+
+	actionDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "d41d8cd98f00b204e9800998ecf8427e", 123)
+	outputFileDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	// Only the output file should be checked for existence.
+	// Standard output and output directories should be ignored,
+	// meaning that no Tree objects need to be loaded.
+	actionResult := &remoteexecution.ActionResult{
+		OutputFiles: []*remoteexecution.OutputFile{
+			{
+				Path: "bazel-out/foo.o",
+				Digest: &remoteexecution.Digest{
+					Hash:      "8b1a9953c4611296a827abf8c47804d7",
+					SizeBytes: 5,
+				},
+			},
+		},
+		OutputDirectories: []*remoteexecution.OutputDirectory{
+			{
+				Path: "bazel-out/foo",
+				TreeDigest: &remoteexecution.Digest{
+					Hash:      "9dd94c5a4b02914af42e8e6372e0b709",
+					SizeBytes: 2,
+				},
+			},
+		},
+		StdoutDigest: &remoteexecution.Digest{
+			Hash:      "6fc422233a40a75a1f028e11c3cd1140",
+			SizeBytes: 7,
+		},
+	}
+
+	t.Run("OutputFilesPresent", func(t *testing.T) {
+		actionCache.EXPECT().Get(ctx, actionDigest).Return(buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided))
+		contentAddressableStorage.EXPECT().FindMissing(ctx, outputFileDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+
+		m, err := completenessCheckingBlobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("OutputFileMissing", func(t *testing.T) {
+		actionCache.EXPECT().Get(ctx, actionDigest).Return(buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided))
+		contentAddressableStorage.EXPECT().FindMissing(ctx, outputFileDigest.ToSingletonSet()).Return(outputFileDigest.ToSingletonSet(), nil)
+
+		_, err := completenessCheckingBlobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object 3-8b1a9953c4611296a827abf8c47804d7-5-hello referenced by the action result is not present in the Content Addressable Storage"), err)
+	})
+
+	t.Run("StdoutMissing", func(t *testing.T) {
+		// ActionResults whose standard output is missing should
+		// still be returned, as only output files are checked.
+		stdoutOnlyActionResult := &remoteexecution.ActionResult{
+			StdoutDigest: &remoteexecution.Digest{
+				Hash:      "6fc422233a40a75a1f028e11c3cd1140",
+				SizeBytes: 7,
+			},
+		}
+		actionCache.EXPECT().Get(ctx, actionDigest).Return(buffer.NewProtoBufferFromProto(stdoutOnlyActionResult, buffer.UserProvided))
+		contentAddressableStorage.EXPECT().FindMissing(ctx, digest.EmptySet).Return(digest.EmptySet, nil)
+
+		m, err := completenessCheckingBlobAccess.Get(ctx, actionDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, stdoutOnlyActionResult, m)
+	})
 }
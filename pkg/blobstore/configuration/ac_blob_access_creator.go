@@ -8,12 +8,14 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
 	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
 	"github.com/buildbarn/bb-storage/pkg/grpc"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 var acCapabilitiesProvider = capabilities.NewStaticProvider(&remoteexecution.ServerCapabilities{
@@ -26,6 +28,21 @@ var acCapabilitiesProvider = capabilities.NewStaticProvider(&remoteexecution.Ser
 	},
 })
 
+// ACBlobAccessCreator is a BlobAccessCreator for the Action Cache (AC).
+// In addition to constructing backends, it is capable of reporting the
+// maximum size of ActionResult messages that the constructed backends
+// are capable of storing.
+type ACBlobAccessCreator interface {
+	BlobAccessCreator
+
+	// GetMaximumOffloadedActionResultSizeBytes returns the maximum
+	// size of ActionResult messages that are stored in the Content
+	// Addressable Storage (CAS) through ActionResultOffloadingBlobAccess.
+	// If offloading is not enabled, zero is returned. This method may
+	// only be called after NewBlobAccessFromConfiguration() returns.
+	GetMaximumOffloadedActionResultSizeBytes() int
+}
+
 type acBlobAccessCreator struct {
 	protoBlobAccessCreator
 	protoBlobReplicatorCreator
@@ -33,12 +50,14 @@ type acBlobAccessCreator struct {
 	contentAddressableStorage *BlobAccessInfo
 	grpcClientFactory         grpc.ClientFactory
 	maximumMessageSizeBytes   int
+
+	maximumOffloadedActionResultSizeBytes int
 }
 
 // NewACBlobAccessCreator creates a BlobAccessCreator that can be
 // provided to NewBlobAccessFromConfiguration() to construct a
 // BlobAccess that is suitable for accessing the Action Cache.
-func NewACBlobAccessCreator(contentAddressableStorage *BlobAccessInfo, grpcClientFactory grpc.ClientFactory, maximumMessageSizeBytes int) BlobAccessCreator {
+func NewACBlobAccessCreator(contentAddressableStorage *BlobAccessInfo, grpcClientFactory grpc.ClientFactory, maximumMessageSizeBytes int) ACBlobAccessCreator {
 	return &acBlobAccessCreator{
 		contentAddressableStorage: contentAddressableStorage,
 		grpcClientFactory:         grpcClientFactory,
@@ -84,9 +103,118 @@ func (bac *acBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAccess
 				bac.maximumMessageSizeBytes,
 				minimumTimestamp.AsTime(),
 				minimumValidity.AsDuration(),
-				maximumValidityJitter.AsDuration()),
+				maximumValidityJitter.AsDuration(),
+				backend.ActionResultExpiring.ExpireWithoutWorkerCompletedTimestamp),
 			DigestKeyFormat: base.DigestKeyFormat,
 		}, "action_result_expiring", nil
+	case *pb.BlobAccessConfiguration_ActionResultExitCodeFiltering:
+		config := backend.ActionResultExitCodeFiltering
+		base, err := nestedCreator.NewNestedBlobAccess(config.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		var rejectNonzeroExitCodes bool
+		switch config.NonzeroExitCodePolicy {
+		case pb.ActionResultExitCodeFilteringBlobAccessConfiguration_DROP:
+			rejectNonzeroExitCodes = false
+		case pb.ActionResultExitCodeFilteringBlobAccessConfiguration_REJECT:
+			rejectNonzeroExitCodes = true
+		default:
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Unknown nonzero exit code policy")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewActionResultExitCodeFilteringBlobAccess(
+				base.BlobAccess,
+				bac.maximumMessageSizeBytes,
+				rejectNonzeroExitCodes),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "action_result_exit_code_filtering", nil
+	case *pb.BlobAccessConfiguration_ActionResultPinning:
+		config := backend.ActionResultPinning
+		base, err := nestedCreator.NewNestedBlobAccess(config.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		pinnedActionResults := make(map[digest.Digest]*remoteexecution.ActionResult, len(config.PinnedActionResults))
+		for i, pinnedActionResult := range config.PinnedActionResults {
+			actionDigest, err := newPinnedActionDigest(pinnedActionResult)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Pinned action result at index %d", i)
+			}
+			if _, ok := pinnedActionResults[actionDigest]; ok {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Pinned action result at index %d: Action %#v is pinned multiple times", i, actionDigest.String())
+			}
+			actionResult := pinnedActionResult.ActionResult
+			if actionResult == nil {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Pinned action result at index %d: No action result provided", i)
+			}
+			if sizeBytes := proto.Size(actionResult); sizeBytes > bac.maximumMessageSizeBytes {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Pinned action result at index %d: Action result is %d bytes in size, which exceeds the maximum message size of %d bytes", i, sizeBytes, bac.maximumMessageSizeBytes)
+			}
+			pinnedActionResults[actionDigest] = actionResult
+		}
+		var rejectPuts bool
+		switch config.PutPolicy {
+		case pb.ActionResultPinningBlobAccessConfiguration_IGNORE:
+			rejectPuts = false
+		case pb.ActionResultPinningBlobAccessConfiguration_REJECT:
+			rejectPuts = true
+		default:
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Unknown put policy")
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewActionResultPinningBlobAccess(base.BlobAccess, pinnedActionResults, rejectPuts),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "action_result_pinning", nil
+	case *pb.BlobAccessConfiguration_ActionResultRetentionClassRouting:
+		config := backend.ActionResultRetentionClassRouting
+		defaultBackend, err := nestedCreator.NewNestedBlobAccess(config.DefaultBackend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.MetadataHeader == "" {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "No metadata header provided")
+		}
+		digestKeyFormat := defaultBackend.DigestKeyFormat
+		classBackends := make(map[string]blobstore.BlobAccess, len(config.Classes))
+		for className, classConfig := range config.Classes {
+			if className == "" {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Retention class names must be non-empty")
+			}
+			classBackend, err := nestedCreator.NewNestedBlobAccess(classConfig, bac)
+			if err != nil {
+				return BlobAccessInfo{}, "", err
+			}
+			classBackends[className] = classBackend.BlobAccess
+			digestKeyFormat = digestKeyFormat.Combine(classBackend.DigestKeyFormat)
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewActionResultRetentionClassRoutingBlobAccess(defaultBackend.BlobAccess, config.MetadataHeader, classBackends, bac.maximumMessageSizeBytes),
+			DigestKeyFormat: digestKeyFormat,
+		}, "action_result_retention_class_routing", nil
+	case *pb.BlobAccessConfiguration_ActionResultOffloading:
+		if bac.contentAddressableStorage == nil {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Action result offloading can only be enabled if a Content Addressable Storage is configured")
+		}
+		config := backend.ActionResultOffloading
+		base, err := nestedCreator.NewNestedBlobAccess(config.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.MaximumOffloadedSizeBytes <= int64(bac.maximumMessageSizeBytes) {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Maximum offloaded size must exceed the maximum message size of %d bytes", bac.maximumMessageSizeBytes)
+		}
+		if bac.maximumOffloadedActionResultSizeBytes < int(config.MaximumOffloadedSizeBytes) {
+			bac.maximumOffloadedActionResultSizeBytes = int(config.MaximumOffloadedSizeBytes)
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewActionResultOffloadingBlobAccess(
+				base.BlobAccess,
+				bac.contentAddressableStorage.BlobAccess,
+				bac.maximumMessageSizeBytes,
+				int(config.MaximumOffloadedSizeBytes)),
+			DigestKeyFormat: base.DigestKeyFormat.Combine(bac.contentAddressableStorage.DigestKeyFormat),
+		}, "action_result_offloading", nil
 	case *pb.BlobAccessConfiguration_CompletenessChecking:
 		if bac.contentAddressableStorage == nil {
 			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Action Cache completeness checking can only be enabled if a Content Addressable Storage is configured")
@@ -95,15 +223,104 @@ func (bac *acBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAccess
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
+		relaxedInstanceNames := digest.NewInstanceNameTrie()
+		for _, instanceNamePrefix := range backend.CompletenessChecking.RelaxedInstanceNamePrefixes {
+			instanceName, err := digest.NewInstanceName(instanceNamePrefix)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid relaxed instance name prefix %#v", instanceNamePrefix)
+			}
+			relaxedInstanceNames.Set(instanceName, 0)
+		}
+		batchSize := blobstore.RecommendedFindMissingDigestsCount
+		if size := backend.CompletenessChecking.FindMissingBatchSize; size < 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "FindMissing() batch size must be non-negative")
+		} else if size > 0 {
+			batchSize = int(size)
+		}
+		maximumConcurrency := 1
+		if concurrency := backend.CompletenessChecking.MaximumConcurrentFindMissingCalls; concurrency < 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of concurrent FindMissing() calls must be non-negative")
+		} else if concurrency > 0 {
+			maximumConcurrency = int(concurrency)
+		}
+		var level completenesschecking.Level
+		switch backend.CompletenessChecking.Level {
+		case pb.CompletenessCheckingBlobAccessConfiguration_FULL:
+			level = completenesschecking.LevelFull
+		case pb.CompletenessCheckingBlobAccessConfiguration_OUTPUT_FILES:
+			level = completenesschecking.LevelOutputFiles
+		default:
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Unknown completeness checking level")
+		}
 		return BlobAccessInfo{
 			BlobAccess: completenesschecking.NewCompletenessCheckingBlobAccess(
 				base.BlobAccess,
 				bac.contentAddressableStorage.BlobAccess,
-				blobstore.RecommendedFindMissingDigestsCount,
+				batchSize,
+				maximumConcurrency,
 				bac.maximumMessageSizeBytes,
-				backend.CompletenessChecking.MaximumTotalTreeSizeBytes),
+				backend.CompletenessChecking.MaximumTotalTreeSizeBytes,
+				relaxedInstanceNames.ContainsPrefix,
+				level),
 			DigestKeyFormat: base.DigestKeyFormat.Combine(bac.contentAddressableStorage.DigestKeyFormat),
 		}, "completeness_checking", nil
+	case *pb.BlobAccessConfiguration_ReadAfterWriteCaching:
+		config := backend.ReadAfterWriteCaching
+		base, err := nestedCreator.NewNestedBlobAccess(config.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		maximumCacheDuration := config.MaximumCacheDuration
+		if err := maximumCacheDuration.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid maximum cache duration")
+		}
+		if config.MaximumCacheSize <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum cache size must be positive")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewReadAfterWriteCachingBlobAccess(
+				base.BlobAccess,
+				clock.SystemClock,
+				eviction.NewMetricsSet(eviction.NewLRUSet[string](), "ReadAfterWriteCachingBlobAccess"),
+				int(config.MaximumCacheSize),
+				maximumCacheDuration.AsDuration(),
+				bac.maximumMessageSizeBytes),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "read_after_write_caching", nil
+	case *pb.BlobAccessConfiguration_StaleWhileRevalidate:
+		config := backend.StaleWhileRevalidate
+		base, err := nestedCreator.NewNestedBlobAccess(config.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		localCache, err := nestedCreator.NewNestedBlobAccess(config.LocalCache, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		freshnessDuration := config.FreshnessDuration
+		if err := freshnessDuration.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid freshness duration")
+		}
+		staleGraceDuration := config.StaleGraceDuration
+		if err := staleGraceDuration.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid stale grace duration")
+		}
+		if config.MaximumCacheSize <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum cache size must be positive")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewStaleWhileRevalidateBlobAccess(
+				base.BlobAccess,
+				localCache.BlobAccess,
+				clock.SystemClock,
+				eviction.NewMetricsSet(eviction.NewLRUSet[string](), "StaleWhileRevalidateBlobAccess"),
+				int(config.MaximumCacheSize),
+				freshnessDuration.AsDuration(),
+				staleGraceDuration.AsDuration(),
+				bac.maximumMessageSizeBytes,
+				util.DefaultErrorLogger),
+			DigestKeyFormat: base.DigestKeyFormat.Combine(localCache.DigestKeyFormat),
+		}, "stale_while_revalidate", nil
 	case *pb.BlobAccessConfiguration_Grpc:
 		client, err := bac.grpcClientFactory.NewClientFromConfiguration(backend.Grpc)
 		if err != nil {
@@ -118,12 +335,41 @@ func (bac *acBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAccess
 	}
 }
 
+// newPinnedActionDigest converts the action digest of a pinned action
+// result that is stored in the configuration to a Digest object.
+func newPinnedActionDigest(pinnedActionResult *pb.ActionResultPinningBlobAccessConfiguration_PinnedActionResult) (digest.Digest, error) {
+	instanceName, err := digest.NewInstanceName(pinnedActionResult.InstanceName)
+	if err != nil {
+		return digest.BadDigest, util.StatusWrapf(err, "Invalid instance name %#v", pinnedActionResult.InstanceName)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(pinnedActionResult.DigestFunction, 0)
+	if err != nil {
+		return digest.BadDigest, err
+	}
+	actionDigest, err := digestFunction.NewDigestFromProto(pinnedActionResult.ActionDigest)
+	if err != nil {
+		return digest.BadDigest, util.StatusWrap(err, "Invalid action digest")
+	}
+	return actionDigest, nil
+}
+
 func (bac *acBlobAccessCreator) WrapTopLevelBlobAccess(blobAccess blobstore.BlobAccess) blobstore.BlobAccess {
 	// For the Action Cache we want to ensure that all ActionResult
 	// objects have a 'worker_completed_timestamp'. This is needed
 	// to make decorators like ActionResultExpiringBlobAccess work.
+	// As this decorator is placed on top of any decorators that
+	// offload ActionResult messages, it needs to accept messages up
+	// to the offloaded size.
+	maximumActionResultSizeBytes := bac.maximumMessageSizeBytes
+	if maximumActionResultSizeBytes < bac.maximumOffloadedActionResultSizeBytes {
+		maximumActionResultSizeBytes = bac.maximumOffloadedActionResultSizeBytes
+	}
 	return blobstore.NewActionResultTimestampInjectingBlobAccess(
 		blobAccess,
 		clock.SystemClock,
-		bac.maximumMessageSizeBytes)
+		maximumActionResultSizeBytes)
+}
+
+func (bac *acBlobAccessCreator) GetMaximumOffloadedActionResultSizeBytes() int {
+	return bac.maximumOffloadedActionResultSizeBytes
 }
@@ -2,7 +2,9 @@ package configuration
 
 import (
 	"context"
+	"math"
 	"net/http"
+	"slices"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -14,6 +16,8 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/cloud/aws"
 	"github.com/buildbarn/bb-storage/pkg/cloud/gcp"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
 	"github.com/buildbarn/bb-storage/pkg/grpc"
 	bb_http "github.com/buildbarn/bb-storage/pkg/http"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
@@ -87,6 +91,83 @@ func (bac *casBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcces
 			BlobAccess:      blobstore.NewExistenceCachingBlobAccess(base.BlobAccess, existenceCache),
 			DigestKeyFormat: base.DigestKeyFormat,
 		}, "existence_caching", nil
+	case *pb.BlobAccessConfiguration_PutDeduplicating:
+		base, err := nestedCreator.NewNestedBlobAccess(backend.PutDeduplicating.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewPutDeduplicatingBlobAccess(base.BlobAccess),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "put_deduplicating", nil
+	case *pb.BlobAccessConfiguration_GetDeduplicating:
+		// Merged objects are held in memory as a byte slice,
+		// meaning that their size must fit in an int.
+		maximumSizeBytes := backend.GetDeduplicating.MaximumSizeBytes
+		if maximumSizeBytes <= 0 || maximumSizeBytes > math.MaxInt {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Maximum size must be between 1 and %d bytes", math.MaxInt)
+		}
+		base, err := nestedCreator.NewNestedBlobAccess(backend.GetDeduplicating.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewGetDeduplicatingBlobAccess(base.BlobAccess, int(maximumSizeBytes)),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "get_deduplicating", nil
+	case *pb.BlobAccessConfiguration_DigestFunctionFederating:
+		config := backend.DigestFunctionFederating
+		if !slices.Contains(digest.SupportedDigestFunctions, config.RemoteDigestFunction) {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Unsupported remote digest function %s", config.RemoteDigestFunction)
+		}
+		local, err := nestedCreator.NewNestedBlobAccess(config.Local, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Local")
+		}
+		remote, err := nestedCreator.NewNestedBlobAccess(config.Remote, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Remote")
+		}
+		// The translation index stores Digest messages instead
+		// of the objects themselves. Create a new
+		// BlobAccessCreator to ensure data is loaded properly.
+		translationIndex, err := nestedCreator.NewNestedBlobAccess(config.TranslationIndex, newDigestTranslationBlobAccessCreator())
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Translation index")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewDigestFunctionFederatingBlobAccess(
+				local.BlobAccess,
+				remote.BlobAccess,
+				blobstore.NewBlobAccessDigestTranslationIndex(
+					translationIndex.BlobAccess,
+					config.RemoteDigestFunction,
+					bac.maximumMessageSizeBytes),
+				config.RemoteDigestFunction),
+			DigestKeyFormat: local.DigestKeyFormat,
+		}, "digest_function_federating", nil
+	case *pb.BlobAccessConfiguration_SecondaryDigestVerifying:
+		base, err := nestedCreator.NewNestedBlobAccess(backend.SecondaryDigestVerifying.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewSecondaryDigestVerifyingBlobAccess(base.BlobAccess, backend.SecondaryDigestVerifying.MetadataHeader),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "secondary_digest_verifying", nil
+	case *pb.BlobAccessConfiguration_FilesystemOverlay:
+		base, err := nestedCreator.NewNestedBlobAccess(backend.FilesystemOverlay.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		directory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(backend.FilesystemOverlay.DirectoryPath))
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open overlay directory %#v", backend.FilesystemOverlay.DirectoryPath)
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewFilesystemOverlayBlobAccess(base.BlobAccess, directory, util.DefaultErrorLogger),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "filesystem_overlay", nil
 	case *pb.BlobAccessConfiguration_Grpc:
 		client, err := bac.grpcClientFactory.NewClientFromConfiguration(backend.Grpc)
 		if err != nil {
@@ -146,6 +227,17 @@ func (bac *casBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcces
 				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create GCS client")
 			}
 			gcsClient = gcp.NewWrappedStorageClient(client)
+
+			if operationTimeout := backend.ReferenceExpanding.GcsOperationTimeout; operationTimeout != nil {
+				if err := operationTimeout.CheckValid(); err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid GCS operation timeout")
+				}
+				maximumAttempts := backend.ReferenceExpanding.GcsMaximumAttempts
+				if maximumAttempts == 0 {
+					return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of GCS attempts must be positive")
+				}
+				gcsClient = gcp.NewTimeoutStorageClient(gcsClient, operationTimeout.AsDuration(), int(maximumAttempts))
+			}
 		}
 
 		return BlobAccessInfo{
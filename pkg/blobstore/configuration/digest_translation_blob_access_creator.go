@@ -0,0 +1,40 @@
+package configuration
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+)
+
+type digestTranslationBlobAccessCreator struct {
+	protoBlobAccessCreator
+	protoBlobReplicatorCreator
+}
+
+// newDigestTranslationBlobAccessCreator creates a BlobAccessCreator
+// that can be provided to NewBlobAccessFromConfiguration() to construct
+// a BlobAccess that is suitable for storing the translation index of
+// DigestFunctionFederatingBlobAccess.
+func newDigestTranslationBlobAccessCreator() BlobAccessCreator {
+	return &digestTranslationBlobAccessCreator{}
+}
+
+func (bac *digestTranslationBlobAccessCreator) GetReadBufferFactory() blobstore.ReadBufferFactory {
+	return blobstore.DigestTranslationReadBufferFactory
+}
+
+func (bac *digestTranslationBlobAccessCreator) GetStorageTypeName() string {
+	return "digest_translation"
+}
+
+func (bac *digestTranslationBlobAccessCreator) GetDefaultCapabilitiesProvider() capabilities.Provider {
+	return nil
+}
+
+func (bac *digestTranslationBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAccessConfiguration, nestedCreator NestedBlobAccessCreator) (BlobAccessInfo, string, error) {
+	return newProtoCustomBlobAccess(configuration, nestedCreator, bac)
+}
+
+func (bac *digestTranslationBlobAccessCreator) WrapTopLevelBlobAccess(blobAccess blobstore.BlobAccess) blobstore.BlobAccess {
+	return blobAccess
+}
@@ -51,6 +51,8 @@ func (bac *fsacBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcce
 			BlobAccess:      grpcclients.NewFSACBlobAccess(client, bac.maximumMessageSizeBytes),
 			DigestKeyFormat: digest.KeyWithInstance,
 		}, "grpc", nil
+	case *pb.BlobAccessConfiguration_ZstdCompressing:
+		return newZstdCompressingBlobAccess(backend.ZstdCompressing, nestedCreator, bac, bac.maximumMessageSizeBytes)
 	default:
 		return newProtoCustomBlobAccess(configuration, nestedCreator, bac)
 	}
@@ -51,6 +51,17 @@ func (bac *isccBlobAccessCreator) NewCustomBlobAccess(configuration *pb.BlobAcce
 			BlobAccess:      grpcclients.NewISCCBlobAccess(client, bac.maximumMessageSizeBytes),
 			DigestKeyFormat: digest.KeyWithInstance,
 		}, "grpc", nil
+	case *pb.BlobAccessConfiguration_IsccCompactEncoding:
+		base, err := nestedCreator.NewNestedBlobAccess(backend.IsccCompactEncoding.Backend, bac)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewISCCCompactEncodingBlobAccess(base.BlobAccess, bac.maximumMessageSizeBytes),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "iscc_compact_encoding", nil
+	case *pb.BlobAccessConfiguration_ZstdCompressing:
+		return newZstdCompressingBlobAccess(backend.ZstdCompressing, nestedCreator, bac, bac.maximumMessageSizeBytes)
 	default:
 		return newProtoCustomBlobAccess(configuration, nestedCreator, bac)
 	}
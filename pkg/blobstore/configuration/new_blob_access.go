@@ -3,32 +3,44 @@ package configuration
 import (
 	"archive/zip"
 	"context"
+	"database/sql"
+	"math"
 	"os"
 	"sync"
 	"time"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/eventpublishing"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/mirrored"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/readcaching"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/readfallback"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/trace"
 	"github.com/buildbarn/bb-storage/pkg/blockdevice"
 	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/cloud/gcp"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/eviction"
 	"github.com/buildbarn/bb-storage/pkg/filesystem"
 	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/global"
 	"github.com/buildbarn/bb-storage/pkg/grpc"
 	"github.com/buildbarn/bb-storage/pkg/program"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
 	digest_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/digest"
 	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/buildbarn/bb-storage/pkg/readiness"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/fxtlabs/primes"
+	"github.com/lib/pq"
 
+	"google.golang.org/api/option"
+	gcp_http "google.golang.org/api/transport/http"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // BlobAccessInfo contains an instance of BlobAccess and information
@@ -58,9 +70,62 @@ type simpleNestedBlobAccessCreator struct {
 	labels           map[string]BlobAccessInfo
 }
 
+// newEventPublisher creates a BatchingPublisher that submits cache
+// events to a sink, and launches a goroutine that runs it.
+func (nc *simpleNestedBlobAccessCreator) newEventPublisher(queueSize, maximumBatchSize int32, flushInterval, publishTimeout *durationpb.Duration, pubsubConfiguration *pb.PubSubEventSinkConfiguration) (eventpublishing.Publisher, error) {
+	if queueSize <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Queue size must be positive")
+	}
+	if maximumBatchSize <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Maximum batch size must be positive")
+	}
+	if err := flushInterval.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid flush interval")
+	}
+	if err := publishTimeout.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid publish timeout")
+	}
+	if publishTimeout.AsDuration() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Publish timeout must be positive")
+	}
+	if pubsubConfiguration == nil {
+		return nil, status.Error(codes.InvalidArgument, "No event sink specified")
+	}
+
+	clientOptions, err := gcp.NewClientOptionsFromConfiguration(pubsubConfiguration.GcpClientOptions, "PubSubSink")
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to create GCP client options")
+	}
+	httpClient, _, err := gcp_http.NewClient(
+		context.Background(),
+		append(clientOptions, option.WithScopes("https://www.googleapis.com/auth/pubsub"))...)
+	if err != nil {
+		return nil, util.StatusWrap(err, "Failed to create Pub/Sub HTTP client")
+	}
+	endpoint := pubsubConfiguration.Endpoint
+	if endpoint == "" {
+		endpoint = "https://pubsub.googleapis.com"
+	}
+	sink := eventpublishing.NewPubSubSink(httpClient, endpoint, pubsubConfiguration.Topic)
+
+	publisher := eventpublishing.NewBatchingPublisher(
+		sink,
+		clock.SystemClock,
+		int(queueSize),
+		int(maximumBatchSize),
+		flushInterval.AsDuration(),
+		publishTimeout.AsDuration(),
+		util.DefaultErrorLogger)
+	nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		publisher.Run(ctx)
+		return nil
+	})
+	return publisher, nil
+}
+
 func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *pb.BlobAccessConfiguration, creator BlobAccessCreator) (BlobAccessInfo, string, error) {
-	readBufferFactory := creator.GetReadBufferFactory()
 	storageTypeName := creator.GetStorageTypeName()
+	readBufferFactory := blobstore.NewMetricsReadBufferFactory(creator.GetReadBufferFactory(), storageTypeName)
 	switch backend := configuration.Backend.(type) {
 	case *pb.BlobAccessConfiguration_Error:
 		return BlobAccessInfo{
@@ -85,6 +150,10 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			DigestKeyFormat: slow.DigestKeyFormat,
 		}, "read_caching", nil
 	case *pb.BlobAccessConfiguration_Sharding:
+		decorateBackend, err := newBackendTimeoutDecorator(backend.Sharding.BackendTimeout)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
 		backends := make([]blobstore.BlobAccess, 0, len(backend.Sharding.Shards))
 		weights := make([]uint32, 0, len(backend.Sharding.Shards))
 		var combinedDigestKeyFormat *digest.KeyFormat
@@ -98,7 +167,7 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 				if err != nil {
 					return BlobAccessInfo{}, "", err
 				}
-				backends = append(backends, backend.BlobAccess)
+				backends = append(backends, decorateBackend(backend.BlobAccess))
 				if combinedDigestKeyFormat == nil {
 					combinedDigestKeyFormat = &backend.DigestKeyFormat
 				} else {
@@ -115,22 +184,42 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		if combinedDigestKeyFormat == nil {
 			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Cannot create sharding blob access without any undrained backends")
 		}
+		var findMissingFailurePolicy sharding.FindMissingFailurePolicy
+		switch backend.Sharding.FindMissingFailurePolicy {
+		case pb.ShardingBlobAccessConfiguration_FAIL:
+			findMissingFailurePolicy = sharding.FindMissingFailurePolicyFail
+		case pb.ShardingBlobAccessConfiguration_REPORT_PRESENT:
+			findMissingFailurePolicy = sharding.FindMissingFailurePolicyReportPresent
+		case pb.ShardingBlobAccessConfiguration_REPORT_MISSING:
+			findMissingFailurePolicy = sharding.FindMissingFailurePolicyReportMissing
+		default:
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Unknown FindMissing() failure policy")
+		}
 		return BlobAccessInfo{
 			BlobAccess: sharding.NewShardingBlobAccess(
 				backends,
 				sharding.NewWeightedShardPermuter(weights),
-				backend.Sharding.HashInitialization),
+				backend.Sharding.HashInitialization,
+				int(backend.Sharding.MaximumParallelism),
+				findMissingFailurePolicy,
+				util.DefaultErrorLogger),
 			DigestKeyFormat: *combinedDigestKeyFormat,
 		}, "sharding", nil
 	case *pb.BlobAccessConfiguration_Mirrored:
+		decorateBackend, err := newBackendTimeoutDecorator(backend.Mirrored.BackendTimeout)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
 		backendA, err := nc.NewNestedBlobAccess(backend.Mirrored.BackendA, creator)
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
+		backendA.BlobAccess = decorateBackend(backendA.BlobAccess)
 		backendB, err := nc.NewNestedBlobAccess(backend.Mirrored.BackendB, creator)
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
+		backendB.BlobAccess = decorateBackend(backendB.BlobAccess)
 		replicatorAToB, err := NewBlobReplicatorFromConfiguration(backend.Mirrored.ReplicatorAToB, backendA.BlobAccess, backendB, creator)
 		if err != nil {
 			return BlobAccessInfo{}, "", err
@@ -139,8 +228,36 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		if err != nil {
 			return BlobAccessInfo{}, "", err
 		}
+		var hedgingPolicy mirrored.HedgingPolicy
+		if hedgeDelay := backend.Mirrored.HedgeDelay; hedgeDelay != nil {
+			if err := hedgeDelay.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid hedge delay")
+			}
+			if hedgeDelay.AsDuration() <= 0 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Hedge delay must be positive")
+			}
+			if backend.Mirrored.HedgeMaximumSizeBytes <= 0 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Hedge maximum size must be positive")
+			}
+			hedgingPolicy = mirrored.HedgingPolicy{
+				Clock:            clock.SystemClock,
+				Delay:            hedgeDelay.AsDuration(),
+				MaximumSizeBytes: int(backend.Mirrored.HedgeMaximumSizeBytes),
+			}
+		}
+		var readRepairPolicy mirrored.ReadRepairPolicy
+		switch backend.Mirrored.ReadRepairTargets {
+		case pb.MirroredBlobAccessConfiguration_ALL_MISSING:
+			readRepairPolicy = mirrored.ReadRepairAllMissing
+		case pb.MirroredBlobAccessConfiguration_BACKEND_A_ONLY:
+			readRepairPolicy = mirrored.ReadRepairBackendAOnly
+		case pb.MirroredBlobAccessConfiguration_BACKEND_B_ONLY:
+			readRepairPolicy = mirrored.ReadRepairBackendBOnly
+		default:
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Unknown read repair targets")
+		}
 		return BlobAccessInfo{
-			BlobAccess:      mirrored.NewMirroredBlobAccess(backendA.BlobAccess, backendB.BlobAccess, replicatorAToB, replicatorBToA),
+			BlobAccess:      mirrored.NewMirroredBlobAccess(backendA.BlobAccess, backendB.BlobAccess, replicatorAToB, replicatorBToA, hedgingPolicy, readRepairPolicy),
 			DigestKeyFormat: backendA.DigestKeyFormat.Combine(backendB.DigestKeyFormat),
 		}, "mirrored", nil
 	case *pb.BlobAccessConfiguration_Local:
@@ -282,6 +399,30 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			return BlobAccessInfo{}, "", err
 		}
 
+		var evictionTracker *local.EvictionTracker
+		if evictionTracking := backend.Local.EvictionTracking; evictionTracking != nil {
+			if backend.Local.HierarchicalInstanceNames {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Eviction tracking cannot be combined with hierarchical instance names")
+			}
+			var evictionHandler local.EvictionHandler
+			if publisherConfiguration := evictionTracking.EventPublisher; publisherConfiguration != nil {
+				var pubsubConfiguration *pb.PubSubEventSinkConfiguration
+				if sinkConfiguration, ok := publisherConfiguration.Sink.(*pb.EventPublisherConfiguration_Pubsub); ok {
+					pubsubConfiguration = sinkConfiguration.Pubsub
+				}
+				publisher, err := nc.newEventPublisher(publisherConfiguration.QueueSize, publisherConfiguration.MaximumBatchSize, publisherConfiguration.FlushInterval, publisherConfiguration.PublishTimeout, pubsubConfiguration)
+				if err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create eviction event publisher")
+				}
+				evictionHandler = eventpublishing.NewPublishingEvictionHandler(publisher, clock.SystemClock, storageTypeName)
+			}
+			evictionTracker = local.NewEvictionTracker(clock.SystemClock, evictionHandler, storageTypeName)
+			blockList = local.NewEvictionTrackingBlockList(blockList, evictionTracker)
+		}
+
+		if backend.Local.ReadRefreshCurrentBlocks < 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Number of current blocks to refresh on read cannot be negative")
+		}
 		locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
 			blockList,
 			blockListGrowthPolicy,
@@ -290,7 +431,8 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			int64(sectorSizeBytes)*blockSectorCount,
 			int(backend.Local.OldBlocks),
 			int(backend.Local.NewBlocks),
-			initialBlockCount)
+			initialBlockCount,
+			int(backend.Local.ReadRefreshCurrentBlocks))
 
 		// Create the backing store for the key-location map.
 		var locationRecordArraySize int
@@ -333,11 +475,24 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 			int(backend.Local.KeyLocationMapMaximumPutAttempts),
 			storageTypeName)
 
+		// Optionally store small objects inline in the
+		// key-location map. This decorator is only applied to
+		// the LocationBlobMap used to store objects, as the
+		// location record array needs to resolve block
+		// references directly. It is applied even if storing
+		// objects inline is disabled, so that objects that
+		// were stored inline previously can still be read.
+		inlineMaximumSizeBytes := backend.Local.InlineMaximumSizeBytes
+		if inlineMaximumSizeBytes < 0 || inlineMaximumSizeBytes > local.InlineLocationBlobMapMaximumSizeBytes {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Maximum size of objects stored inline must be between 0 and %d bytes", local.InlineLocationBlobMapMaximumSizeBytes)
+		}
+		objectLocationBlobMap := local.NewInlineLocationBlobMap(locationBlobMap, readBufferFactory, inlineMaximumSizeBytes)
+
 		var localBlobAccess blobstore.BlobAccess
 		if backend.Local.HierarchicalInstanceNames {
 			localBlobAccess, err = creator.NewHierarchicalInstanceNamesLocalBlobAccess(
 				keyLocationMap,
-				locationBlobMap,
+				objectLocationBlobMap,
 				&globalLock)
 			if err != nil {
 				return BlobAccessInfo{}, "", err
@@ -345,11 +500,53 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 		} else {
 			localBlobAccess = local.NewFlatBlobAccess(
 				keyLocationMap,
-				locationBlobMap,
+				objectLocationBlobMap,
 				digestKeyFormat,
 				&globalLock,
 				storageTypeName,
-				creator.GetDefaultCapabilitiesProvider())
+				creator.GetDefaultCapabilitiesProvider(),
+				evictionTracker)
+		}
+		if minimumFreeSpace := backend.Local.MinimumFreeSpace; minimumFreeSpace != nil {
+			if minimumFreeSpace.MinimumFreeRatio < 0 || minimumFreeSpace.MinimumFreeRatio > 1 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Minimum free ratio must be between 0.0 and 1.0")
+			}
+			freeSpaceProvider, err := local.NewStatfsFreeSpaceProvider(minimumFreeSpace.Path)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrap(err, "Failed to create free space provider")
+			}
+			localBlobAccess = local.NewFreeSpaceCheckingBlobAccess(
+				localBlobAccess,
+				freeSpaceProvider,
+				minimumFreeSpace.MinimumFreeBytes,
+				minimumFreeSpace.MinimumFreeRatio,
+				storageTypeName)
+		}
+		if pinning := backend.Local.Pinning; pinning != nil {
+			if pinning.Name == "" {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "No name provided for pinning")
+			}
+			blobPinner := local.NewBlobPinner(localBlobAccess, locationBlobMap, &globalLock, util.DefaultErrorLogger, storageTypeName)
+			if pinnedBlobs := pinning.PinnedBlobs; pinnedBlobs != nil {
+				if err := blobPinner.SetPinnedBlobs(pinnedBlobs); err != nil {
+					return BlobAccessInfo{}, "", util.StatusWrap(err, "Invalid pinned blobs")
+				}
+			}
+			if err := global.RegisterDiagnosticsHTTPHandler("/pinned_blobs/"+pinning.Name, blobPinner); err != nil {
+				return BlobAccessInfo{}, "", err
+			}
+			localBlobAccess = blobPinner
+		}
+		if contentsListing := backend.Local.ContentsListing; contentsListing != nil {
+			if contentsListing.Name == "" {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "No name provided for contents listing")
+			}
+			if err := global.RegisterDiagnosticsHTTPHandler(
+				"/local_contents/"+contentsListing.Name,
+				local.NewKeyLocationMapListingHandler(keyLocationMap, globalLock.RLocker()),
+			); err != nil {
+				return BlobAccessInfo{}, "", err
+			}
 		}
 		return BlobAccessInfo{
 			BlobAccess:      localBlobAccess,
@@ -438,6 +635,564 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 				util.DefaultErrorLogger),
 			DigestKeyFormat: source.DigestKeyFormat.Combine(replica.DigestKeyFormat),
 		}, "read_canarying", nil
+	case *pb.BlobAccessConfiguration_Failover:
+		config := backend.Failover
+		primary, err := nc.NewNestedBlobAccess(config.Primary, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		secondary, err := nc.NewNestedBlobAccess(config.Secondary, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.FailureThreshold <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Failure threshold must be positive")
+		}
+		stabilizationWindow := config.StabilizationWindow
+		if err := stabilizationWindow.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid stabilization window")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewFailoverBlobAccess(
+				primary.BlobAccess,
+				secondary.BlobAccess,
+				clock.SystemClock,
+				int(config.FailureThreshold),
+				stabilizationWindow.AsDuration(),
+				creator.GetStorageTypeName()),
+			DigestKeyFormat: primary.DigestKeyFormat.Combine(secondary.DigestKeyFormat),
+		}, "failover", nil
+	case *pb.BlobAccessConfiguration_Postgres:
+		config := backend.Postgres
+		if config.TableName == "" {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "No table name provided")
+		}
+		if config.LargeObjectThresholdBytes < 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Large object threshold must be non-negative")
+		}
+		if config.FindMissingBatchSize <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "FindMissing() batch size must be positive")
+		}
+		var expiration time.Duration
+		if config.Expiration != nil {
+			if err := config.Expiration.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid expiration")
+			}
+			expiration = config.Expiration.AsDuration()
+		}
+		connector, err := pq.NewConnector(config.ConnectionString)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid connection string")
+		}
+		db := sql.OpenDB(connector)
+		db.SetMaxOpenConns(int(config.MaximumOpenConnections))
+		if config.MaximumIdleConnections > 0 {
+			db.SetMaxIdleConns(int(config.MaximumIdleConnections))
+		}
+		if config.ConnectionMaximumLifetime != nil {
+			if err := config.ConnectionMaximumLifetime.CheckValid(); err != nil {
+				db.Close()
+				return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid connection maximum lifetime")
+			}
+			db.SetConnMaxLifetime(config.ConnectionMaximumLifetime.AsDuration())
+		}
+
+		digestKeyFormat := creator.GetBaseDigestKeyFormat()
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewPostgresBlobAccess(
+				creator.GetDefaultCapabilitiesProvider(),
+				blobstore.NewSQLPostgresQueries(db, config.TableName),
+				readBufferFactory,
+				digestKeyFormat,
+				clock.SystemClock,
+				expiration,
+				config.LargeObjectThresholdBytes,
+				int(config.FindMissingBatchSize)),
+			DigestKeyFormat: digestKeyFormat,
+		}, "postgres", nil
+	case *pb.BlobAccessConfiguration_ReadWarming:
+		config := backend.ReadWarming
+		primary, err := nc.NewNestedBlobAccess(config.Primary, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		secondary, err := nc.NewNestedBlobAccess(config.Secondary, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.QueueSize <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Queue size must be positive")
+		}
+		// Objects are held in memory as a byte slice, meaning
+		// that their size must fit in an int.
+		if config.MaximumSizeBytes <= 0 || config.MaximumSizeBytes > math.MaxInt {
+			return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Maximum size must be between 1 and %d bytes", math.MaxInt)
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewReadWarmingBlobAccess(primary.BlobAccess, secondary.BlobAccess, int(config.QueueSize), int(config.MaximumSizeBytes)),
+			DigestKeyFormat: primary.DigestKeyFormat.Combine(secondary.DigestKeyFormat),
+		}, "read_warming", nil
+	case *pb.BlobAccessConfiguration_EventPublishing:
+		config := backend.EventPublishing
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		var pubsubConfiguration *pb.PubSubEventSinkConfiguration
+		if sinkConfiguration, ok := config.Sink.(*pb.EventPublishingBlobAccessConfiguration_Pubsub); ok {
+			pubsubConfiguration = sinkConfiguration.Pubsub
+		}
+		publisher, err := nc.newEventPublisher(config.QueueSize, config.MaximumBatchSize, config.FlushInterval, config.PublishTimeout, pubsubConfiguration)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      eventpublishing.NewEventPublishingBlobAccess(base.BlobAccess, publisher, clock.SystemClock, creator.GetStorageTypeName(), config.MissesOnly),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "event_publishing", nil
+	case *pb.BlobAccessConfiguration_DigestDenylisting:
+		config := backend.DigestDenylisting
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		denylist, err := blobstore.NewDigestDenylist(config.DenylistPath)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.ReloadInterval != nil {
+			if err := config.ReloadInterval.CheckValid(); err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid reload interval")
+			}
+			reloadInterval := config.ReloadInterval.AsDuration()
+			if reloadInterval <= 0 {
+				return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Reload interval must be positive")
+			}
+			nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+				for {
+					timer, t := clock.SystemClock.NewTimer(reloadInterval)
+					select {
+					case <-t:
+						if err := denylist.Reload(); err != nil {
+							util.DefaultErrorLogger.Log(err)
+						}
+					case <-ctx.Done():
+						timer.Stop()
+						return nil
+					}
+				}
+			})
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewDigestDenylistingBlobAccess(base.BlobAccess, denylist),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "digest_denylisting", nil
+	case *pb.BlobAccessConfiguration_LoadReporting:
+		config := backend.LoadReporting
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.Capacity <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Capacity must be positive")
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewLoadReportingBlobAccess(base.BlobAccess, int(config.Capacity), config.EmitHeader, creator.GetStorageTypeName()),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "load_reporting", nil
+	case *pb.BlobAccessConfiguration_PutBufferLifecycleChecking:
+		config := backend.PutBufferLifecycleChecking
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewPutBufferLifecycleCheckingBlobAccess(base.BlobAccess, config.PanicOnViolation, creator.GetStorageTypeName()),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "put_buffer_lifecycle_checking", nil
+	case *pb.BlobAccessConfiguration_RetentionReporting:
+		config := backend.RetentionReporting
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if err := config.SampleInterval.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid sample interval")
+		}
+		if config.MaximumSamples <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of samples must be positive")
+		}
+		if err := config.ReportInterval.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid report interval")
+		}
+		reportInterval := config.ReportInterval.AsDuration()
+		if reportInterval <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Report interval must be positive")
+		}
+		blobAccess := blobstore.NewRetentionReportingBlobAccess(
+			base.BlobAccess,
+			clock.SystemClock,
+			config.SampleInterval.AsDuration(),
+			int(config.MaximumSamples),
+			creator.GetStorageTypeName())
+		nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			blobAccess.Run(ctx, reportInterval, util.DefaultErrorLogger)
+			return nil
+		})
+		return BlobAccessInfo{
+			BlobAccess:      blobAccess,
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "retention_reporting", nil
+	case *pb.BlobAccessConfiguration_Recording:
+		config := backend.Recording
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if err := config.FlushInterval.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid flush interval")
+		}
+		flushInterval := config.FlushInterval.AsDuration()
+		if flushInterval <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Flush interval must be positive")
+		}
+		if err := config.ShutdownTimeout.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid shutdown timeout")
+		}
+		shutdownTimeout := config.ShutdownTimeout.AsDuration()
+		if shutdownTimeout <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Shutdown timeout must be positive")
+		}
+		recordingPath := config.Path
+		file, err := os.OpenFile(recordingPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapf(err, "Failed to open recording %#v", recordingPath)
+		}
+		recorder := trace.NewRecorder(file, clock.SystemClock)
+
+		// Periodically flush recorded operations, and attempt to
+		// write the remaining ones upon termination.
+		nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			for {
+				timer, timerChannel := clock.SystemClock.NewTimer(flushInterval)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil
+				case <-timerChannel:
+					if err := recorder.Flush(); err != nil {
+						util.DefaultErrorLogger.Log(util.StatusWrapf(err, "Failed to flush recording %#v", recordingPath))
+					}
+				}
+			}
+		})
+		nc.terminationGroup.Go(program.NewShutdownHookRoutine(
+			recordingPath,
+			func(ctx context.Context) error {
+				if err := recorder.Flush(); err != nil {
+					file.Close()
+					return util.StatusWrap(err, "Failed to flush recording")
+				}
+				if err := file.Close(); err != nil {
+					return util.StatusWrap(err, "Failed to close recording")
+				}
+				return nil
+			},
+			shutdownTimeout,
+			util.DefaultErrorLogger))
+
+		return BlobAccessInfo{
+			BlobAccess:      trace.NewRecordingBlobAccess(base.BlobAccess, recorder, util.DefaultErrorLogger),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "recording", nil
+	case *pb.BlobAccessConfiguration_WriteCanarying:
+		config := backend.WriteCanarying
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		instanceName, err := digest.NewInstanceName(config.InstanceName)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid instance name %#v", config.InstanceName)
+		}
+		digestFunction, err := instanceName.GetDigestFunction(config.DigestFunction, 0)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Invalid digest function")
+		}
+		if err := config.Interval.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid interval")
+		}
+		interval := config.Interval.AsDuration()
+		if interval <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Interval must be positive")
+		}
+		if err := config.Timeout.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid timeout")
+		}
+		timeout := config.Timeout.AsDuration()
+		if timeout <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Timeout must be positive")
+		}
+		if config.FailureThreshold <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Failure threshold must be positive")
+		}
+		blobAccess := blobstore.NewWriteCanaryingBlobAccess(
+			base.BlobAccess,
+			clock.SystemClock,
+			digestFunction,
+			timeout,
+			int(config.FailureThreshold),
+			creator.GetStorageTypeName(),
+			config.Name)
+		if err := readiness.RegisterCheck(config.Name, blobAccess.CheckReadiness); err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		nc.terminationGroup.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			blobAccess.Run(ctx, interval, util.DefaultErrorLogger)
+			return nil
+		})
+		return BlobAccessInfo{
+			BlobAccess:      blobAccess,
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "write_canarying", nil
+	case *pb.BlobAccessConfiguration_FindMissingDeduplicating:
+		base, err := nc.NewNestedBlobAccess(backend.FindMissingDeduplicating.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewFindMissingDeduplicatingBlobAccess(base.BlobAccess),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "find_missing_deduplicating", nil
+	case *pb.BlobAccessConfiguration_FaultInjecting:
+		config := backend.FaultInjecting
+		if !config.EnableFaultInjection {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Fault injection needs to be enabled explicitly")
+		}
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		getPolicy, err := newFaultInjectionPolicyFromConfiguration(config.Get)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Invalid Get() fault injection policy")
+		}
+		putPolicy, err := newFaultInjectionPolicyFromConfiguration(config.Put)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Invalid Put() fault injection policy")
+		}
+		findMissingPolicy, err := newFaultInjectionPolicyFromConfiguration(config.FindMissing)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrap(err, "Invalid FindMissing() fault injection policy")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewFaultInjectingBlobAccess(
+				base.BlobAccess,
+				clock.SystemClock,
+				random.FastThreadSafeGenerator,
+				getPolicy,
+				putPolicy,
+				findMissingPolicy),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "fault_injecting", nil
+	case *pb.BlobAccessConfiguration_InstanceNameAliasing:
+		config := backend.InstanceNameAliasing
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		aliasesTrie := digest.NewInstanceNameTrie()
+		aliases := make(map[digest.InstanceName]digest.InstanceName, len(config.Aliases))
+		for aliasStr, canonicalStr := range config.Aliases {
+			alias, err := digest.NewInstanceName(aliasStr)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid instance name %#v", aliasStr)
+			}
+			canonical, err := digest.NewInstanceName(canonicalStr)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid instance name %#v", canonicalStr)
+			}
+			aliasesTrie.Set(alias, 0)
+			aliases[alias] = canonical
+		}
+		for alias, canonical := range aliases {
+			if aliasesTrie.ContainsPrefix(canonical) {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Canonical instance name %#v of alias %#v matches an alias", canonical.String(), alias.String())
+			}
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewInstanceNameAliasingBlobAccess(base.BlobAccess, aliases),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "instance_name_aliasing", nil
+	case *pb.BlobAccessConfiguration_BlobCountLimiting:
+		config := backend.BlobCountLimiting
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		maximumBlobs := make(map[digest.InstanceName]int64, len(config.MaximumBlobs))
+		for instanceNameStr, maximum := range config.MaximumBlobs {
+			instanceName, err := digest.NewInstanceName(instanceNameStr)
+			if err != nil {
+				return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid instance name %#v", instanceNameStr)
+			}
+			if maximum < 0 {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Maximum number of objects for instance name %#v must be non-negative", instanceNameStr)
+			}
+			maximumBlobs[instanceName] = maximum
+		}
+		if err := config.Window.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid window")
+		}
+		window := config.Window.AsDuration()
+		if window <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Window must be positive")
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewBlobCountLimitingBlobAccess(base.BlobAccess, clock.SystemClock, window, maximumBlobs),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "blob_count_limiting", nil
+	case *pb.BlobAccessConfiguration_DigestFunctionRouting:
+		config := backend.DigestFunctionRouting
+		defaultBackend, err := nc.NewNestedBlobAccess(config.DefaultBackend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		backends := map[remoteexecution.DigestFunction_Value]blobstore.BlobAccess{}
+		digestKeyFormat := defaultBackend.DigestKeyFormat
+		for i, route := range config.Routes {
+			if len(route.DigestFunctions) == 0 {
+				return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Route at index %d does not list any digest functions", i)
+			}
+			routeBackend, err := nc.NewNestedBlobAccess(route.Backend, creator)
+			if err != nil {
+				return BlobAccessInfo{}, "", err
+			}
+			for _, digestFunction := range route.DigestFunctions {
+				if _, ok := backends[digestFunction]; ok {
+					return BlobAccessInfo{}, "", status.Errorf(codes.InvalidArgument, "Route at index %d: Digest function %s is routed multiple times", i, digestFunction)
+				}
+				backends[digestFunction] = routeBackend.BlobAccess
+			}
+			digestKeyFormat = digestKeyFormat.Combine(routeBackend.DigestKeyFormat)
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewDigestFunctionRoutingBlobAccess(backends, defaultBackend.BlobAccess),
+			DigestKeyFormat: digestKeyFormat,
+		}, "digest_function_routing", nil
+	case *pb.BlobAccessConfiguration_DigestFunctionConsistencyChecking:
+		config := backend.DigestFunctionConsistencyChecking
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		var policy blobstore.MixedDigestFunctionsPolicy
+		switch config.MixedDigestFunctions {
+		case pb.DigestFunctionConsistencyCheckingBlobAccessConfiguration_REJECT:
+			policy = blobstore.MixedDigestFunctionsReject
+		case pb.DigestFunctionConsistencyCheckingBlobAccessConfiguration_PARTITION:
+			policy = blobstore.MixedDigestFunctionsPartition
+		default:
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Unknown mixed digest functions policy")
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewDigestFunctionConsistencyCheckingBlobAccess(base.BlobAccess, policy),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "digest_function_consistency_checking", nil
+	case *pb.BlobAccessConfiguration_ProvenanceRecording:
+		config := backend.ProvenanceRecording
+		if config.Name == "" {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Backend name must be non-empty")
+		}
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewProvenanceRecordingBlobAccess(base.BlobAccess, config.Name),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "provenance_recording", nil
+	case *pb.BlobAccessConfiguration_FindMissingShadowing:
+		config := backend.FindMissingShadowing
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		shadow, err := nc.NewNestedBlobAccess(config.Shadow, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.SamplingProbability < 0 || config.SamplingProbability > 1 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Sampling probability must be in range [0.0, 1.0]")
+		}
+		if config.MaximumConcurrentQueries <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of concurrent queries must be positive")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewFindMissingShadowingBlobAccess(
+				base.BlobAccess,
+				shadow.BlobAccess,
+				random.FastThreadSafeGenerator,
+				config.SamplingProbability,
+				int(config.MaximumConcurrentQueries),
+				creator.GetStorageTypeName()),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "find_missing_shadowing", nil
+	case *pb.BlobAccessConfiguration_PutRateLimiting:
+		config := backend.PutRateLimiting
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.PutsPerSecond <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Puts per second must be positive")
+		}
+		if config.Burst == 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Burst must be positive")
+		}
+		if config.MaximumPrincipals <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum number of principals must be positive")
+		}
+		return BlobAccessInfo{
+			BlobAccess: blobstore.NewPutRateLimitingBlobAccess(
+				base.BlobAccess,
+				clock.SystemClock,
+				eviction.NewMetricsSet(eviction.NewLRUSet[string](), "PutRateLimitingBlobAccess"),
+				int(config.MaximumPrincipals),
+				config.PutsPerSecond,
+				int(config.Burst)),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "put_rate_limiting", nil
+	case *pb.BlobAccessConfiguration_SlowOperationRecording:
+		config := backend.SlowOperationRecording
+		base, err := nc.NewNestedBlobAccess(config.Backend, creator)
+		if err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		if config.Name == "" {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "No name provided")
+		}
+		if err := config.Threshold.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid threshold")
+		}
+		if config.Capacity <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Capacity must be positive")
+		}
+		if err := config.MaximumAge.CheckValid(); err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid maximum age")
+		}
+		maximumAge := config.MaximumAge.AsDuration()
+		if maximumAge <= 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Maximum age must be positive")
+		}
+		recorder := blobstore.NewSlowOperationRecorder(clock.SystemClock, config.Threshold.AsDuration(), int(config.Capacity), maximumAge)
+		if err := global.RegisterDiagnosticsHTTPHandler("/slow_operations/"+config.Name, recorder); err != nil {
+			return BlobAccessInfo{}, "", err
+		}
+		return BlobAccessInfo{
+			BlobAccess:      blobstore.NewSlowOperationRecordingBlobAccess(base.BlobAccess, clock.SystemClock, recorder, config.Name),
+			DigestKeyFormat: base.DigestKeyFormat,
+		}, "slow_operation_recording", nil
 	case *pb.BlobAccessConfiguration_ZipReading:
 		config := backend.ZipReading
 		file, err := os.Open(config.Path)
@@ -465,11 +1220,14 @@ func (nc *simpleNestedBlobAccessCreator) newNestedBlobAccessBare(configuration *
 				creator.GetDefaultCapabilitiesProvider(),
 				cachedReadBufferFactory,
 				digestKeyFormat,
-				zipReader.File),
+				blobstore.FilterZIPFilesByHashPrefix(zipReader.File, config.HashPrefixes)),
 			DigestKeyFormat: digestKeyFormat,
 		}, "zip_reading", nil
 	case *pb.BlobAccessConfiguration_ZipWriting:
 		config := backend.ZipWriting
+		if len(config.HashPrefixes) > 0 {
+			return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Hash prefixes are only supported when reading ZIP files")
+		}
 		zipPath := config.Path
 		file, err := os.OpenFile(zipPath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o666)
 		if err != nil {
@@ -551,16 +1309,74 @@ func (nc *simpleNestedBlobAccessCreator) NewNestedBlobAccess(configuration *pb.B
 		return BlobAccessInfo{}, status.Errorf(codes.InvalidArgument, "Label %#v not declared", backend.Label)
 	}
 
+	if configuration.ReportPlacementInlineThresholdBytes < 0 {
+		return BlobAccessInfo{}, status.Error(codes.InvalidArgument, "Placement inline threshold must be non-negative")
+	}
 	backend, backendType, err := nc.newNestedBlobAccessBare(configuration, creator)
 	if err != nil {
 		return BlobAccessInfo{}, err
 	}
 	return BlobAccessInfo{
-		BlobAccess:      blobstore.NewMetricsBlobAccess(backend.BlobAccess, clock.SystemClock, creator.GetStorageTypeName(), backendType),
+		BlobAccess:      blobstore.NewMetricsBlobAccess(backend.BlobAccess, clock.SystemClock, creator.GetStorageTypeName(), backendType, configuration.ReportDigestFunctionMetrics, configuration.ReportPlacementInlineThresholdBytes),
 		DigestKeyFormat: backend.DigestKeyFormat,
 	}, nil
 }
 
+// newBackendTimeoutDecorator returns a function that wraps individual
+// backends of a sharded or mirrored setup into a TimeoutBlobAccess, if
+// a per-backend timeout is configured.
+func newBackendTimeoutDecorator(configuration *durationpb.Duration) (func(blobstore.BlobAccess) blobstore.BlobAccess, error) {
+	if configuration == nil {
+		return func(base blobstore.BlobAccess) blobstore.BlobAccess { return base }, nil
+	}
+	if err := configuration.CheckValid(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid backend timeout")
+	}
+	timeout := configuration.AsDuration()
+	if timeout <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Backend timeout must be positive")
+	}
+	return func(base blobstore.BlobAccess) blobstore.BlobAccess {
+		return blobstore.NewTimeoutBlobAccess(base, clock.SystemClock, timeout)
+	}, nil
+}
+
+func newFaultInjectionPolicyFromConfiguration(configuration *pb.FaultInjectionPolicy) (blobstore.FaultInjectionPolicy, error) {
+	if configuration == nil {
+		return blobstore.FaultInjectionPolicy{}, nil
+	}
+	errorProbability := configuration.ErrorProbability
+	if errorProbability < 0 || errorProbability > 1 {
+		return blobstore.FaultInjectionPolicy{}, status.Error(codes.InvalidArgument, "Error probability must be in range [0.0, 1.0]")
+	}
+	err := status.ErrorProto(configuration.Error)
+	if errorProbability > 0 && err == nil {
+		return blobstore.FaultInjectionPolicy{}, status.Error(codes.InvalidArgument, "An error with a non-zero code must be provided if the error probability is non-zero")
+	}
+	var minimumLatency, maximumLatency time.Duration
+	if configuration.MinimumLatency != nil {
+		if err := configuration.MinimumLatency.CheckValid(); err != nil {
+			return blobstore.FaultInjectionPolicy{}, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid minimum latency")
+		}
+		minimumLatency = configuration.MinimumLatency.AsDuration()
+	}
+	if configuration.MaximumLatency != nil {
+		if err := configuration.MaximumLatency.CheckValid(); err != nil {
+			return blobstore.FaultInjectionPolicy{}, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid maximum latency")
+		}
+		maximumLatency = configuration.MaximumLatency.AsDuration()
+	}
+	if minimumLatency < 0 || maximumLatency < minimumLatency {
+		return blobstore.FaultInjectionPolicy{}, status.Error(codes.InvalidArgument, "Latency range must be non-negative, and the maximum latency must not be smaller than the minimum latency")
+	}
+	return blobstore.FaultInjectionPolicy{
+		ErrorProbability: errorProbability,
+		Error:            err,
+		MinimumLatency:   minimumLatency,
+		MaximumLatency:   maximumLatency,
+	}, nil
+}
+
 // NewBlobAccessFromConfiguration creates a BlobAccess object based on a
 // configuration file.
 func NewBlobAccessFromConfiguration(terminationGroup program.Group, configuration *pb.BlobAccessConfiguration, creator BlobAccessCreator) (BlobAccessInfo, error) {
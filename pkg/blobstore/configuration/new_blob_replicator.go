@@ -6,6 +6,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
@@ -21,6 +22,19 @@ func NewBlobReplicatorFromConfiguration(configuration *pb.BlobReplicatorConfigur
 	storageTypeName := creator.GetStorageTypeName()
 	var configuredBlobReplicator replication.BlobReplicator
 	switch mode := configuration.Mode.(type) {
+	case *pb.BlobReplicatorConfiguration_Asynchronous:
+		if mode.Asynchronous.MaximumConcurrency <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Maximum concurrency must be positive")
+		}
+		base, err := NewBlobReplicatorFromConfiguration(mode.Asynchronous.Base, source, sink, creator)
+		if err != nil {
+			return nil, err
+		}
+		configuredBlobReplicator = replication.NewAsynchronousBlobReplicator(
+			source,
+			base,
+			util.DefaultErrorLogger,
+			int(mode.Asynchronous.MaximumConcurrency))
 	case *pb.BlobReplicatorConfiguration_ConcurrencyLimiting:
 		base, err := NewBlobReplicatorFromConfiguration(mode.ConcurrencyLimiting.Base, source, sink, creator)
 		if err != nil {
@@ -30,6 +44,32 @@ func NewBlobReplicatorFromConfiguration(configuration *pb.BlobReplicatorConfigur
 			base,
 			sink.BlobAccess,
 			semaphore.NewWeighted(mode.ConcurrencyLimiting.MaximumConcurrency))
+	case *pb.BlobReplicatorConfiguration_Demultiplexing:
+		// Construct a trie for each of the replicators
+		// specified in the configuration indexed by instance
+		// name prefix.
+		replicatorsTrie := digest.NewInstanceNameTrie()
+		replicators := make([]replication.BlobReplicator, 0, len(mode.Demultiplexing.InstanceNamePrefixes))
+		for k, replicatorConfiguration := range mode.Demultiplexing.InstanceNamePrefixes {
+			matchInstanceNamePrefix, err := digest.NewInstanceName(k)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Invalid instance name %#v", k)
+			}
+			replicator, err := NewBlobReplicatorFromConfiguration(replicatorConfiguration, source, sink, creator)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Instance name %#v", k)
+			}
+			replicatorsTrie.Set(matchInstanceNamePrefix, len(replicators))
+			replicators = append(replicators, replicator)
+		}
+		configuredBlobReplicator = replication.NewDemultiplexingBlobReplicator(
+			func(i digest.InstanceName) (replication.BlobReplicator, error) {
+				idx := replicatorsTrie.GetLongestPrefix(i)
+				if idx < 0 {
+					return nil, status.Errorf(codes.InvalidArgument, "Unknown instance name: %#v", i.String())
+				}
+				return replicators[idx], nil
+			})
 	case *pb.BlobReplicatorConfiguration_Local:
 		configuredBlobReplicator = replication.NewLocalBlobReplicator(source, sink.BlobAccess)
 	case *pb.BlobReplicatorConfiguration_Noop:
@@ -7,6 +7,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/util"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,3 +48,25 @@ func newProtoCustomBlobAccess(configuration *pb.BlobAccessConfiguration, nestedC
 		return BlobAccessInfo{}, "", status.Error(codes.InvalidArgument, "Configuration did not contain a supported storage backend")
 	}
 }
+
+// newZstdCompressingBlobAccess creates a ZstdCompressingBlobAccess
+// based on its configuration. It is shared by all types derived from
+// protoBlobAccessCreator that support compression.
+func newZstdCompressingBlobAccess(config *pb.ZstdCompressingBlobAccessConfiguration, nestedCreator NestedBlobAccessCreator, bac BlobAccessCreator, maximumMessageSizeBytes int) (BlobAccessInfo, string, error) {
+	base, err := nestedCreator.NewNestedBlobAccess(config.Backend, bac)
+	if err != nil {
+		return BlobAccessInfo{}, "", err
+	}
+	compressionEnabled := make(map[digest.InstanceName]bool, len(config.CompressionEnabled))
+	for instanceNameStr, enabled := range config.CompressionEnabled {
+		instanceName, err := digest.NewInstanceName(instanceNameStr)
+		if err != nil {
+			return BlobAccessInfo{}, "", util.StatusWrapf(err, "Invalid instance name %#v", instanceNameStr)
+		}
+		compressionEnabled[instanceName] = enabled
+	}
+	return BlobAccessInfo{
+		BlobAccess:      blobstore.NewZstdCompressingBlobAccess(base.BlobAccess, int(config.MinimumSizeBytes), maximumMessageSizeBytes, compressionEnabled),
+		DigestKeyFormat: base.DigestKeyFormat,
+	}, "zstd_compressing", nil
+}
@@ -0,0 +1,170 @@
+package blobstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DigestDenylist contains a set of digests that may not be stored or
+// served, loaded from a file. The file contains one digest per line,
+// in the form "${hash}/${size}". Empty lines and lines starting with
+// '#' are ignored. Digests match regardless of instance name and
+// digest function.
+//
+// The denylist may be reloaded at runtime by calling Reload().
+type DigestDenylist struct {
+	path string
+
+	lock    sync.RWMutex
+	digests map[string]struct{}
+}
+
+// NewDigestDenylist creates a DigestDenylist, loading its initial
+// contents from a file.
+func NewDigestDenylist(path string) (*DigestDenylist, error) {
+	dl := &DigestDenylist{path: path}
+	if err := dl.Reload(); err != nil {
+		return nil, err
+	}
+	return dl, nil
+}
+
+func getDigestDenylistKey(hash string, sizeBytes int64) string {
+	return fmt.Sprintf("%s/%d", hash, sizeBytes)
+}
+
+// Reload the contents of the denylist from disk. If the file cannot be
+// read or contains invalid entries, the existing contents of the
+// denylist are retained.
+func (dl *DigestDenylist) Reload() error {
+	data, err := os.ReadFile(dl.path)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to read denylist file %#v", dl.path)
+	}
+
+	digests := map[string]struct{}{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		hash, sizeBytesStr, ok := strings.Cut(line, "/")
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "Line %d of denylist file %#v is not of the form \"${hash}/${size}\"", lineNumber, dl.path)
+		}
+		if _, err := hex.DecodeString(hash); err != nil || hash == "" || strings.ToLower(hash) != hash {
+			return status.Errorf(codes.InvalidArgument, "Line %d of denylist file %#v contains invalid hash %#v", lineNumber, dl.path, hash)
+		}
+		sizeBytes, err := strconv.ParseInt(sizeBytesStr, 10, 64)
+		if err != nil || sizeBytes < 0 {
+			return status.Errorf(codes.InvalidArgument, "Line %d of denylist file %#v contains invalid size %#v", lineNumber, dl.path, sizeBytesStr)
+		}
+		digests[getDigestDenylistKey(hash, sizeBytes)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return util.StatusWrapf(err, "Failed to parse denylist file %#v", dl.path)
+	}
+
+	dl.lock.Lock()
+	dl.digests = digests
+	dl.lock.Unlock()
+	return nil
+}
+
+// Contains returns whether a digest is part of the denylist.
+func (dl *DigestDenylist) Contains(blobDigest digest.Digest) bool {
+	key := getDigestDenylistKey(blobDigest.GetHashString(), blobDigest.GetSizeBytes())
+	dl.lock.RLock()
+	_, ok := dl.digests[key]
+	dl.lock.RUnlock()
+	return ok
+}
+
+type digestDenylistingBlobAccess struct {
+	BlobAccess
+	denylist *DigestDenylist
+}
+
+// NewDigestDenylistingBlobAccess creates a decorator for BlobAccess
+// that blocks digests contained in a denylist, regardless of whether
+// they are present in the backend. Attempts to store these digests
+// fail with PERMISSION_DENIED, while attempts to read them fail with
+// NOT_FOUND. FindMissing() always reports them as missing.
+//
+// This decorator may be used to prevent blobs from being served after
+// they have been identified as harmful (e.g., as part of a cache
+// poisoning incident).
+func NewDigestDenylistingBlobAccess(base BlobAccess, denylist *DigestDenylist) BlobAccess {
+	return &digestDenylistingBlobAccess{
+		BlobAccess: base,
+		denylist:   denylist,
+	}
+}
+
+func newDigestDenylistedError(code codes.Code, blobDigest digest.Digest) error {
+	return status.Errorf(code, "Digest %s is denylisted", blobDigest)
+}
+
+func (ba *digestDenylistingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if ba.denylist.Contains(blobDigest) {
+		return buffer.NewBufferFromError(newDigestDenylistedError(codes.NotFound, blobDigest))
+	}
+	return ba.BlobAccess.Get(ctx, blobDigest)
+}
+
+func (ba *digestDenylistingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	if ba.denylist.Contains(parentDigest) {
+		return buffer.NewBufferFromError(newDigestDenylistedError(codes.NotFound, parentDigest))
+	}
+	if ba.denylist.Contains(childDigest) {
+		return buffer.NewBufferFromError(newDigestDenylistedError(codes.NotFound, childDigest))
+	}
+	return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (ba *digestDenylistingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	if ba.denylist.Contains(blobDigest) {
+		b.Discard()
+		return newDigestDenylistedError(codes.PermissionDenied, blobDigest)
+	}
+	return ba.BlobAccess.Put(ctx, blobDigest, b)
+}
+
+func (ba *digestDenylistingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	// Only forward digests that are not denylisted to the backend.
+	// Denylisted digests are always reported as missing.
+	allowed := digest.NewSetBuilder()
+	denied := digest.NewSetBuilder()
+	for _, blobDigest := range digests.Items() {
+		if ba.denylist.Contains(blobDigest) {
+			denied.Add(blobDigest)
+		} else {
+			allowed.Add(blobDigest)
+		}
+	}
+	if denied.Length() == 0 {
+		return ba.BlobAccess.FindMissing(ctx, digests)
+	}
+
+	missing, err := ba.BlobAccess.FindMissing(ctx, allowed.Build())
+	if err != nil {
+		return digest.EmptySet, err
+	}
+	return digest.GetUnion([]digest.Set{missing, denied.Build()}), nil
+}
@@ -0,0 +1,113 @@
+package blobstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDigestDenylistingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	denylistPath := filepath.Join(t.TempDir(), "denylist")
+	require.NoError(t, os.WriteFile(denylistPath, []byte(
+		"# Poisoned during incident.\n"+
+			"8b1a9953c4611296a827abf8c47804d7/5\n"+
+			"\n"), 0o666))
+	denylist, err := blobstore.NewDigestDenylist(denylistPath)
+	require.NoError(t, err)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewDigestDenylistingBlobAccess(baseBlobAccess, denylist)
+
+	deniedDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	allowedDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6cd3556deb0da54bca060b4c39479839", 13)
+
+	t.Run("PutDenied", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Digest 3-8b1a9953c4611296a827abf8c47804d7-5-hello is denylisted"),
+			blobAccess.Put(ctx, deniedDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("PutAllowed", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, allowedDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello, world!"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, allowedDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello, world!"))))
+	})
+
+	t.Run("GetDenied", func(t *testing.T) {
+		// Denylisted digests should not be requested from the
+		// backend, even if they are present.
+		_, err := blobAccess.Get(ctx, deniedDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Digest 3-8b1a9953c4611296a827abf8c47804d7-5-hello is denylisted"), err)
+	})
+
+	t.Run("GetAllowed", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Get(ctx, allowedDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello, world!")))
+
+		data, err := blobAccess.Get(ctx, allowedDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello, world!"), data)
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		// Denylisted digests should be reported as missing,
+		// while only the other digests are forwarded.
+		baseBlobAccess.EXPECT().FindMissing(ctx, allowedDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(deniedDigest).Add(allowedDigest).Build())
+		require.NoError(t, err)
+		require.Equal(t, deniedDigest.ToSingletonSet(), missing)
+	})
+
+	t.Run("Reload", func(t *testing.T) {
+		// Changes to the denylist file should be picked up
+		// after reloading.
+		require.NoError(t, os.WriteFile(denylistPath, []byte("6cd3556deb0da54bca060b4c39479839/13\n"), 0o666))
+		require.NoError(t, denylist.Reload())
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.PermissionDenied, "Digest 3-6cd3556deb0da54bca060b4c39479839-13-hello is denylisted"),
+			blobAccess.Put(ctx, allowedDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello, world!"))))
+
+		baseBlobAccess.EXPECT().Get(ctx, deniedDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		data, err := blobAccess.Get(ctx, deniedDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("ReloadInvalid", func(t *testing.T) {
+		// Invalid denylist files should be rejected, leaving
+		// the existing contents of the denylist intact.
+		require.NoError(t, os.WriteFile(denylistPath, []byte("8b1a9953c4611296a827abf8c47804d7\n"), 0o666))
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.InvalidArgument, "Line 1 of denylist file %#v is not of the form \"${hash}/${size}\"", denylistPath),
+			denylist.Reload())
+
+		require.True(t, denylist.Contains(allowedDigest))
+		require.False(t, denylist.Contains(deniedDigest))
+	})
+}
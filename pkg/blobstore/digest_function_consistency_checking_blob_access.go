@@ -0,0 +1,80 @@
+package blobstore
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MixedDigestFunctionsPolicy controls how
+// DigestFunctionConsistencyCheckingBlobAccess processes FindMissing()
+// requests containing digests of multiple digest functions.
+type MixedDigestFunctionsPolicy int
+
+const (
+	// MixedDigestFunctionsReject causes FindMissing() requests
+	// containing digests of multiple digest functions to fail with
+	// INVALID_ARGUMENT.
+	MixedDigestFunctionsReject MixedDigestFunctionsPolicy = iota
+	// MixedDigestFunctionsPartition causes FindMissing() requests
+	// containing digests of multiple digest functions to be split
+	// up into one request per digest function. The results of
+	// these requests are combined.
+	MixedDigestFunctionsPartition
+)
+
+type digestFunctionConsistencyCheckingBlobAccess struct {
+	BlobAccess
+	policy MixedDigestFunctionsPolicy
+}
+
+// NewDigestFunctionConsistencyCheckingBlobAccess creates a decorator
+// for BlobAccess that ensures that FindMissing() requests that are
+// forwarded to the backend only contain digests of a single digest
+// function. Clients are not expected to send requests for multiple
+// digest functions, but buggy clients may still do so. Backends that
+// route requests based on the digest function may not process such
+// requests consistently.
+func NewDigestFunctionConsistencyCheckingBlobAccess(base BlobAccess, policy MixedDigestFunctionsPolicy) BlobAccess {
+	return &digestFunctionConsistencyCheckingBlobAccess{
+		BlobAccess: base,
+		policy:     policy,
+	}
+}
+
+func (ba *digestFunctionConsistencyCheckingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	partitions := digests.PartitionByDigestFunction()
+	if len(partitions) <= 1 {
+		// Common case: all digests use the same digest function.
+		return ba.BlobAccess.FindMissing(ctx, digests)
+	}
+
+	if ba.policy != MixedDigestFunctionsPartition {
+		return digest.EmptySet, status.Errorf(codes.InvalidArgument, "Request contains digests of multiple digest functions, including %s and %s", getSetDigestFunction(partitions[0]), getSetDigestFunction(partitions[1]))
+	}
+
+	// Call FindMissing() for each of the digest functions and
+	// gather the results into a single set.
+	allMissing := make([]digest.Set, 0, len(partitions))
+	for _, partition := range partitions {
+		partitionMissing, err := ba.BlobAccess.FindMissing(ctx, partition)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Digest function %s", getSetDigestFunction(partition))
+		}
+		allMissing = append(allMissing, partitionMissing)
+	}
+	return digest.GetUnion(allMissing), nil
+}
+
+// getSetDigestFunction returns the digest function of the digests
+// contained in a non-empty set obtained through
+// digest.Set.PartitionByDigestFunction().
+func getSetDigestFunction(digests digest.Set) remoteexecution.DigestFunction_Value {
+	firstDigest, _ := digests.First()
+	return firstDigest.GetDigestFunction().GetEnumValue()
+}
@@ -0,0 +1,88 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDigestFunctionConsistencyCheckingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	sha256Digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	sha256Digest2 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e", 11)
+	md5Digest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("Reject", func(t *testing.T) {
+		baseBackend := mock.NewMockBlobAccess(ctrl)
+		blobAccess := blobstore.NewDigestFunctionConsistencyCheckingBlobAccess(baseBackend, blobstore.MixedDigestFunctionsReject)
+
+		t.Run("SingleDigestFunction", func(t *testing.T) {
+			// Requests using a single digest function
+			// should be forwarded as is.
+			baseBackend.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(sha256Digest1).Add(sha256Digest2).Build()).
+				Return(sha256Digest2.ToSingletonSet(), nil)
+
+			missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(sha256Digest1).Add(sha256Digest2).Build())
+			require.NoError(t, err)
+			require.Equal(t, sha256Digest2.ToSingletonSet(), missing)
+		})
+
+		t.Run("MixedDigestFunctions", func(t *testing.T) {
+			// Requests using multiple digest functions
+			// should be rejected without contacting the
+			// backend.
+			_, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(sha256Digest1).Add(md5Digest).Build())
+			testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Request contains digests of multiple digest functions, including SHA256 and MD5"), err)
+		})
+	})
+
+	t.Run("Partition", func(t *testing.T) {
+		// Place the decorator in front of a backend that routes
+		// requests by digest function.
+		sha256Backend := mock.NewMockBlobAccess(ctrl)
+		defaultBackend := mock.NewMockBlobAccess(ctrl)
+		blobAccess := blobstore.NewDigestFunctionConsistencyCheckingBlobAccess(
+			blobstore.NewDigestFunctionRoutingBlobAccess(
+				map[remoteexecution.DigestFunction_Value]blobstore.BlobAccess{
+					remoteexecution.DigestFunction_SHA256: sha256Backend,
+				},
+				defaultBackend),
+			blobstore.MixedDigestFunctionsPartition)
+
+		t.Run("MixedDigestFunctions", func(t *testing.T) {
+			// Requests using multiple digest functions
+			// should be split up, and be sent to the
+			// backends for each of the digest functions.
+			sha256Backend.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(sha256Digest1).Add(sha256Digest2).Build()).
+				Return(sha256Digest1.ToSingletonSet(), nil)
+			defaultBackend.EXPECT().FindMissing(ctx, md5Digest.ToSingletonSet()).
+				Return(md5Digest.ToSingletonSet(), nil)
+
+			missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(sha256Digest1).Add(sha256Digest2).Add(md5Digest).Build())
+			require.NoError(t, err)
+			require.Equal(t, digest.NewSetBuilder().Add(sha256Digest1).Add(md5Digest).Build(), missing)
+		})
+
+		t.Run("Failure", func(t *testing.T) {
+			sha256Backend.EXPECT().FindMissing(ctx, sha256Digest1.ToSingletonSet()).
+				Return(digest.EmptySet, nil).AnyTimes()
+			defaultBackend.EXPECT().FindMissing(ctx, md5Digest.ToSingletonSet()).
+				Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+
+			_, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(sha256Digest1).Add(md5Digest).Build())
+			testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Digest function MD5: Digest function MD5: Server offline"), err)
+		})
+	})
+}
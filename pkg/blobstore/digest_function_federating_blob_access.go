@@ -0,0 +1,171 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/errgroup"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type digestFunctionFederatingBlobAccess struct {
+	BlobAccess
+	remote               BlobAccess
+	translationIndex     DigestTranslationIndex
+	remoteDigestFunction remoteexecution.DigestFunction_Value
+}
+
+// NewDigestFunctionFederatingBlobAccess creates a decorator for the
+// Content Addressable Storage (CAS) that permits reading objects from
+// a remote cluster that uses a different digest function.
+//
+// When objects are written, their digest with respect to the remote
+// digest function is computed and stored in a translation index. When
+// objects are absent locally, the translation index is consulted to
+// determine under which digest the object is stored remotely. Objects
+// fetched from the remote cluster are validated against the local
+// digest, and are stored locally to speed up subsequent reads.
+func NewDigestFunctionFederatingBlobAccess(local, remote BlobAccess, translationIndex DigestTranslationIndex, remoteDigestFunction remoteexecution.DigestFunction_Value) BlobAccess {
+	return &digestFunctionFederatingBlobAccess{
+		BlobAccess:           local,
+		remote:               remote,
+		translationIndex:     translationIndex,
+		remoteDigestFunction: remoteDigestFunction,
+	}
+}
+
+func (ba *digestFunctionFederatingBlobAccess) getRemoteDigestFunction(localDigest digest.Digest) (digest.Function, error) {
+	digestFunction, err := localDigest.GetInstanceName().GetDigestFunction(ba.remoteDigestFunction, 0)
+	if err != nil {
+		return digest.Function{}, util.StatusWrap(err, "Failed to obtain remote digest function")
+	}
+	return digestFunction, nil
+}
+
+func (ba *digestFunctionFederatingBlobAccess) getFromRemote(ctx context.Context, localDigest digest.Digest) buffer.Buffer {
+	remoteDigest, err := ba.translationIndex.GetRemoteDigest(ctx, localDigest)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return buffer.NewBufferFromError(util.StatusWrap(err, "Object not found locally"))
+		}
+		return buffer.NewBufferFromError(util.StatusWrapWithCode(err, codes.Internal, "Failed to obtain remote digest"))
+	}
+
+	// Validate the data returned by the remote cluster against the
+	// local digest, and store a copy of it locally.
+	b1, b2 := buffer.NewCASBufferFromReader(
+		localDigest,
+		ba.remote.Get(ctx, remoteDigest).ToReader(),
+		buffer.BackendProvided(buffer.Irreparable(localDigest)),
+	).CloneStream()
+	return b1.WithTask(func() error {
+		if err := ba.BlobAccess.Put(ctx, localDigest, b2); err != nil {
+			return util.StatusWrap(err, "Failed to store object fetched from remote cluster")
+		}
+		return nil
+	})
+}
+
+func (ba *digestFunctionFederatingBlobAccess) Get(ctx context.Context, localDigest digest.Digest) buffer.Buffer {
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, localDigest),
+		&digestFunctionFederatingErrorHandler{
+			blobAccess:  ba,
+			context:     ctx,
+			localDigest: localDigest,
+		})
+}
+
+func (ba *digestFunctionFederatingBlobAccess) Put(ctx context.Context, localDigest digest.Digest, b buffer.Buffer) error {
+	remoteDigestFunction, err := ba.getRemoteDigestFunction(localDigest)
+	if err != nil {
+		b.Discard()
+		return err
+	}
+
+	// Store the object locally, while computing its digest with
+	// respect to the remote digest function.
+	b1, b2 := b.CloneStream()
+	var remoteDigest digest.Digest
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		return ba.BlobAccess.Put(groupCtx, localDigest, b1)
+	})
+	group.Go(func() error {
+		r := b2.ToReader()
+		defer r.Close()
+		generator := remoteDigestFunction.NewGenerator(localDigest.GetSizeBytes())
+		if _, err := io.Copy(generator, r); err != nil {
+			return util.StatusWrap(err, "Failed to compute remote digest")
+		}
+		remoteDigest = generator.Sum()
+		return nil
+	})
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	if err := ba.translationIndex.PutRemoteDigest(ctx, localDigest, remoteDigest); err != nil {
+		return util.StatusWrap(err, "Failed to store remote digest")
+	}
+	return nil
+}
+
+func (ba *digestFunctionFederatingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	missingLocally, err := ba.BlobAccess.FindMissing(ctx, digests)
+	if err != nil {
+		return digest.EmptySet, err
+	}
+
+	// Objects that are absent locally, but can be fetched from the
+	// remote cluster, should not be reported as missing.
+	remoteDigests := digest.NewSetBuilder()
+	localDigestsByRemoteDigest := map[digest.Digest]digest.Digest{}
+	missing := digest.NewSetBuilder()
+	for _, localDigest := range missingLocally.Items() {
+		remoteDigest, err := ba.translationIndex.GetRemoteDigest(ctx, localDigest)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return digest.EmptySet, util.StatusWrapfWithCode(err, codes.Internal, "Failed to obtain remote digest of object %#v", localDigest.String())
+			}
+			missing.Add(localDigest)
+			continue
+		}
+		remoteDigests.Add(remoteDigest)
+		localDigestsByRemoteDigest[remoteDigest] = localDigest
+	}
+	if len(localDigestsByRemoteDigest) > 0 {
+		missingRemotely, err := ba.remote.FindMissing(ctx, remoteDigests.Build())
+		if err != nil {
+			return digest.EmptySet, util.StatusWrap(err, "Remote")
+		}
+		for _, remoteDigest := range missingRemotely.Items() {
+			missing.Add(localDigestsByRemoteDigest[remoteDigest])
+		}
+	}
+	return missing.Build(), nil
+}
+
+type digestFunctionFederatingErrorHandler struct {
+	blobAccess  *digestFunctionFederatingBlobAccess
+	context     context.Context
+	localDigest digest.Digest
+	triedRemote bool
+}
+
+func (eh *digestFunctionFederatingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	if status.Code(err) != codes.NotFound || eh.triedRemote {
+		return nil, err
+	}
+	eh.triedRemote = true
+	return eh.blobAccess.getFromRemote(eh.context, eh.localDigest), nil
+}
+
+func (eh *digestFunctionFederatingErrorHandler) Done() {}
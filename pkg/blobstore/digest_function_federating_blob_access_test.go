@@ -0,0 +1,136 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDigestFunctionFederatingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	localBlobAccess := mock.NewMockBlobAccess(ctrl)
+	remoteBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewDigestFunctionFederatingBlobAccess(
+		localBlobAccess,
+		remoteBlobAccess,
+		blobstore.NewInMemoryDigestTranslationIndex(eviction.NewLRUSet[digest.Digest](), 10),
+		remoteexecution.DigestFunction_SHA512)
+
+	localDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	remoteDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA512, "3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315", 5)
+
+	t.Run("MissingTranslation", func(t *testing.T) {
+		// If the object is absent locally and no translation
+		// to the remote digest function exists, the remote
+		// cluster cannot be consulted.
+		localBlobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, localDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found locally: No translation to a remote digest exists"), err)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		// Writing an object should cause it to be stored
+		// locally, while its remote digest is recorded.
+		localBlobAccess.EXPECT().Put(gomock.Any(), localDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, localDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("LocalHit", func(t *testing.T) {
+		localBlobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, localDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("RemoteHit", func(t *testing.T) {
+		// If the object is absent locally, it should be fetched
+		// from the remote cluster using the translated digest,
+		// and be stored locally.
+		localBlobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		remoteBlobAccess.EXPECT().Get(ctx, remoteDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		localBlobAccess.EXPECT().Put(ctx, localDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		data, err := blobAccess.Get(ctx, localDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("RemoteCorrupted", func(t *testing.T) {
+		// Data returned by the remote cluster should be
+		// validated against the local digest.
+		localBlobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		remoteBlobAccess.EXPECT().Get(ctx, remoteDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Xyzzy")))
+		localBlobAccess.EXPECT().Put(ctx, localDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				_, err := b.ToByteSlice(100)
+				return err
+			})
+
+		_, err := blobAccess.Get(ctx, localDigest).ToByteSlice(100)
+		require.Equal(t, codes.Internal, status.Code(err))
+	})
+
+	t.Run("RemoteMissing", func(t *testing.T) {
+		localBlobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		remoteBlobAccess.EXPECT().Get(ctx, remoteDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		localBlobAccess.EXPECT().Put(ctx, localDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				_, err := b.ToByteSlice(100)
+				return err
+			})
+
+		_, err := blobAccess.Get(ctx, localDigest).ToByteSlice(100)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		// Objects that are absent locally, but present in the
+		// remote cluster, should not be reported as missing.
+		otherDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", 0)
+		localBlobAccess.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(localDigest).Add(otherDigest).Build()).
+			Return(digest.NewSetBuilder().Add(localDigest).Add(otherDigest).Build(), nil)
+		remoteBlobAccess.EXPECT().FindMissing(ctx, remoteDigest.ToSingletonSet()).
+			Return(digest.EmptySet, nil)
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(localDigest).Add(otherDigest).Build())
+		require.NoError(t, err)
+		require.Equal(t, otherDigest.ToSingletonSet(), missing)
+	})
+}
@@ -0,0 +1,72 @@
+package blobstore
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type digestFunctionRoutingBlobAccess struct {
+	backends       map[remoteexecution.DigestFunction_Value]BlobAccess
+	defaultBackend BlobAccess
+}
+
+// NewDigestFunctionRoutingBlobAccess creates a BlobAccess that forwards
+// requests to different backends, based on the digest function of the
+// objects being accessed. This can be used to store objects using a
+// new digest function (e.g., SHA256TREE) in a separate backend, while
+// objects using existing digest functions (e.g., SHA-256) remain
+// stored where they are.
+//
+// Requests for digest functions for which no backend is provided are
+// forwarded to the default backend. Calls to GetCapabilities() are
+// forwarded to the default backend as well.
+func NewDigestFunctionRoutingBlobAccess(backends map[remoteexecution.DigestFunction_Value]BlobAccess, defaultBackend BlobAccess) BlobAccess {
+	return &digestFunctionRoutingBlobAccess{
+		backends:       backends,
+		defaultBackend: defaultBackend,
+	}
+}
+
+func (ba *digestFunctionRoutingBlobAccess) getBackend(digestFunction remoteexecution.DigestFunction_Value) BlobAccess {
+	if backend, ok := ba.backends[digestFunction]; ok {
+		return backend
+	}
+	return ba.defaultBackend
+}
+
+func (ba *digestFunctionRoutingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	return ba.getBackend(blobDigest.GetDigestFunction().GetEnumValue()).Get(ctx, blobDigest)
+}
+
+func (ba *digestFunctionRoutingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return ba.getBackend(parentDigest.GetDigestFunction().GetEnumValue()).GetFromComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (ba *digestFunctionRoutingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	return ba.getBackend(blobDigest.GetDigestFunction().GetEnumValue()).Put(ctx, blobDigest, b)
+}
+
+func (ba *digestFunctionRoutingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	// Call FindMissing() on the backend of each of the digest
+	// functions and gather the results into a single set.
+	partitions := digests.PartitionByDigestFunction()
+	allMissing := make([]digest.Set, 0, len(partitions))
+	for _, partition := range partitions {
+		digestFunction := getSetDigestFunction(partition)
+		partitionMissing, err := ba.getBackend(digestFunction).FindMissing(ctx, partition)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Digest function %s", digestFunction)
+		}
+		allMissing = append(allMissing, partitionMissing)
+	}
+	return digest.GetUnion(allMissing), nil
+}
+
+func (ba *digestFunctionRoutingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	return ba.defaultBackend.GetCapabilities(ctx, instanceName)
+}
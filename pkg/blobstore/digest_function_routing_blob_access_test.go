@@ -0,0 +1,112 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDigestFunctionRoutingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	sha256Backend := mock.NewMockBlobAccess(ctrl)
+	sha256treeBackend := mock.NewMockBlobAccess(ctrl)
+	defaultBackend := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewDigestFunctionRoutingBlobAccess(
+		map[remoteexecution.DigestFunction_Value]blobstore.BlobAccess{
+			remoteexecution.DigestFunction_SHA256:     sha256Backend,
+			remoteexecution.DigestFunction_SHA256TREE: sha256treeBackend,
+		},
+		defaultBackend)
+
+	sha256Digest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	sha256treeDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256TREE, "ea8f163db38682925e4491c5e58d4bb3506ef8c14eb78a86e908c5624a67200f", 5)
+	md5Digest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("Get", func(t *testing.T) {
+		sha256Backend.EXPECT().Get(ctx, sha256Digest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		sha256treeBackend.EXPECT().Get(ctx, sha256treeDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		defaultBackend.EXPECT().Get(ctx, md5Digest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, sha256Digest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+
+		_, err = blobAccess.Get(ctx, sha256treeDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+
+		data, err = blobAccess.Get(ctx, md5Digest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		for _, testCase := range []struct {
+			backend    *mock.MockBlobAccess
+			blobDigest digest.Digest
+		}{
+			{sha256Backend, sha256Digest},
+			{sha256treeBackend, sha256treeDigest},
+			{defaultBackend, md5Digest},
+		} {
+			testCase.backend.EXPECT().Put(ctx, testCase.blobDigest, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+					data, err := b.ToByteSlice(100)
+					require.NoError(t, err)
+					require.Equal(t, []byte("Hello"), data)
+					return nil
+				})
+
+			require.NoError(t, blobAccess.Put(ctx, testCase.blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		}
+	})
+
+	t.Run("FindMissingMixed", func(t *testing.T) {
+		// Digests should be partitioned by digest function, and
+		// the results of all backends should be recombined.
+		otherSHA256Digest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "78ae647dc5544d227130a0682a51e30bc7777fbb6d8a8f17007463a3ecd1d524", 5)
+		sha256Backend.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(sha256Digest).Add(otherSHA256Digest).Build()).
+			Return(otherSHA256Digest.ToSingletonSet(), nil)
+		sha256treeBackend.EXPECT().FindMissing(ctx, sha256treeDigest.ToSingletonSet()).
+			Return(sha256treeDigest.ToSingletonSet(), nil)
+		defaultBackend.EXPECT().FindMissing(ctx, md5Digest.ToSingletonSet()).
+			Return(digest.EmptySet, nil)
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().
+			Add(sha256Digest).
+			Add(otherSHA256Digest).
+			Add(sha256treeDigest).
+			Add(md5Digest).
+			Build())
+		require.NoError(t, err)
+		require.Equal(t, digest.NewSetBuilder().Add(otherSHA256Digest).Add(sha256treeDigest).Build(), missing)
+	})
+
+	t.Run("FindMissingFailure", func(t *testing.T) {
+		sha256treeBackend.EXPECT().FindMissing(ctx, sha256treeDigest.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := blobAccess.FindMissing(ctx, sha256treeDigest.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Digest function SHA256TREE: Server offline"), err)
+	})
+
+	t.Run("GetCapabilities", func(t *testing.T) {
+		defaultBackend.EXPECT().GetCapabilities(ctx, digest.MustNewInstanceName("hello")).
+			Return(&remoteexecution.ServerCapabilities{}, nil)
+
+		_, err := blobAccess.GetCapabilities(ctx, digest.MustNewInstanceName("hello"))
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,119 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DigestTranslationIndex keeps track of how objects stored in one
+// cluster's Content Addressable Storage (CAS) can be referenced in
+// another cluster that uses a different digest function. It is used by
+// DigestFunctionFederatingBlobAccess.
+type DigestTranslationIndex interface {
+	// GetRemoteDigest returns the digest under which an object
+	// identified by a local digest is stored remotely. NOT_FOUND
+	// is returned if no translation exists.
+	GetRemoteDigest(ctx context.Context, localDigest digest.Digest) (digest.Digest, error)
+	// PutRemoteDigest records the digest under which an object
+	// identified by a local digest is stored remotely.
+	PutRemoteDigest(ctx context.Context, localDigest, remoteDigest digest.Digest) error
+}
+
+type inMemoryDigestTranslationIndex struct {
+	maximumSize int
+
+	lock         sync.Mutex
+	translations map[digest.Digest]digest.Digest
+	evictionSet  eviction.Set[digest.Digest]
+}
+
+// NewInMemoryDigestTranslationIndex creates a DigestTranslationIndex
+// that stores translations in memory. Once the maximum number of
+// translations is reached, translations are removed in the order
+// determined by the eviction set.
+func NewInMemoryDigestTranslationIndex(evictionSet eviction.Set[digest.Digest], maximumSize int) DigestTranslationIndex {
+	return &inMemoryDigestTranslationIndex{
+		maximumSize:  maximumSize,
+		translations: map[digest.Digest]digest.Digest{},
+		evictionSet:  evictionSet,
+	}
+}
+
+func (dti *inMemoryDigestTranslationIndex) GetRemoteDigest(ctx context.Context, localDigest digest.Digest) (digest.Digest, error) {
+	dti.lock.Lock()
+	defer dti.lock.Unlock()
+
+	remoteDigest, ok := dti.translations[localDigest]
+	if !ok {
+		return digest.BadDigest, status.Error(codes.NotFound, "No translation to a remote digest exists")
+	}
+	dti.evictionSet.Touch(localDigest)
+	return remoteDigest, nil
+}
+
+func (dti *inMemoryDigestTranslationIndex) PutRemoteDigest(ctx context.Context, localDigest, remoteDigest digest.Digest) error {
+	dti.lock.Lock()
+	defer dti.lock.Unlock()
+
+	if _, ok := dti.translations[localDigest]; ok {
+		dti.evictionSet.Touch(localDigest)
+	} else {
+		for len(dti.translations) >= dti.maximumSize && len(dti.translations) > 0 {
+			delete(dti.translations, dti.evictionSet.Peek())
+			dti.evictionSet.Remove()
+		}
+		dti.evictionSet.Insert(localDigest)
+	}
+	dti.translations[localDigest] = remoteDigest
+	return nil
+}
+
+type blobAccessDigestTranslationIndex struct {
+	blobAccess              BlobAccess
+	remoteDigestFunction    remoteexecution.DigestFunction_Value
+	maximumMessageSizeBytes int
+}
+
+// NewBlobAccessDigestTranslationIndex creates a DigestTranslationIndex
+// that stores translations in a BlobAccess, keyed by local digest. This
+// permits storing translations persistently, so that they remain
+// available across restarts.
+func NewBlobAccessDigestTranslationIndex(blobAccess BlobAccess, remoteDigestFunction remoteexecution.DigestFunction_Value, maximumMessageSizeBytes int) DigestTranslationIndex {
+	return &blobAccessDigestTranslationIndex{
+		blobAccess:              blobAccess,
+		remoteDigestFunction:    remoteDigestFunction,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+	}
+}
+
+func (dti *blobAccessDigestTranslationIndex) GetRemoteDigest(ctx context.Context, localDigest digest.Digest) (digest.Digest, error) {
+	m, err := dti.blobAccess.Get(ctx, localDigest).ToProto(&remoteexecution.Digest{}, dti.maximumMessageSizeBytes)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return digest.BadDigest, status.Error(codes.NotFound, "No translation to a remote digest exists")
+		}
+		return digest.BadDigest, err
+	}
+	remoteDigestFunction, err := localDigest.GetInstanceName().GetDigestFunction(dti.remoteDigestFunction, 0)
+	if err != nil {
+		return digest.BadDigest, util.StatusWrap(err, "Failed to obtain remote digest function")
+	}
+	remoteDigest, err := remoteDigestFunction.NewDigestFromProto(m.(*remoteexecution.Digest))
+	if err != nil {
+		return digest.BadDigest, util.StatusWrapWithCode(err, codes.Internal, "Invalid remote digest")
+	}
+	return remoteDigest, nil
+}
+
+func (dti *blobAccessDigestTranslationIndex) PutRemoteDigest(ctx context.Context, localDigest, remoteDigest digest.Digest) error {
+	return dti.blobAccess.Put(ctx, localDigest, buffer.NewProtoBufferFromProto(remoteDigest.GetProto(), buffer.UserProvided))
+}
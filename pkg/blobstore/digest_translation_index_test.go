@@ -0,0 +1,76 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBlobAccessDigestTranslationIndex(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	translationIndex := blobstore.NewBlobAccessDigestTranslationIndex(blobAccess, remoteexecution.DigestFunction_SHA512, 1000)
+
+	localDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	remoteDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA512, "3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315", 5)
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		blobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := translationIndex.GetRemoteDigest(ctx, localDigest)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "No translation to a remote digest exists"), err)
+	})
+
+	t.Run("GetFailure", func(t *testing.T) {
+		blobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+
+		_, err := translationIndex.GetRemoteDigest(ctx, localDigest)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("GetInvalidDigest", func(t *testing.T) {
+		blobAccess.EXPECT().Get(ctx, localDigest).
+			Return(buffer.NewProtoBufferFromProto(&remoteexecution.Digest{
+				Hash:      "8b1a9953c4611296a827abf8c47804d7",
+				SizeBytes: 5,
+			}, buffer.UserProvided))
+
+		_, err := translationIndex.GetRemoteDigest(ctx, localDigest)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Invalid remote digest: Hash has length 32, while 128 characters were expected"), err)
+	})
+
+	t.Run("PutAndGet", func(t *testing.T) {
+		// Translations should be stored as Digest messages,
+		// keyed by local digest.
+		var stored []byte
+		blobAccess.EXPECT().Put(ctx, localDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(1000)
+				require.NoError(t, err)
+				stored = data
+				return nil
+			})
+		require.NoError(t, translationIndex.PutRemoteDigest(ctx, localDigest, remoteDigest))
+
+		blobAccess.EXPECT().Get(ctx, localDigest).
+			Return(blobstore.DigestTranslationReadBufferFactory.NewBufferFromByteSlice(localDigest, stored, buffer.Irreparable(localDigest)))
+		translatedDigest, err := translationIndex.GetRemoteDigest(ctx, localDigest)
+		require.NoError(t, err)
+		require.Equal(t, remoteDigest, translatedDigest)
+	})
+}
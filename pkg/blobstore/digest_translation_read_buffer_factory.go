@@ -0,0 +1,35 @@
+package blobstore
+
+import (
+	"io"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type digestTranslationReadBufferFactory struct{}
+
+func (f digestTranslationReadBufferFactory) NewBufferFromByteSlice(digest digest.Digest, data []byte, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return buffer.NewProtoBufferFromByteSlice(&remoteexecution.Digest{}, data, buffer.BackendProvided(dataIntegrityCallback))
+}
+
+func (f digestTranslationReadBufferFactory) NewBufferFromReader(digest digest.Digest, r io.ReadCloser, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return f.NewBufferFromByteSlice(digest, data, dataIntegrityCallback)
+}
+
+func (f digestTranslationReadBufferFactory) NewBufferFromReaderAt(digest digest.Digest, r buffer.ReadAtCloser, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return f.NewBufferFromReader(digest, newReaderFromReaderAt(r), dataIntegrityCallback)
+}
+
+// DigestTranslationReadBufferFactory is capable of creating
+// identifiers and buffers for objects stored in the translation index
+// of DigestFunctionFederatingBlobAccess. Objects are keyed by their
+// local digest, and contain a Digest message holding the digest of the
+// object with respect to the remote digest function.
+var DigestTranslationReadBufferFactory ReadBufferFactory = digestTranslationReadBufferFactory{}
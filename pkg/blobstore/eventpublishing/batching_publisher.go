@@ -0,0 +1,155 @@
+package eventpublishing
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	batchingPublisherPrometheusMetrics sync.Once
+
+	batchingPublisherEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "batching_publisher_events_total",
+			Help:      "Number of cache events processed by BatchingPublisher",
+		},
+		[]string{"outcome"})
+	batchingPublisherEventsDropped   = batchingPublisherEvents.WithLabelValues("Dropped")
+	batchingPublisherEventsFailed    = batchingPublisherEvents.WithLabelValues("Failed")
+	batchingPublisherEventsPublished = batchingPublisherEvents.WithLabelValues("Published")
+)
+
+// Publisher of cache events. Calls to Publish() never block.
+type Publisher interface {
+	Publish(event *cacheevents_pb.CacheEvent)
+}
+
+// BatchingPublisher is an implementation of Publisher that queues
+// events, and submits them to a Sink in batches. If the Sink is unable
+// to keep up, the queue fills up and events are dropped. This ensures
+// that publishing events never slows down requests.
+type BatchingPublisher struct {
+	sink             Sink
+	clock            clock.Clock
+	maximumBatchSize int
+	flushInterval    time.Duration
+	publishTimeout   time.Duration
+	errorLogger      util.ErrorLogger
+	events           chan *cacheevents_pb.CacheEvent
+}
+
+var _ Publisher = (*BatchingPublisher)(nil)
+
+// NewBatchingPublisher creates a BatchingPublisher that holds up to
+// queueSize events that have not been submitted to the Sink. Batches
+// are submitted when they reach maximumBatchSize events, or when
+// flushInterval has passed since the first event in the batch was
+// queued. Submitting a batch may take at most publishTimeout, so that
+// an unresponsive Sink does not cause the queue to stall indefinitely.
+func NewBatchingPublisher(sink Sink, clock clock.Clock, queueSize, maximumBatchSize int, flushInterval, publishTimeout time.Duration, errorLogger util.ErrorLogger) *BatchingPublisher {
+	batchingPublisherPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(batchingPublisherEvents)
+	})
+
+	return &BatchingPublisher{
+		sink:             sink,
+		clock:            clock,
+		maximumBatchSize: maximumBatchSize,
+		flushInterval:    flushInterval,
+		publishTimeout:   publishTimeout,
+		errorLogger:      errorLogger,
+		events:           make(chan *cacheevents_pb.CacheEvent, queueSize),
+	}
+}
+
+// Publish an event by placing it in the queue. The event is dropped if
+// the queue is full.
+func (p *BatchingPublisher) Publish(event *cacheevents_pb.CacheEvent) {
+	select {
+	case p.events <- event:
+	default:
+		batchingPublisherEventsDropped.Inc()
+	}
+}
+
+func (p *BatchingPublisher) submit(ctx context.Context, batch []*cacheevents_pb.CacheEvent) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, p.publishTimeout)
+	err := p.sink.Publish(ctxWithTimeout, batch)
+	cancel()
+	if err != nil {
+		batchingPublisherEventsFailed.Add(float64(len(batch)))
+		p.errorLogger.Log(util.StatusWrapf(err, "Failed to publish %d cache events", len(batch)))
+		return
+	}
+	batchingPublisherEventsPublished.Add(float64(len(batch)))
+}
+
+// gatherEvents appends queued events to a batch until it is full, or
+// until one of the provided channels is triggered.
+func (p *BatchingPublisher) gatherEvents(batch []*cacheevents_pb.CacheEvent, timeout <-chan time.Time, done <-chan struct{}) []*cacheevents_pb.CacheEvent {
+	for len(batch) < p.maximumBatchSize {
+		select {
+		case event := <-p.events:
+			batch = append(batch, event)
+		case <-timeout:
+			return batch
+		case <-done:
+			return batch
+		}
+	}
+	return batch
+}
+
+// gatherQueuedEvents returns a batch of events that are currently
+// queued, without waiting for additional events to arrive.
+func (p *BatchingPublisher) gatherQueuedEvents() []*cacheevents_pb.CacheEvent {
+	var batch []*cacheevents_pb.CacheEvent
+	for len(batch) < p.maximumBatchSize {
+		select {
+		case event := <-p.events:
+			batch = append(batch, event)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// Run the processing loop of the BatchingPublisher, submitting queued
+// events to the Sink. Once the context is cancelled, any events that
+// remain in the queue are submitted, after which this function
+// returns.
+func (p *BatchingPublisher) Run(ctx context.Context) {
+	submitCtx := context.WithoutCancel(ctx)
+	for {
+		// Wait for the first event of the next batch.
+		var batch []*cacheevents_pb.CacheEvent
+		select {
+		case <-ctx.Done():
+			for {
+				batch := p.gatherQueuedEvents()
+				if len(batch) == 0 {
+					return
+				}
+				p.submit(submitCtx, batch)
+			}
+		case event := <-p.events:
+			batch = append(batch, event)
+		}
+
+		// Gather additional events until the batch is full, or
+		// the flush interval has passed.
+		timer, timerChannel := p.clock.NewTimer(p.flushInterval)
+		batch = p.gatherEvents(batch, timerChannel, ctx.Done())
+		timer.Stop()
+		p.submit(submitCtx, batch)
+	}
+}
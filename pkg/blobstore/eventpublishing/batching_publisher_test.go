@@ -0,0 +1,76 @@
+package eventpublishing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/eventpublishing"
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBatchingPublisher(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	sink := mock.NewMockSink(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	publisher := eventpublishing.NewBatchingPublisher(
+		sink,
+		clock,
+		/* queueSize = */ 3,
+		/* maximumBatchSize = */ 2,
+		/* flushInterval = */ 10*time.Second,
+		/* publishTimeout = */ 30*time.Second,
+		errorLogger)
+
+	event1 := &cacheevents_pb.CacheEvent{StorageType: "cas", InstanceName: "a"}
+	event2 := &cacheevents_pb.CacheEvent{StorageType: "cas", InstanceName: "b"}
+	event3 := &cacheevents_pb.CacheEvent{StorageType: "cas", InstanceName: "c"}
+	event4 := &cacheevents_pb.CacheEvent{StorageType: "cas", InstanceName: "d"}
+
+	// The queue can only hold three events. The fourth event should
+	// be dropped, as publishing events may not block.
+	publisher.Publish(event1)
+	publisher.Publish(event2)
+	publisher.Publish(event3)
+	publisher.Publish(event4)
+
+	// The first two events fit in a single batch, meaning they
+	// should be submitted without waiting for the timer to expire.
+	timer1 := mock.NewMockTimer(ctrl)
+	clock.EXPECT().NewTimer(10*time.Second).Return(timer1, nil)
+	timer1.EXPECT().Stop()
+	// Submission should be subject to a timeout.
+	sink.EXPECT().Publish(gomock.Any(), []*cacheevents_pb.CacheEvent{event1, event2}).
+		DoAndReturn(func(ctx context.Context, events []*cacheevents_pb.CacheEvent) error {
+			_, ok := ctx.Deadline()
+			require.True(t, ok)
+			return nil
+		})
+
+	// The third event is submitted once the timer expires. Let
+	// submission fail, and shut down the publisher afterwards.
+	timer2 := mock.NewMockTimer(ctrl)
+	timerChannel2 := make(chan time.Time, 1)
+	timerChannel2 <- time.Unix(1010, 0)
+	clock.EXPECT().NewTimer(10*time.Second).Return(timer2, timerChannel2)
+	timer2.EXPECT().Stop()
+	runCtx, cancel := context.WithCancel(ctx)
+	sink.EXPECT().Publish(gomock.Any(), []*cacheevents_pb.CacheEvent{event3}).
+		DoAndReturn(func(ctx context.Context, events []*cacheevents_pb.CacheEvent) error {
+			cancel()
+			return status.Error(codes.Unavailable, "Server not reachable")
+		})
+	errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to publish 1 cache events: Server not reachable")))
+
+	publisher.Run(runCtx)
+}
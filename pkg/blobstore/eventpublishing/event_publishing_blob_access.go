@@ -0,0 +1,135 @@
+package eventpublishing
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type eventPublishingBlobAccess struct {
+	blobstore.BlobAccess
+	publisher   Publisher
+	clock       clock.Clock
+	storageType string
+	missesOnly  bool
+}
+
+// NewEventPublishingBlobAccess creates a decorator for BlobAccess that
+// emits a CacheEvent for every object that is read, written or checked
+// for existence. These events may be used to perform offline analysis
+// of cache hit rates and access patterns.
+//
+// Events are only emitted for operations that succeed, or that fail
+// with NOT_FOUND. Other errors are not indicative of whether objects
+// are present, and are therefore ignored.
+//
+// If missesOnly is set, events for reads and existence checks of
+// objects that are present are suppressed. Only misses and writes
+// (which typically follow misses) are emitted, which significantly
+// reduces the number of events for caches with a high hit rate.
+func NewEventPublishingBlobAccess(base blobstore.BlobAccess, publisher Publisher, clock clock.Clock, storageType string, missesOnly bool) blobstore.BlobAccess {
+	return &eventPublishingBlobAccess{
+		BlobAccess:  base,
+		publisher:   publisher,
+		clock:       clock,
+		storageType: storageType,
+		missesOnly:  missesOnly,
+	}
+}
+
+func (ba *eventPublishingBlobAccess) publish(ctx context.Context, blobDigest digest.Digest, operation cacheevents_pb.CacheEvent_Operation, hit bool) {
+	if hit && ba.missesOnly {
+		return
+	}
+	event := &cacheevents_pb.CacheEvent{
+		Timestamp:      timestamppb.New(ba.clock.Now()),
+		StorageType:    ba.storageType,
+		InstanceName:   blobDigest.GetInstanceName().String(),
+		DigestFunction: blobDigest.GetDigestFunction().GetEnumValue(),
+		Digest:         blobDigest.GetProto(),
+		Operation:      operation,
+		Hit:            hit,
+	}
+	if principal, shouldDisplay := auth.AuthenticationMetadataFromContext(ctx).GetPublicProto(); shouldDisplay {
+		event.Principal = principal
+	}
+	ba.publisher.Publish(event)
+}
+
+func (ba *eventPublishingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, blobDigest),
+		&eventPublishingErrorHandler{
+			blobAccess: ba,
+			ctx:        ctx,
+			digest:     blobDigest,
+			hit:        true,
+		})
+}
+
+func (ba *eventPublishingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+		&eventPublishingErrorHandler{
+			blobAccess: ba,
+			ctx:        ctx,
+			digest:     childDigest,
+			hit:        true,
+		})
+}
+
+func (ba *eventPublishingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	if err := ba.BlobAccess.Put(ctx, blobDigest, b); err != nil {
+		return err
+	}
+	ba.publish(ctx, blobDigest, cacheevents_pb.CacheEvent_PUT, false)
+	return nil
+}
+
+func (ba *eventPublishingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	missing, err := ba.BlobAccess.FindMissing(ctx, digests)
+	if err != nil {
+		return digest.EmptySet, err
+	}
+	present, stillMissing, _ := digest.GetDifferenceAndIntersection(digests, missing)
+	for _, blobDigest := range present.Items() {
+		ba.publish(ctx, blobDigest, cacheevents_pb.CacheEvent_FIND_MISSING, true)
+	}
+	for _, blobDigest := range stillMissing.Items() {
+		ba.publish(ctx, blobDigest, cacheevents_pb.CacheEvent_FIND_MISSING, false)
+	}
+	return missing, nil
+}
+
+type eventPublishingErrorHandler struct {
+	blobAccess *eventPublishingBlobAccess
+	ctx        context.Context
+	digest     digest.Digest
+	hit        bool
+	skip       bool
+}
+
+func (eh *eventPublishingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	if status.Code(err) == codes.NotFound {
+		eh.hit = false
+	} else {
+		eh.skip = true
+	}
+	return nil, err
+}
+
+func (eh *eventPublishingErrorHandler) Done() {
+	if !eh.skip {
+		eh.blobAccess.publish(eh.ctx, eh.digest, cacheevents_pb.CacheEvent_GET, eh.hit)
+	}
+}
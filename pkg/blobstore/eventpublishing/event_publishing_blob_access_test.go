@@ -0,0 +1,189 @@
+package eventpublishing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/eventpublishing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/auth"
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestEventPublishingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	publisher := mock.NewMockPublisher(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := eventpublishing.NewEventPublishingBlobAccess(baseBlobAccess, publisher, clock, "cas", false)
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	ctxAlice := auth.NewContextWithAuthenticationMetadata(ctx, auth.MustNewAuthenticationMetadataFromProto(&auth_pb.AuthenticationMetadata{
+		Public: structpb.NewStringValue("alice"),
+	}))
+	newEvent := func(d digest.Digest, operation cacheevents_pb.CacheEvent_Operation, hit bool) *cacheevents_pb.CacheEvent {
+		return &cacheevents_pb.CacheEvent{
+			Timestamp:      &timestamppb.Timestamp{Seconds: 1000},
+			StorageType:    "cas",
+			InstanceName:   "example",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			Digest:         d.GetProto(),
+			Operation:      operation,
+			Hit:            hit,
+		}
+	}
+
+	t.Run("GetHit", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Get(ctxAlice, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		expectedEvent := newEvent(blobDigest, cacheevents_pb.CacheEvent_GET, true)
+		expectedEvent.Principal = &auth_pb.AuthenticationMetadata{
+			Public: structpb.NewStringValue("alice"),
+		}
+		publisher.EXPECT().Publish(testutil.EqProto(t, expectedEvent))
+
+		data, err := blobAccess.Get(ctxAlice, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetMiss", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		publisher.EXPECT().Publish(testutil.EqProto(t, newEvent(blobDigest, cacheevents_pb.CacheEvent_GET, false)))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("GetFailure", func(t *testing.T) {
+		// Errors other than NOT_FOUND say nothing about whether
+		// the object is present. No event should be published.
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Internal, "Disk on fire")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Disk on fire"), err)
+	})
+
+	t.Run("PutSuccess", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		publisher.EXPECT().Publish(testutil.EqProto(t, newEvent(blobDigest, cacheevents_pb.CacheEvent_PUT, false)))
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("PutFailure", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.Internal, "Disk on fire")
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Disk on fire"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		otherDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+		baseBlobAccess.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(blobDigest).Add(otherDigest).Build()).
+			Return(otherDigest.ToSingletonSet(), nil)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(2)
+		publisher.EXPECT().Publish(testutil.EqProto(t, newEvent(blobDigest, cacheevents_pb.CacheEvent_FIND_MISSING, true)))
+		publisher.EXPECT().Publish(testutil.EqProto(t, newEvent(otherDigest, cacheevents_pb.CacheEvent_FIND_MISSING, false)))
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(blobDigest).Add(otherDigest).Build())
+		require.NoError(t, err)
+		require.Equal(t, otherDigest.ToSingletonSet(), missing)
+	})
+}
+
+func TestEventPublishingBlobAccessMissesOnly(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	publisher := mock.NewMockPublisher(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := eventpublishing.NewEventPublishingBlobAccess(baseBlobAccess, publisher, clock, "cas", true)
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	newEvent := func(d digest.Digest, operation cacheevents_pb.CacheEvent_Operation) *cacheevents_pb.CacheEvent {
+		return &cacheevents_pb.CacheEvent{
+			Timestamp:      &timestamppb.Timestamp{Seconds: 1000},
+			StorageType:    "cas",
+			InstanceName:   "example",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			Digest:         d.GetProto(),
+			Operation:      operation,
+		}
+	}
+
+	t.Run("GetHit", func(t *testing.T) {
+		// Hits should not cause any events to be published.
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetMiss", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		publisher.EXPECT().Publish(testutil.EqProto(t, newEvent(blobDigest, cacheevents_pb.CacheEvent_GET)))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("PutSuccess", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		publisher.EXPECT().Publish(testutil.EqProto(t, newEvent(blobDigest, cacheevents_pb.CacheEvent_PUT)))
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		// Only digests that are missing should be published.
+		otherDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+		baseBlobAccess.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(blobDigest).Add(otherDigest).Build()).
+			Return(otherDigest.ToSingletonSet(), nil)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		publisher.EXPECT().Publish(testutil.EqProto(t, newEvent(otherDigest, cacheevents_pb.CacheEvent_FIND_MISSING)))
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(blobDigest).Add(otherDigest).Build())
+		require.NoError(t, err)
+		require.Equal(t, otherDigest.ToSingletonSet(), missing)
+	})
+}
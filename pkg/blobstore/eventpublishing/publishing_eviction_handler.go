@@ -0,0 +1,41 @@
+package eventpublishing
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type publishingEvictionHandler struct {
+	publisher   Publisher
+	clock       clock.Clock
+	storageType string
+}
+
+// NewPublishingEvictionHandler creates an EvictionHandler for local
+// storage that emits a CacheEvent with operation EVICT for every
+// object that is evicted.
+func NewPublishingEvictionHandler(publisher Publisher, clock clock.Clock, storageType string) local.EvictionHandler {
+	return &publishingEvictionHandler{
+		publisher:   publisher,
+		clock:       clock,
+		storageType: storageType,
+	}
+}
+
+func (eh *publishingEvictionHandler) HandleEvictedBlob(evictedBlob local.EvictedBlob) {
+	blobDigest := evictedBlob.Digest
+	eh.publisher.Publish(&cacheevents_pb.CacheEvent{
+		Timestamp:      timestamppb.New(eh.clock.Now()),
+		StorageType:    eh.storageType,
+		InstanceName:   blobDigest.GetInstanceName().String(),
+		DigestFunction: blobDigest.GetDigestFunction().GetEnumValue(),
+		Digest:         blobDigest.GetProto(),
+		Operation:      cacheevents_pb.CacheEvent_EVICT,
+		Age:            durationpb.New(evictedBlob.Age),
+		Refreshed:      evictedBlob.Refreshed,
+	})
+}
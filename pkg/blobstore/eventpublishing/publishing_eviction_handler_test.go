@@ -0,0 +1,47 @@
+package eventpublishing_test
+
+import (
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/eventpublishing"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPublishingEvictionHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	publisher := mock.NewMockPublisher(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	evictionHandler := eventpublishing.NewPublishingEvictionHandler(publisher, clock, "ac")
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	publisher.EXPECT().Publish(testutil.EqProto(t, &cacheevents_pb.CacheEvent{
+		Timestamp:      &timestamppb.Timestamp{Seconds: 1000},
+		StorageType:    "ac",
+		InstanceName:   "example",
+		DigestFunction: remoteexecution.DigestFunction_MD5,
+		Digest:         blobDigest.GetProto(),
+		Operation:      cacheevents_pb.CacheEvent_EVICT,
+		Age:            &durationpb.Duration{Seconds: 300},
+		Refreshed:      true,
+	}))
+
+	evictionHandler.HandleEvictedBlob(local.EvictedBlob{
+		Digest:    blobDigest,
+		Age:       5 * time.Minute,
+		Refreshed: true,
+	})
+}
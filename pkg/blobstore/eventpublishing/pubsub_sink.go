@@ -0,0 +1,78 @@
+package eventpublishing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type pubSubSink struct {
+	client     *http.Client
+	publishURL string
+}
+
+// NewPubSubSink creates a Sink that publishes cache events to a Google
+// Cloud Pub/Sub topic. Every event is published as a separate message,
+// containing a serialized CacheEvent message. Each batch of events is
+// submitted using a single call to the Pub/Sub REST API.
+//
+// The topic must be provided in the form
+// "projects/${project}/topics/${topic}". The HTTP client must be
+// capable of authenticating against Google Cloud.
+func NewPubSubSink(client *http.Client, endpoint, topic string) Sink {
+	return &pubSubSink{
+		client:     client,
+		publishURL: fmt.Sprintf("%s/v1/%s:publish", endpoint, topic),
+	}
+}
+
+type pubSubMessage struct {
+	Data []byte `json:"data"`
+}
+
+type pubSubPublishRequest struct {
+	Messages []pubSubMessage `json:"messages"`
+}
+
+func (s *pubSubSink) Publish(ctx context.Context, events []*cacheevents_pb.CacheEvent) error {
+	request := pubSubPublishRequest{
+		Messages: make([]pubSubMessage, 0, len(events)),
+	}
+	for _, event := range events {
+		data, err := proto.Marshal(event)
+		if err != nil {
+			return util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to marshal cache event")
+		}
+		request.Messages = append(request.Messages, pubSubMessage{Data: data})
+	}
+	body, err := json.Marshal(&request)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal publish request")
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, s.publishURL, bytes.NewReader(body))
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to create HTTP request")
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(httpRequest)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "HTTP request failed")
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return status.Errorf(codes.Unavailable, "HTTP request failed with status %#v", resp.Status)
+	}
+	return nil
+}
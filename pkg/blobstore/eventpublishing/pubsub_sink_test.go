@@ -0,0 +1,90 @@
+package eventpublishing_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/eventpublishing"
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPubSubSink(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	roundTripper := mock.NewMockRoundTripper(ctrl)
+	sink := eventpublishing.NewPubSubSink(
+		&http.Client{Transport: roundTripper},
+		"https://pubsub.example.com",
+		"projects/myproject/topics/mytopic")
+
+	event1 := &cacheevents_pb.CacheEvent{StorageType: "cas", InstanceName: "a"}
+	event2 := &cacheevents_pb.CacheEvent{StorageType: "cas", InstanceName: "b"}
+
+	t.Run("HTTPRequestFailed", func(t *testing.T) {
+		roundTripper.EXPECT().RoundTrip(gomock.Any()).Return(nil, errors.New("dial tcp 1.2.3.4:443: connect: connection refused"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "HTTP request failed: Post \"https://pubsub.example.com/v1/projects/myproject/topics/mytopic:publish\": dial tcp 1.2.3.4:443: connect: connection refused"),
+			sink.Publish(ctx, []*cacheevents_pb.CacheEvent{event1}))
+	})
+
+	t.Run("HTTPBadStatusCode", func(t *testing.T) {
+		roundTripper.EXPECT().RoundTrip(gomock.Any()).Return(&http.Response{
+			Status:     "403 Forbidden",
+			StatusCode: 403,
+			Body:       io.NopCloser(strings.NewReader("Permission denied")),
+		}, nil)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "HTTP request failed with status \"403 Forbidden\""),
+			sink.Publish(ctx, []*cacheevents_pb.CacheEvent{event1}))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// All events should be submitted as part of a single
+		// request, each being a separate message containing a
+		// serialized CacheEvent.
+		roundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, http.MethodPost, req.Method)
+			require.Equal(t, "https://pubsub.example.com/v1/projects/myproject/topics/mytopic:publish", req.URL.String())
+			require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+			var request struct {
+				Messages []struct {
+					Data []byte `json:"data"`
+				} `json:"messages"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&request))
+			require.Len(t, request.Messages, 2)
+			for i, expectedEvent := range []*cacheevents_pb.CacheEvent{event1, event2} {
+				var event cacheevents_pb.CacheEvent
+				require.NoError(t, proto.Unmarshal(request.Messages[i].Data, &event))
+				testutil.RequireEqualProto(t, expectedEvent, &event)
+			}
+
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`{"messageIds": ["1", "2"]}`)),
+			}, nil
+		})
+
+		require.NoError(t, sink.Publish(ctx, []*cacheevents_pb.CacheEvent{event1, event2}))
+	})
+}
@@ -0,0 +1,13 @@
+package eventpublishing
+
+import (
+	"context"
+
+	cacheevents_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/cacheevents"
+)
+
+// Sink of cache events, such as a message bus. Events are provided in
+// batches, so that implementations may submit them efficiently.
+type Sink interface {
+	Publish(ctx context.Context, events []*cacheevents_pb.CacheEvent) error
+}
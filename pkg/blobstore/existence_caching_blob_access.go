@@ -22,6 +22,12 @@ type existenceCachingBlobAccess struct {
 // This decorator may be useful to run on instances that act as
 // frontends for a mirrored/sharding storage pool, as it may reduce the
 // load observed on the storage pool.
+//
+// Only digests that are reported as present are cached. Digests that
+// are reported as missing are always queried on the backend again, as
+// clients are expected to upload them shortly after, causing them to
+// become present. If FindMissing() on the backend fails, nothing is
+// cached.
 func NewExistenceCachingBlobAccess(base BlobAccess, existenceCache *digest.ExistenceCache) BlobAccess {
 	return &existenceCachingBlobAccess{
 		BlobAccess:     base,
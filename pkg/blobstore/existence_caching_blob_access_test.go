@@ -10,8 +10,12 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
 	"github.com/stretchr/testify/require"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"go.uber.org/mock/gomock"
 )
 
@@ -54,4 +58,18 @@ func TestExistenceCachingBlobAccessFindMissing(t *testing.T) {
 	missing, err = blobAccess.FindMissing(ctx, bothDigests)
 	require.NoError(t, err)
 	require.Equal(t, nonExistingDigests, missing)
+
+	// Failures of the backend should not cause any digests to be
+	// cached. After the cache entry has expired, both objects
+	// should be requested until a call succeeds.
+	clock.EXPECT().Now().Return(time.Unix(1200, 0))
+	baseBlobAccess.EXPECT().FindMissing(ctx, bothDigests).Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+	_, err = blobAccess.FindMissing(ctx, bothDigests)
+	testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+
+	clock.EXPECT().Now().Return(time.Unix(1200, 0)).Times(2)
+	baseBlobAccess.EXPECT().FindMissing(ctx, bothDigests).Return(nonExistingDigests, nil)
+	missing, err = blobAccess.FindMissing(ctx, bothDigests)
+	require.NoError(t, err)
+	require.Equal(t, nonExistingDigests, missing)
 }
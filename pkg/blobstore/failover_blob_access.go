@@ -0,0 +1,255 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	failoverBlobAccessPrometheusMetrics sync.Once
+
+	failoverBlobAccessActiveBackend = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "failover_blob_access_active_backend",
+			Help:      "Whether the primary or secondary backend is currently used to serve reads, having value 1 for the active backend.",
+		},
+		[]string{"storage_type", "backend"})
+)
+
+type failoverBlobAccess struct {
+	BlobAccess
+	secondary           BlobAccess
+	clock               clock.Clock
+	failureThreshold    int
+	stabilizationWindow time.Duration
+
+	primaryActive   prometheus.Gauge
+	secondaryActive prometheus.Gauge
+
+	lock                sync.Mutex
+	consecutiveFailures int
+	usingSecondary      bool
+	probeInProgress     bool
+	probeAfter          time.Time
+}
+
+// NewFailoverBlobAccess creates a decorator for two BlobAccess
+// instances, where reads (Get, GetFromComposite and FindMissing) are
+// sent to the primary backend for as long as it is healthy. Reads that
+// fail with an infrastructure error or time out are retried against the
+// secondary backend. Once the number of consecutive failures returned
+// by the primary backend reaches a threshold, all reads are sent to the
+// secondary backend.
+//
+// To prevent flapping, reads are only sent to the primary backend
+// again once a stabilization window has elapsed. After that, a single
+// read is sent to the primary backend to probe whether it has
+// recovered. The primary backend is only considered to be recovered if
+// the probe succeeds or fails with NOT_FOUND, in which case all reads
+// are sent to the primary backend once more. Upon any other outcome,
+// the read is retried against the secondary backend and the
+// stabilization window restarts.
+//
+// Writes are always sent to the primary backend.
+func NewFailoverBlobAccess(primary, secondary BlobAccess, clock clock.Clock, failureThreshold int, stabilizationWindow time.Duration, storageType string) BlobAccess {
+	failoverBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(failoverBlobAccessActiveBackend)
+	})
+
+	ba := &failoverBlobAccess{
+		BlobAccess:          primary,
+		secondary:           secondary,
+		clock:               clock,
+		failureThreshold:    failureThreshold,
+		stabilizationWindow: stabilizationWindow,
+
+		primaryActive:   failoverBlobAccessActiveBackend.WithLabelValues(storageType, "Primary"),
+		secondaryActive: failoverBlobAccessActiveBackend.WithLabelValues(storageType, "Secondary"),
+	}
+	ba.updateActiveBackendMetrics()
+	return ba
+}
+
+func (ba *failoverBlobAccess) updateActiveBackendMetrics() {
+	if ba.usingSecondary {
+		ba.primaryActive.Set(0)
+		ba.secondaryActive.Set(1)
+	} else {
+		ba.primaryActive.Set(1)
+		ba.secondaryActive.Set(0)
+	}
+}
+
+// shouldSendToPrimary returns whether a read should be sent to the
+// primary backend. If the secondary backend is active, it also returns
+// whether the read acts as a probe of the primary backend.
+func (ba *failoverBlobAccess) shouldSendToPrimary() (sendToPrimary, isProbe bool) {
+	now := ba.clock.Now()
+
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	if !ba.usingSecondary {
+		return true, false
+	}
+	if ba.probeInProgress || now.Before(ba.probeAfter) {
+		return false, false
+	}
+	ba.probeInProgress = true
+	return true, true
+}
+
+// isFailoverError returns whether an error returned by the primary
+// backend indicates that it is unhealthy. Timeouts are included, as an
+// overloaded or partitioned backend tends to cause requests to hang
+// instead of failing.
+func isFailoverError(err error) bool {
+	return util.IsInfrastructureError(err) || status.Code(err) == codes.DeadlineExceeded
+}
+
+// recordPrimaryResponse updates the state of the failover logic based
+// on the outcome of a read against the primary backend. It returns
+// whether the read needs to be retried against the secondary backend.
+func (ba *failoverBlobAccess) recordPrimaryResponse(err error, isProbe bool) bool {
+	now := ba.clock.Now()
+
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	if isProbe {
+		ba.probeInProgress = false
+		if code := status.Code(err); code != codes.OK && code != codes.NotFound {
+			// The primary backend has not recovered yet.
+			// Restart the stabilization window.
+			ba.probeAfter = now.Add(ba.stabilizationWindow)
+			return true
+		}
+		// The primary backend has recovered.
+		ba.usingSecondary = false
+		ba.consecutiveFailures = 0
+		ba.updateActiveBackendMetrics()
+		return false
+	}
+
+	gotFailoverError := isFailoverError(err)
+	if !ba.usingSecondary {
+		if gotFailoverError {
+			ba.consecutiveFailures++
+			if ba.consecutiveFailures >= ba.failureThreshold {
+				ba.usingSecondary = true
+				ba.probeAfter = now.Add(ba.stabilizationWindow)
+				ba.updateActiveBackendMetrics()
+			}
+		} else {
+			ba.consecutiveFailures = 0
+		}
+	}
+	return gotFailoverError
+}
+
+func (ba *failoverBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	sendToPrimary, isProbe := ba.shouldSendToPrimary()
+	if !sendToPrimary {
+		return buffer.WithErrorHandler(
+			ba.secondary.Get(ctx, blobDigest),
+			failoverSecondaryErrorHandler{})
+	}
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, blobDigest),
+		&failoverPrimaryErrorHandler{
+			blobAccess: ba,
+			isProbe:    isProbe,
+			getFromSecondary: func() buffer.Buffer {
+				return ba.secondary.Get(ctx, blobDigest)
+			},
+		})
+}
+
+func (ba *failoverBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	sendToPrimary, isProbe := ba.shouldSendToPrimary()
+	if !sendToPrimary {
+		return buffer.WithErrorHandler(
+			ba.secondary.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+			failoverSecondaryErrorHandler{})
+	}
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+		&failoverPrimaryErrorHandler{
+			blobAccess: ba,
+			isProbe:    isProbe,
+			getFromSecondary: func() buffer.Buffer {
+				return ba.secondary.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+			},
+		})
+}
+
+func (ba *failoverBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	if sendToPrimary, isProbe := ba.shouldSendToPrimary(); sendToPrimary {
+		missing, err := ba.BlobAccess.FindMissing(ctx, digests)
+		if !ba.recordPrimaryResponse(err, isProbe) {
+			if err != nil {
+				return digest.EmptySet, util.StatusWrap(err, "Primary")
+			}
+			return missing, nil
+		}
+	}
+
+	missing, err := ba.secondary.FindMissing(ctx, digests)
+	if err != nil {
+		return digest.EmptySet, util.StatusWrap(err, "Secondary")
+	}
+	return missing, nil
+}
+
+// failoverPrimaryErrorHandler is the ErrorHandler that is attached to
+// all buffers read from the primary backend. It retries reads that
+// failed due to the primary backend being unhealthy against the
+// secondary backend.
+type failoverPrimaryErrorHandler struct {
+	blobAccess       *failoverBlobAccess
+	isProbe          bool
+	getFromSecondary func() buffer.Buffer
+}
+
+func (eh *failoverPrimaryErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	ba := eh.blobAccess
+	if ba == nil {
+		// Already retried the operation against the secondary
+		// backend.
+		return nil, util.StatusWrap(err, "Secondary")
+	}
+	eh.blobAccess = nil
+	if ba.recordPrimaryResponse(err, eh.isProbe) {
+		return eh.getFromSecondary(), nil
+	}
+	return nil, util.StatusWrap(err, "Primary")
+}
+
+func (eh *failoverPrimaryErrorHandler) Done() {
+	if ba := eh.blobAccess; ba != nil {
+		ba.recordPrimaryResponse(nil, eh.isProbe)
+	}
+}
+
+// failoverSecondaryErrorHandler is the ErrorHandler that is attached
+// to all buffers read from the secondary backend while it is active.
+type failoverSecondaryErrorHandler struct{}
+
+func (failoverSecondaryErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	return nil, util.StatusWrap(err, "Secondary")
+}
+
+func (failoverSecondaryErrorHandler) Done() {}
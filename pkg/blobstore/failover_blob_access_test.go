@@ -0,0 +1,206 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+// getFailoverBlobAccessActiveBackend returns the name of the backend
+// that is reported as being active for a given storage type.
+func getFailoverBlobAccessActiveBackend(t *testing.T, storageType string) string {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	activeBackend := ""
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "buildbarn_blobstore_failover_blob_access_active_backend" {
+			continue
+		}
+		for _, metric := range metricFamily.Metric {
+			labelPairs := map[string]string{}
+			for _, labelPair := range metric.Label {
+				labelPairs[labelPair.GetName()] = labelPair.GetValue()
+			}
+			if labelPairs["storage_type"] == storageType && metric.Gauge.GetValue() == 1 {
+				require.Empty(t, activeBackend)
+				activeBackend = labelPairs["backend"]
+			}
+		}
+	}
+	return activeBackend
+}
+
+func TestFailoverBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	primary := mock.NewMockBlobAccess(ctrl)
+	secondary := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewFailoverBlobAccess(primary, secondary, clock, 2, time.Minute, "failover_test")
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	requireGetSucceeds := func(t *testing.T) {
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	}
+
+	require.Equal(t, "Primary", getFailoverBlobAccessActiveBackend(t, "failover_test"))
+
+	t.Run("PrimaryNotFound", func(t *testing.T) {
+		// Errors that are not infrastructure errors should be
+		// returned, without consulting the secondary backend.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(2)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Primary: Object not found"), err)
+	})
+
+	t.Run("FailoverOnPrimaryErrors", func(t *testing.T) {
+		// Reads that fail against the primary backend should be
+		// retried against the secondary backend. Once the number
+		// of consecutive failures reaches the threshold, the
+		// secondary backend should become active.
+		clock.EXPECT().Now().Return(time.Unix(1010, 0)).Times(4)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline"))).Times(2)
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))).Times(2)
+
+		requireGetSucceeds(t)
+		require.Equal(t, "Primary", getFailoverBlobAccessActiveBackend(t, "failover_test"))
+		requireGetSucceeds(t)
+		require.Equal(t, "Secondary", getFailoverBlobAccessActiveBackend(t, "failover_test"))
+	})
+
+	t.Run("SecondaryDuringStabilizationWindow", func(t *testing.T) {
+		// While the stabilization window has not elapsed, reads
+		// should go to the secondary backend directly.
+		clock.EXPECT().Now().Return(time.Unix(1069, 0)).Times(2)
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		secondary.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+
+		requireGetSucceeds(t)
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+		require.Equal(t, "Secondary", getFailoverBlobAccessActiveBackend(t, "failover_test"))
+	})
+
+	t.Run("ProbeFailure", func(t *testing.T) {
+		// Once the stabilization window has elapsed, a single
+		// request should be sent to the primary backend. If it
+		// still fails, the stabilization window should restart.
+		clock.EXPECT().Now().Return(time.Unix(1070, 0)).Times(2)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		requireGetSucceeds(t)
+
+		clock.EXPECT().Now().Return(time.Unix(1129, 0))
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		requireGetSucceeds(t)
+		require.Equal(t, "Secondary", getFailoverBlobAccessActiveBackend(t, "failover_test"))
+	})
+
+	t.Run("SwitchBackAfterRecovery", func(t *testing.T) {
+		// Once the primary backend has recovered, all reads
+		// should go to it again.
+		clock.EXPECT().Now().Return(time.Unix(1130, 0)).Times(2)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		requireGetSucceeds(t)
+		require.Equal(t, "Primary", getFailoverBlobAccessActiveBackend(t, "failover_test"))
+
+		clock.EXPECT().Now().Return(time.Unix(1131, 0)).Times(2)
+		primary.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, blobDigest.ToSingletonSet(), missing)
+	})
+
+	t.Run("SecondaryFailure", func(t *testing.T) {
+		// Errors returned by the secondary backend should be
+		// propagated.
+		clock.EXPECT().Now().Return(time.Unix(1140, 0)).Times(2)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Secondary: Server offline"), err)
+		require.Equal(t, "Primary", getFailoverBlobAccessActiveBackend(t, "failover_test"))
+	})
+}
+
+func TestFailoverBlobAccessProbe(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	primary := mock.NewMockBlobAccess(ctrl)
+	secondary := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewFailoverBlobAccess(primary, secondary, clock, 1, time.Minute, "failover_probe_test")
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	requireGetSucceeds := func(t *testing.T) {
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	}
+
+	t.Run("FailoverOnDeadlineExceeded", func(t *testing.T) {
+		// Timeouts should be treated as the primary backend
+		// being unhealthy.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0)).Times(2)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.DeadlineExceeded, "Request timed out")))
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		requireGetSucceeds(t)
+		require.Equal(t, "Secondary", getFailoverBlobAccessActiveBackend(t, "failover_probe_test"))
+	})
+
+	t.Run("ProbeDeadlineExceeded", func(t *testing.T) {
+		// A probe that times out should not cause the primary
+		// backend to be considered recovered. The read should
+		// be retried against the secondary backend, and the
+		// stabilization window should restart.
+		clock.EXPECT().Now().Return(time.Unix(1060, 0)).Times(2)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.DeadlineExceeded, "Request timed out")))
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		requireGetSucceeds(t)
+		require.Equal(t, "Secondary", getFailoverBlobAccessActiveBackend(t, "failover_probe_test"))
+
+		clock.EXPECT().Now().Return(time.Unix(1119, 0))
+		secondary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		requireGetSucceeds(t)
+		require.Equal(t, "Secondary", getFailoverBlobAccessActiveBackend(t, "failover_probe_test"))
+	})
+
+	t.Run("ProbeNotFound", func(t *testing.T) {
+		// A probe for an object that does not exist proves that
+		// the primary backend is able to process requests.
+		clock.EXPECT().Now().Return(time.Unix(1120, 0)).Times(2)
+		primary.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Primary: Object not found"), err)
+		require.Equal(t, "Primary", getFailoverBlobAccessActiveBackend(t, "failover_probe_test"))
+	})
+}
@@ -0,0 +1,102 @@
+package blobstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// FaultInjectionPolicy describes which faults FaultInjectingBlobAccess
+// injects into a single type of operation.
+type FaultInjectionPolicy struct {
+	// Probability in range [0.0, 1.0] at which operations fail.
+	ErrorProbability float64
+	// The error that is returned by operations that fail.
+	Error error
+	// Range of latency that is added to every operation. The amount
+	// of latency is uniformly distributed within the range.
+	MinimumLatency time.Duration
+	MaximumLatency time.Duration
+}
+
+type faultInjectingBlobAccess struct {
+	BlobAccess
+	clock             clock.Clock
+	randomGenerator   random.ThreadSafeGenerator
+	getPolicy         FaultInjectionPolicy
+	putPolicy         FaultInjectionPolicy
+	findMissingPolicy FaultInjectionPolicy
+}
+
+// NewFaultInjectingBlobAccess creates a decorator for BlobAccess that
+// randomly adds latency to operations and lets them fail. This can be
+// used to validate that clients deal with slow and unreliable storage
+// properly, for example by retrying failed operations.
+//
+// The policy for Get() also applies to GetFromComposite(). Calls to
+// GetCapabilities() are forwarded without injecting any faults.
+func NewFaultInjectingBlobAccess(base BlobAccess, clock clock.Clock, randomGenerator random.ThreadSafeGenerator, getPolicy, putPolicy, findMissingPolicy FaultInjectionPolicy) BlobAccess {
+	return &faultInjectingBlobAccess{
+		BlobAccess:        base,
+		clock:             clock,
+		randomGenerator:   randomGenerator,
+		getPolicy:         getPolicy,
+		putPolicy:         putPolicy,
+		findMissingPolicy: findMissingPolicy,
+	}
+}
+
+func (ba *faultInjectingBlobAccess) injectFault(ctx context.Context, policy *FaultInjectionPolicy) error {
+	if policy.MaximumLatency > 0 {
+		latency := policy.MinimumLatency
+		if policy.MaximumLatency > policy.MinimumLatency {
+			latency += random.Duration(ba.randomGenerator, policy.MaximumLatency-policy.MinimumLatency)
+		}
+		timer, timerChannel := ba.clock.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return util.StatusFromContext(ctx)
+		case <-timerChannel:
+		}
+	}
+	if policy.ErrorProbability > 0 && ba.randomGenerator.Float64() < policy.ErrorProbability {
+		return policy.Error
+	}
+	return nil
+}
+
+func (ba *faultInjectingBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
+	if err := ba.injectFault(ctx, &ba.getPolicy); err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return ba.BlobAccess.Get(ctx, digest)
+}
+
+func (ba *faultInjectingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	if err := ba.injectFault(ctx, &ba.getPolicy); err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (ba *faultInjectingBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	if err := ba.injectFault(ctx, &ba.putPolicy); err != nil {
+		b.Discard()
+		return err
+	}
+	return ba.BlobAccess.Put(ctx, digest, b)
+}
+
+func (ba *faultInjectingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	if err := ba.injectFault(ctx, &ba.findMissingPolicy); err != nil {
+		return digest.EmptySet, err
+	}
+	return ba.BlobAccess.FindMissing(ctx, digests)
+}
@@ -0,0 +1,129 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestFaultInjectingBlobAccessErrorRate(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Let a quarter of all FindMissing() calls fail. Use an actual
+	// random number generator to check that the observed error rate
+	// approximates the configured probability.
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	baseBlobAccess.EXPECT().FindMissing(ctx, gomock.Any()).Return(digest.EmptySet, nil).AnyTimes()
+	blobAccess := blobstore.NewFaultInjectingBlobAccess(
+		baseBlobAccess,
+		mock.NewMockClock(ctrl),
+		random.FastThreadSafeGenerator,
+		blobstore.FaultInjectionPolicy{},
+		blobstore.FaultInjectionPolicy{},
+		blobstore.FaultInjectionPolicy{
+			ErrorProbability: 0.25,
+			Error:            status.Error(codes.Unavailable, "Injected fault"),
+		})
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	failures := 0
+	for i := 0; i < 10000; i++ {
+		if _, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet()); err != nil {
+			testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Injected fault"), err)
+			failures++
+		}
+	}
+	require.InDelta(t, 2500, failures, 300)
+}
+
+func TestFaultInjectingBlobAccessLatency(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	randomGenerator := mock.NewMockThreadSafeGenerator(ctrl)
+	blobAccess := blobstore.NewFaultInjectingBlobAccess(
+		baseBlobAccess,
+		clock,
+		randomGenerator,
+		blobstore.FaultInjectionPolicy{
+			MinimumLatency: time.Second,
+			MaximumLatency: 3 * time.Second,
+		},
+		blobstore.FaultInjectionPolicy{
+			ErrorProbability: 0.5,
+			Error:            status.Error(codes.Internal, "Injected fault"),
+			MinimumLatency:   time.Second,
+			MaximumLatency:   time.Second,
+		},
+		blobstore.FaultInjectionPolicy{})
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("GetDelayed", func(t *testing.T) {
+		// The amount of latency should be picked uniformly
+		// between the minimum and maximum.
+		randomGenerator.EXPECT().Int64N(int64(2 * time.Second)).Return(int64(500 * time.Millisecond))
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1000, 0)
+		clock.EXPECT().NewTimer(1500*time.Millisecond).Return(mock.NewMockTimer(ctrl), timerChannel)
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetCanceled", func(t *testing.T) {
+		// Cancelation should interrupt the added latency.
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		randomGenerator.EXPECT().Int64N(int64(2 * time.Second)).Return(int64(0))
+		timer := mock.NewMockTimer(ctrl)
+		clock.EXPECT().NewTimer(time.Second).Return(timer, nil)
+		timer.EXPECT().Stop().Return(true)
+
+		_, err := blobAccess.Get(canceledCtx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "context canceled"), err)
+	})
+
+	t.Run("PutDelayedAndFailed", func(t *testing.T) {
+		// Faults should be injected after latency is added.
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1000, 0)
+		clock.EXPECT().NewTimer(time.Second).Return(mock.NewMockTimer(ctrl), timerChannel)
+		randomGenerator.EXPECT().Float64().Return(0.25)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Injected fault"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("PutDelayedAndSucceeded", func(t *testing.T) {
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1000, 0)
+		clock.EXPECT().NewTimer(time.Second).Return(mock.NewMockTimer(ctrl), timerChannel)
+		randomGenerator.EXPECT().Float64().Return(0.75)
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
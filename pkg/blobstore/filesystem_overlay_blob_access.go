@@ -0,0 +1,148 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type filesystemOverlayBlobAccess struct {
+	BlobAccess
+	directory   filesystem.Directory
+	errorLogger util.ErrorLogger
+}
+
+// NewFilesystemOverlayBlobAccess creates a decorator for the Content
+// Addressable Storage (CAS) that serves objects from a directory on a
+// file system, before falling back to a backend. This can be used to
+// serve a set of prebuilt objects that are stored on a read-only
+// mount (e.g., an NFS export).
+//
+// Files in the directory must be named after the hexadecimal hash of
+// the object they contain. Files are validated against the requested
+// digest in their entirety before being served. Files that are absent
+// or fail validation cause the request to be forwarded to the backend.
+// Validation failures are reported through the provided ErrorLogger.
+//
+// Calls to Put() are always forwarded to the backend. Calls to
+// FindMissing() only report objects as missing if they are absent from
+// both the backend and the directory.
+//
+// Validation results are not cached, as the directory may be modified
+// at any point in time. This makes this decorator expensive to use with
+// large objects. Every call to Get() that is served from the directory
+// reads the file twice: once to validate it, and once more while the
+// object is returned to the caller. Every call to FindMissing() reads
+// and hashes all files of objects that the backend reports as missing.
+// It is therefore advisable to place this decorator in front of a
+// backend that already contains most objects stored in the directory,
+// or to only store small objects in the directory.
+func NewFilesystemOverlayBlobAccess(base BlobAccess, directory filesystem.Directory, errorLogger util.ErrorLogger) BlobAccess {
+	return &filesystemOverlayBlobAccess{
+		BlobAccess:  base,
+		directory:   directory,
+		errorLogger: errorLogger,
+	}
+}
+
+// openValidated opens the file in the overlay directory corresponding
+// to a digest, and checks whether its contents match the digest.
+func (ba *filesystemOverlayBlobAccess) openValidated(blobDigest digest.Digest) (filesystem.FileReader, error) {
+	f, err := ba.directory.OpenRead(path.MustNewComponent(blobDigest.GetHashString()))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, status.Error(codes.NotFound, "Object not found")
+		}
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to open file")
+	}
+
+	// Read one byte past the expected size, so that files that are
+	// too large are detected.
+	sizeBytes := blobDigest.GetSizeBytes()
+	hasher := blobDigest.NewHasher(sizeBytes)
+	n, err := io.Copy(hasher, io.NewSectionReader(f, 0, sizeBytes+1))
+	if err != nil {
+		f.Close()
+		return nil, util.StatusWrapWithCode(err, codes.Internal, "Failed to read file")
+	}
+	if n != sizeBytes {
+		f.Close()
+		return nil, status.Errorf(codes.Internal, "File is %d bytes in size, while %d bytes were expected", n, sizeBytes)
+	}
+	if actualChecksum := hasher.Sum(nil); !bytes.Equal(actualChecksum, blobDigest.GetHashBytes()) {
+		f.Close()
+		return nil, status.Errorf(codes.Internal, "File has checksum %x, while %s was expected", actualChecksum, blobDigest.GetHashString())
+	}
+	return f, nil
+}
+
+// openFromOverlay opens the file in the overlay directory
+// corresponding to a digest. False is returned if the file is absent
+// or fails validation, in which case the object should be obtained
+// from the backend instead.
+func (ba *filesystemOverlayBlobAccess) openFromOverlay(blobDigest digest.Digest) (filesystem.FileReader, bool) {
+	f, err := ba.openValidated(blobDigest)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			ba.errorLogger.Log(util.StatusWrapf(err, "Overlay file for blob %#v", blobDigest.String()))
+		}
+		return nil, false
+	}
+	return f, true
+}
+
+// getFromOverlay returns a buffer for an object stored in the overlay
+// directory. False is returned if the object should be obtained from
+// the backend instead.
+func (ba *filesystemOverlayBlobAccess) getFromOverlay(blobDigest digest.Digest) (buffer.Buffer, bool) {
+	f, ok := ba.openFromOverlay(blobDigest)
+	if !ok {
+		return nil, false
+	}
+	return buffer.NewValidatedBufferFromReaderAt(f, blobDigest.GetSizeBytes()), true
+}
+
+func (ba *filesystemOverlayBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if b, ok := ba.getFromOverlay(blobDigest); ok {
+		return b
+	}
+	return ba.BlobAccess.Get(ctx, blobDigest)
+}
+
+func (ba *filesystemOverlayBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	if b, ok := ba.getFromOverlay(parentDigest); ok {
+		bChild, _ := slicer.Slice(b, childDigest)
+		return bChild
+	}
+	return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (ba *filesystemOverlayBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	missingInBackend, err := ba.BlobAccess.FindMissing(ctx, digests)
+	if err != nil {
+		return digest.EmptySet, err
+	}
+
+	// Only report objects as missing if they are also absent from
+	// the overlay directory.
+	missing := digest.NewSetBuilder()
+	for _, blobDigest := range missingInBackend.Items() {
+		if f, ok := ba.openFromOverlay(blobDigest); ok {
+			f.Close()
+		} else {
+			missing.Add(blobDigest)
+		}
+	}
+	return missing.Build(), nil
+}
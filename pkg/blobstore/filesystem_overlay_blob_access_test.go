@@ -0,0 +1,131 @@
+package blobstore_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/filesystem"
+	"github.com/buildbarn/bb-storage/pkg/filesystem/path"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestFilesystemOverlayBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	directoryPath := t.TempDir()
+	directory, err := filesystem.NewLocalDirectory(path.LocalFormat.NewParser(directoryPath))
+	require.NoError(t, err)
+	defer directory.Close()
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	blobAccess := blobstore.NewFilesystemOverlayBlobAccess(baseBlobAccess, directory, errorLogger)
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	writeOverlayFile := func(data string) {
+		require.NoError(t, os.WriteFile(filepath.Join(directoryPath, "8b1a9953c4611296a827abf8c47804d7"), []byte(data), 0o644))
+	}
+
+	t.Run("OverlayHit", func(t *testing.T) {
+		// Objects present in the overlay directory should be
+		// served without contacting the backend.
+		writeOverlayFile("Hello")
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("OverlayMiss", func(t *testing.T) {
+		// Objects absent from the overlay directory should be
+		// requested from the backend.
+		require.NoError(t, os.Remove(filepath.Join(directoryPath, "8b1a9953c4611296a827abf8c47804d7")))
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("OverlayChecksumMismatch", func(t *testing.T) {
+		// Files whose contents don't match the digest should
+		// not be served. The backend should be consulted instead.
+		writeOverlayFile("Jello")
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Internal, "Overlay file for blob \"3-8b1a9953c4611296a827abf8c47804d7-5-hello\": File has checksum bedad9eef4de4b391cc5aeb8ddbe6387, while 8b1a9953c4611296a827abf8c47804d7 was expected")))
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("OverlaySizeMismatch", func(t *testing.T) {
+		writeOverlayFile("Hello, world")
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Internal, "Overlay file for blob \"3-8b1a9953c4611296a827abf8c47804d7-5-hello\": File is 6 bytes in size, while 5 bytes were expected")))
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		// Objects should only be reported as missing if they
+		// are absent from both the backend and the overlay
+		// directory.
+		writeOverlayFile("Hello")
+		otherDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+		presentDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "3e25960a79dbc69b674cd4ec67a72c62", 11)
+		allDigests := digest.NewSetBuilder().Add(blobDigest).Add(otherDigest).Add(presentDigest).Build()
+		baseBlobAccess.EXPECT().FindMissing(ctx, allDigests).
+			Return(digest.NewSetBuilder().Add(blobDigest).Add(otherDigest).Build(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, otherDigest.ToSingletonSet(), missing)
+	})
+
+	t.Run("FindMissingOverlayInvalid", func(t *testing.T) {
+		// Files that fail validation should not cause objects
+		// to be reported as present.
+		writeOverlayFile("Jello")
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Internal, "Overlay file for blob \"3-8b1a9953c4611296a827abf8c47804d7-5-hello\": File has checksum bedad9eef4de4b391cc5aeb8ddbe6387, while 8b1a9953c4611296a827abf8c47804d7 was expected")))
+		baseBlobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, blobDigest.ToSingletonSet(), missing)
+	})
+
+	t.Run("FindMissingBackendFailure", func(t *testing.T) {
+		baseBlobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		// Writes should always go to the backend.
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
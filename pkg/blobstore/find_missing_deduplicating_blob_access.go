@@ -0,0 +1,67 @@
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type findMissingDeduplicatingKey [sha256.Size]byte
+
+type findMissingDeduplicatingBlobAccess struct {
+	BlobAccess
+	calls callDeduplicator[findMissingDeduplicatingKey, digest.Set]
+}
+
+// NewFindMissingDeduplicatingBlobAccess creates a decorator for
+// BlobAccess that merges concurrent FindMissing() requests for
+// identical sets of digests. Only a single call is made against the
+// backend, whose results are returned to all callers. Requests are
+// only merged while a call against the backend is in flight. Results
+// are not cached afterwards.
+//
+// As digests contain the instance name, requests are only merged if
+// they refer to the same instance name.
+//
+// If the call against the backend fails, callers that were waiting for
+// it to complete retry the call themselves. This ensures that the
+// cancelation of one client's request does not cause requests of other
+// clients to fail.
+func NewFindMissingDeduplicatingBlobAccess(base BlobAccess) BlobAccess {
+	return &findMissingDeduplicatingBlobAccess{
+		BlobAccess: base,
+		calls:      newCallDeduplicator[findMissingDeduplicatingKey, digest.Set](),
+	}
+}
+
+func getFindMissingDeduplicatingKey(digests digest.Set) findMissingDeduplicatingKey {
+	// Digests in a set are sorted, meaning that identical sets
+	// always yield the same key.
+	hasher := sha256.New()
+	for _, blobDigest := range digests.Items() {
+		hasher.Write([]byte(blobDigest.String()))
+		hasher.Write([]byte{0})
+	}
+	var key findMissingDeduplicatingKey
+	hasher.Sum(key[:0])
+	return key
+}
+
+func (ba *findMissingDeduplicatingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	if digests.Empty() {
+		return digest.EmptySet, nil
+	}
+
+	missing, _, err := ba.calls.call(
+		ctx,
+		getFindMissingDeduplicatingKey(digests),
+		alwaysRetry,
+		func() (digest.Set, error) {
+			return ba.BlobAccess.FindMissing(ctx, digests)
+		})
+	if err != nil {
+		return digest.EmptySet, err
+	}
+	return missing, nil
+}
@@ -0,0 +1,104 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+type findMissingResult struct {
+	missing digest.Set
+	err     error
+}
+
+func TestFindMissingDeduplicatingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewFindMissingDeduplicatingBlobAccess(baseBlobAccess)
+
+	digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+	digests := digest.NewSetBuilder().Add(digest1).Add(digest2).Build()
+
+	findMissing := func(ctx context.Context) findMissingResult {
+		missing, err := blobAccess.FindMissing(ctx, digests)
+		return findMissingResult{missing: missing, err: err}
+	}
+	expectBlockingFindMissing := func(missing digest.Set, err error) blockingCall {
+		call := newBlockingCall()
+		baseBlobAccess.EXPECT().FindMissing(ctx, digests).DoAndReturn(
+			func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+				call.block()
+				return missing, err
+			})
+		return call
+	}
+
+	t.Run("IdenticalRequestsCoalesced", func(t *testing.T) {
+		// Two identical concurrent requests should only result
+		// in a single call against the backend, whose results
+		// are returned to both callers.
+		call := expectBlockingFindMissing(digest2.ToSingletonSet(), nil)
+		results1 := callAsync(func() findMissingResult { return findMissing(ctx) })
+		<-call.started
+
+		results2 := attachAsync(newWaitingContext(ctx), findMissing)
+		close(call.release)
+
+		for _, results := range []<-chan findMissingResult{results1, results2} {
+			result := <-results
+			require.NoError(t, result.err)
+			require.Equal(t, digest2.ToSingletonSet(), result.missing)
+		}
+	})
+
+	t.Run("DistinctRequestsNotCoalesced", func(t *testing.T) {
+		// Requests for different sets of digests should each be
+		// forwarded to the backend, even if they overlap.
+		call := expectBlockingFindMissing(digest2.ToSingletonSet(), nil)
+		results1 := callAsync(func() findMissingResult { return findMissing(ctx) })
+		<-call.started
+
+		baseBlobAccess.EXPECT().FindMissing(ctx, digest1.ToSingletonSet()).Return(digest1.ToSingletonSet(), nil)
+		missing, err := blobAccess.FindMissing(ctx, digest1.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest1.ToSingletonSet(), missing)
+
+		close(call.release)
+		result := <-results1
+		require.NoError(t, result.err)
+		require.Equal(t, digest2.ToSingletonSet(), result.missing)
+	})
+
+	t.Run("FailureRetried", func(t *testing.T) {
+		// If the call against the backend fails, callers that
+		// were waiting for it should not observe the error, but
+		// retry the call.
+		call := expectBlockingFindMissing(digest.EmptySet, status.Error(codes.Canceled, "Request canceled"))
+		results1 := callAsync(func() findMissingResult { return findMissing(ctx) })
+		<-call.started
+
+		ctx2 := newWaitingContext(ctx)
+		baseBlobAccess.EXPECT().FindMissing(ctx2, digests).Return(digest1.ToSingletonSet(), nil)
+		results2 := attachAsync(ctx2, findMissing)
+		close(call.release)
+
+		result := <-results1
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "Request canceled"), result.err)
+		result = <-results2
+		require.NoError(t, result.err)
+		require.Equal(t, digest1.ToSingletonSet(), result.missing)
+	})
+}
@@ -0,0 +1,106 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/random"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	findMissingShadowingBlobAccessPrometheusMetrics sync.Once
+
+	findMissingShadowingBlobAccessQueries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "find_missing_shadowing_blob_access_queries_total",
+			Help:      "Number of sampled FindMissing() calls that FindMissingShadowingBlobAccess attempted to mirror to the shadow backend",
+		},
+		[]string{"storage_type", "outcome"})
+	findMissingShadowingBlobAccessDigests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "find_missing_shadowing_blob_access_digests_total",
+			Help:      "Number of digests checked for existence against the shadow backend, and whether the shadow backend reported them as present",
+		},
+		[]string{"storage_type", "outcome"})
+)
+
+type findMissingShadowingBlobAccess struct {
+	BlobAccess
+	shadow              BlobAccess
+	randomGenerator     random.ThreadSafeGenerator
+	samplingProbability float64
+	slots               chan struct{}
+
+	queriesDropped   prometheus.Counter
+	queriesFailed    prometheus.Counter
+	queriesSucceeded prometheus.Counter
+	digestsPresent   prometheus.Counter
+	digestsMissing   prometheus.Counter
+}
+
+// NewFindMissingShadowingBlobAccess creates a decorator for BlobAccess
+// that serves all requests from a base backend. For a randomly sampled
+// fraction of FindMissing() calls, the same call is also performed
+// against a shadow backend in the background. The number of digests
+// that the shadow backend reports as present is exposed through
+// Prometheus metrics. This can be used to estimate the hit rate a new
+// backend would achieve under real traffic, without serving any data
+// from it.
+//
+// Results of the shadow backend are never returned to the caller. At
+// most maximumConcurrentQueries calls against the shadow backend are
+// performed concurrently. Sampled calls beyond that are dropped. As
+// calls against the shadow backend are not canceled when the original
+// call completes, it is advised to place a TimeoutBlobAccess in front
+// of the shadow backend.
+func NewFindMissingShadowingBlobAccess(base, shadow BlobAccess, randomGenerator random.ThreadSafeGenerator, samplingProbability float64, maximumConcurrentQueries int, storageType string) BlobAccess {
+	findMissingShadowingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(findMissingShadowingBlobAccessQueries)
+		prometheus.MustRegister(findMissingShadowingBlobAccessDigests)
+	})
+
+	return &findMissingShadowingBlobAccess{
+		BlobAccess:          base,
+		shadow:              shadow,
+		randomGenerator:     randomGenerator,
+		samplingProbability: samplingProbability,
+		slots:               make(chan struct{}, maximumConcurrentQueries),
+
+		queriesDropped:   findMissingShadowingBlobAccessQueries.WithLabelValues(storageType, "Dropped"),
+		queriesFailed:    findMissingShadowingBlobAccessQueries.WithLabelValues(storageType, "Failed"),
+		queriesSucceeded: findMissingShadowingBlobAccessQueries.WithLabelValues(storageType, "Succeeded"),
+		digestsPresent:   findMissingShadowingBlobAccessDigests.WithLabelValues(storageType, "Present"),
+		digestsMissing:   findMissingShadowingBlobAccessDigests.WithLabelValues(storageType, "Missing"),
+	}
+}
+
+func (ba *findMissingShadowingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	if !digests.Empty() && ba.randomGenerator.Float64() < ba.samplingProbability {
+		select {
+		case ba.slots <- struct{}{}:
+			ctxWithoutCancel := context.WithoutCancel(ctx)
+			go func() {
+				defer func() { <-ba.slots }()
+
+				missing, err := ba.shadow.FindMissing(ctxWithoutCancel, digests)
+				if err != nil {
+					ba.queriesFailed.Inc()
+					return
+				}
+				present, missing, _ := digest.GetDifferenceAndIntersection(digests, missing)
+				ba.digestsPresent.Add(float64(present.Length()))
+				ba.digestsMissing.Add(float64(missing.Length()))
+				ba.queriesSucceeded.Inc()
+			}()
+		default:
+			ba.queriesDropped.Inc()
+		}
+	}
+	return ba.BlobAccess.FindMissing(ctx, digests)
+}
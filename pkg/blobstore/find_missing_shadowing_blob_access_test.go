@@ -0,0 +1,159 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+// getFindMissingShadowingBlobAccessCounters returns the values of all
+// counters reported for a given storage type, keyed by metric name and
+// outcome.
+func getFindMissingShadowingBlobAccessCounters(t *testing.T, storageType string) map[string]float64 {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	counters := map[string]float64{}
+	for _, metricFamily := range metricFamilies {
+		var prefix string
+		switch metricFamily.GetName() {
+		case "buildbarn_blobstore_find_missing_shadowing_blob_access_queries_total":
+			prefix = "Queries"
+		case "buildbarn_blobstore_find_missing_shadowing_blob_access_digests_total":
+			prefix = "Digests"
+		default:
+			continue
+		}
+		for _, metric := range metricFamily.Metric {
+			labelPairs := map[string]string{}
+			for _, labelPair := range metric.Label {
+				labelPairs[labelPair.GetName()] = labelPair.GetValue()
+			}
+			if labelPairs["storage_type"] == storageType && metric.Counter.GetValue() > 0 {
+				counters[prefix+labelPairs["outcome"]] = metric.Counter.GetValue()
+			}
+		}
+	}
+	return counters
+}
+
+func TestFindMissingShadowingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+	digest3 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "ad3c8ac9eef32188da352082244b3598", 13)
+	allDigests := digest.NewSetBuilder().Add(digest1).Add(digest2).Add(digest3).Build()
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	shadowBlobAccess := mock.NewMockBlobAccess(ctrl)
+	randomGenerator := mock.NewMockThreadSafeGenerator(ctrl)
+	blobAccess := blobstore.NewFindMissingShadowingBlobAccess(baseBlobAccess, shadowBlobAccess, randomGenerator, 0.1, 1, "find_missing_shadowing_test")
+
+	// Counters are global, meaning that they may already have been
+	// incremented if this test is run multiple times. Only report
+	// increases relative to their initial values.
+	initialCounters := getFindMissingShadowingBlobAccessCounters(t, "find_missing_shadowing_test")
+	getCounters := func() map[string]float64 {
+		counters := getFindMissingShadowingBlobAccessCounters(t, "find_missing_shadowing_test")
+		for name, value := range counters {
+			if value == initialCounters[name] {
+				delete(counters, name)
+			} else {
+				counters[name] = value - initialCounters[name]
+			}
+		}
+		return counters
+	}
+
+	t.Run("NotSampled", func(t *testing.T) {
+		// Calls that are not sampled should only be forwarded
+		// to the base backend.
+		randomGenerator.EXPECT().Float64().Return(0.5)
+		baseBlobAccess.EXPECT().FindMissing(ctx, allDigests).Return(digest1.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, digest1.ToSingletonSet(), missing)
+		require.Empty(t, getCounters())
+	})
+
+	t.Run("Sampled", func(t *testing.T) {
+		// Sampled calls should also be forwarded to the shadow
+		// backend. Its results should only be used to update
+		// metrics, even if the call against the shadow backend
+		// is still in progress when the original call
+		// completes.
+		randomGenerator.EXPECT().Float64().Return(0.05)
+		shadowUnblock := make(chan struct{})
+		shadowBlobAccess.EXPECT().FindMissing(gomock.Any(), allDigests).DoAndReturn(
+			func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+				<-shadowUnblock
+				return digest.NewSetBuilder().Add(digest1).Add(digest2).Build(), nil
+			})
+		baseBlobAccess.EXPECT().FindMissing(ctx, allDigests).Return(digest1.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, digest1.ToSingletonSet(), missing)
+
+		// While the call against the shadow backend is in
+		// progress, other sampled calls should be dropped.
+		randomGenerator.EXPECT().Float64().Return(0.05)
+		baseBlobAccess.EXPECT().FindMissing(ctx, digest3.ToSingletonSet()).Return(digest.EmptySet, nil)
+
+		missing, err = blobAccess.FindMissing(ctx, digest3.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+
+		// The shadow backend only reported a single digest as
+		// present, meaning it would have achieved a hit rate of
+		// one third.
+		close(shadowUnblock)
+		require.Eventually(t, func() bool {
+			return getCounters()["QueriesSucceeded"] == 1
+		}, 10*time.Second, time.Millisecond)
+		require.Equal(t, map[string]float64{
+			"QueriesDropped":   1,
+			"QueriesSucceeded": 1,
+			"DigestsPresent":   1,
+			"DigestsMissing":   2,
+		}, getCounters())
+	})
+
+	t.Run("ShadowFailure", func(t *testing.T) {
+		// Failures of the shadow backend should not be
+		// propagated, nor should they be counted as misses.
+		randomGenerator.EXPECT().Float64().Return(0.05)
+		shadowBlobAccess.EXPECT().FindMissing(gomock.Any(), allDigests).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+		baseBlobAccess.EXPECT().FindMissing(ctx, allDigests).Return(digest.EmptySet, nil)
+
+		missing, err := blobAccess.FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+
+		require.Eventually(t, func() bool {
+			return getCounters()["QueriesFailed"] == 1
+		}, 10*time.Second, time.Millisecond)
+		require.Equal(t, map[string]float64{
+			"QueriesDropped":   1,
+			"QueriesFailed":    1,
+			"QueriesSucceeded": 1,
+			"DigestsPresent":   1,
+			"DigestsMissing":   2,
+		}, getCounters())
+	})
+}
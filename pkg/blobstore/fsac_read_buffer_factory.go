@@ -6,16 +6,33 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/proto/fsac"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
 )
 
 type fsacReadBufferFactory struct{}
 
 func (f fsacReadBufferFactory) NewBufferFromByteSlice(digest digest.Digest, data []byte, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	// Entries may have been compressed by ZstdCompressingBlobAccess.
+	if isZstdCompressedEncoding(data) {
+		decompressed, err := unmarshalZstdCompressedEncoding(data)
+		if err != nil {
+			dataIntegrityCallback(false)
+			return buffer.NewBufferFromError(util.StatusWrapWithCode(err, codes.Internal, "Failed to decode file system access profile"))
+		}
+		data = decompressed
+	}
 	return buffer.NewProtoBufferFromByteSlice(&fsac.FileSystemAccessProfile{}, data, buffer.BackendProvided(dataIntegrityCallback))
 }
 
 func (f fsacReadBufferFactory) NewBufferFromReader(digest digest.Digest, r io.ReadCloser, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
-	return buffer.NewProtoBufferFromReader(&fsac.FileSystemAccessProfile{}, r, buffer.BackendProvided(dataIntegrityCallback))
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return f.NewBufferFromByteSlice(digest, data, dataIntegrityCallback)
 }
 
 func (f fsacReadBufferFactory) NewBufferFromReaderAt(digest digest.Digest, r buffer.ReadAtCloser, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
@@ -23,5 +40,7 @@ func (f fsacReadBufferFactory) NewBufferFromReaderAt(digest digest.Digest, r buf
 }
 
 // FSACReadBufferFactory is capable of creating identifiers and buffers
-// for objects stored in the File System Access Cache (FSAC).
+// for objects stored in the File System Access Cache (FSAC). Objects
+// may be stored either using the regular Protobuf encoding, or in
+// compressed form as generated by ZstdCompressingBlobAccess.
 var FSACReadBufferFactory ReadBufferFactory = fsacReadBufferFactory{}
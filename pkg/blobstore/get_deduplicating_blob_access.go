@@ -0,0 +1,75 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type getDeduplicatingBlobAccess struct {
+	BlobAccess
+	maximumSizeBytes int
+	calls            callDeduplicator[string, []byte]
+}
+
+// NewGetDeduplicatingBlobAccess creates a decorator for the Content
+// Addressable Storage (CAS) that merges concurrent Get() requests for
+// the same digest. This reduces the load on the backend when many
+// clients download the same object simultaneously, for example when
+// workers fetch a shared toolchain through the ByteStream API.
+//
+// The first caller reads the object from the backend into memory. Other
+// callers wait for this read to complete, and are served from the same
+// copy of the data. Only objects whose size does not exceed a given
+// limit are merged, thereby bounding the amount of memory used to fan
+// out data to callers. Larger objects are read from the backend
+// directly.
+//
+// If the read is interrupted, because the context of the first caller
+// is canceled or its deadline is exceeded, callers that were waiting
+// for it to complete retry the read themselves. This ensures that the
+// cancelation of one client's request does not cause requests of other
+// clients to fail. Any other error is returned to all callers.
+//
+// This decorator may only be used for the CAS, as it assumes that all
+// reads for a given digest yield identical contents.
+func NewGetDeduplicatingBlobAccess(base BlobAccess, maximumSizeBytes int) BlobAccess {
+	return &getDeduplicatingBlobAccess{
+		BlobAccess:       base,
+		maximumSizeBytes: maximumSizeBytes,
+		calls:            newCallDeduplicator[string, []byte](),
+	}
+}
+
+func (ba *getDeduplicatingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if blobDigest.GetSizeBytes() > int64(ba.maximumSizeBytes) {
+		return ba.BlobAccess.Get(ctx, blobDigest)
+	}
+
+	data, _, err := ba.calls.call(
+		ctx,
+		blobDigest.GetKey(digest.KeyWithInstance),
+		isInterruptionError,
+		func() ([]byte, error) {
+			// Buffers returned by the backend validate the
+			// data while being read, meaning that only
+			// valid data is shared with other callers.
+			return ba.BlobAccess.Get(ctx, blobDigest).ToByteSlice(ba.maximumSizeBytes)
+		})
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return buffer.NewValidatedBufferFromByteSlice(data)
+}
+
+// isInterruptionError returns whether a read got interrupted, because
+// the context of the caller was canceled or its deadline was exceeded.
+// Other callers should retry such reads, instead of sharing the error.
+func isInterruptionError(err error) bool {
+	code := status.Code(err)
+	return code == codes.Canceled || code == codes.DeadlineExceeded
+}
@@ -0,0 +1,128 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+type getResult struct {
+	data []byte
+	err  error
+}
+
+func TestGetDeduplicatingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewGetDeduplicatingBlobAccess(baseBlobAccess, 10)
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	get := func(ctx context.Context) getResult {
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		return getResult{data: data, err: err}
+	}
+	expectBlockingGet := func(b buffer.Buffer) blockingCall {
+		call := newBlockingCall()
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+				call.block()
+				return b
+			})
+		return call
+	}
+
+	t.Run("ConcurrentReadsCoalesced", func(t *testing.T) {
+		// Many concurrent reads of the same object should only
+		// result in a single call against the backend. All
+		// callers should receive the full contents.
+		call := expectBlockingGet(buffer.NewCASBufferFromByteSlice(blobDigest, []byte("Hello"), buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+		results := []<-chan getResult{callAsync(func() getResult { return get(ctx) })}
+		<-call.started
+
+		for i := 0; i < 100; i++ {
+			results = append(results, attachAsync(newWaitingContext(ctx), get))
+		}
+		close(call.release)
+
+		for _, result := range results {
+			r := <-result
+			require.NoError(t, r.err)
+			require.Equal(t, []byte("Hello"), r.data)
+		}
+	})
+
+	t.Run("ErrorShared", func(t *testing.T) {
+		// Errors returned by the backend should be returned to
+		// all callers, without retrying.
+		call := expectBlockingGet(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		results1 := callAsync(func() getResult { return get(ctx) })
+		<-call.started
+
+		results2 := attachAsync(newWaitingContext(ctx), get)
+		close(call.release)
+
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), (<-results1).err)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), (<-results2).err)
+	})
+
+	t.Run("CorruptionShared", func(t *testing.T) {
+		// Data that does not match the digest should not be
+		// handed out to any of the callers.
+		call := expectBlockingGet(buffer.NewCASBufferFromByteSlice(blobDigest, []byte("Jello"), buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+		results1 := callAsync(func() getResult { return get(ctx) })
+		<-call.started
+
+		results2 := attachAsync(newWaitingContext(ctx), get)
+		close(call.release)
+
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Buffer has checksum bedad9eef4de4b391cc5aeb8ddbe6387, while 8b1a9953c4611296a827abf8c47804d7 was expected"), (<-results1).err)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Buffer has checksum bedad9eef4de4b391cc5aeb8ddbe6387, while 8b1a9953c4611296a827abf8c47804d7 was expected"), (<-results2).err)
+	})
+
+	t.Run("CancelationRetried", func(t *testing.T) {
+		// If the call against the backend is canceled, callers
+		// that were waiting for it should not observe the
+		// error, but retry the call themselves.
+		call := expectBlockingGet(buffer.NewBufferFromError(status.Error(codes.Canceled, "Request canceled")))
+		results1 := callAsync(func() getResult { return get(ctx) })
+		<-call.started
+
+		ctx2 := newWaitingContext(ctx)
+		baseBlobAccess.EXPECT().Get(ctx2, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		results2 := attachAsync(ctx2, get)
+		close(call.release)
+
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "Request canceled"), (<-results1).err)
+		r := <-results2
+		require.NoError(t, r.err)
+		require.Equal(t, []byte("Hello"), r.data)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		// Objects exceeding the maximum size should be read
+		// from the backend directly, as they would otherwise
+		// need to be held in memory.
+		largeDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "3e25960a79dbc69b674cd4ec67a72c62", 11)
+		baseBlobAccess.EXPECT().Get(ctx, largeDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		data, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+}
@@ -11,8 +11,6 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/proto/fsac"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type fsacBlobAccess struct {
@@ -68,7 +66,38 @@ func (ba *fsacBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffe
 }
 
 func (ba *fsacBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
-	return digest.EmptySet, status.Error(codes.Unimplemented, "File System Access Cache does not support bulk existence checking")
+	// Partition all digests by digest function, as the
+	// FindMissingFileSystemAccessProfiles() RPC can only process digests for a
+	// single instance name and digest function.
+	perFunctionDigests := map[digest.Function][]*remoteexecution.Digest{}
+	for _, digest := range digests.Items() {
+		digestFunction := digest.GetDigestFunction()
+		perFunctionDigests[digestFunction] = append(perFunctionDigests[digestFunction], digest.GetProto())
+	}
+
+	missingDigests := digest.NewSetBuilder()
+	for digestFunction, blobDigests := range perFunctionDigests {
+		// Call FindMissingFileSystemAccessProfiles() for each digest function.
+		request := remoteexecution.FindMissingBlobsRequest{
+			InstanceName:   digestFunction.GetInstanceName().String(),
+			BlobDigests:    blobDigests,
+			DigestFunction: digestFunction.GetEnumValue(),
+		}
+		response, err := ba.filesystemAccessCacheClient.FindMissingFileSystemAccessProfiles(ctx, &request)
+		if err != nil {
+			return digest.EmptySet, err
+		}
+
+		// Convert results back.
+		for _, proto := range response.MissingBlobDigests {
+			blobDigest, err := digestFunction.NewDigestFromProto(proto)
+			if err != nil {
+				return digest.EmptySet, err
+			}
+			missingDigests.Add(blobDigest)
+		}
+	}
+	return missingDigests.Build(), nil
 }
 
 func (ba *fsacBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
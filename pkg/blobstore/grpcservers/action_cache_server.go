@@ -8,28 +8,55 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type actionCacheServer struct {
-	blobAccess              blobstore.BlobAccess
-	maximumMessageSizeBytes int
+	blobAccess                            blobstore.BlobAccess
+	maximumMessageSizeResolver            *digest.MaximumMessageSizeResolver
+	maximumOffloadedActionResultSizeBytes int64
+	defaultDigestFunctions                *digest.DefaultFunctionResolver
 }
 
 // NewActionCacheServer creates a GRPC service for serving the contents
-// of a Bazel Action Cache (AC) to Bazel.
-func NewActionCacheServer(blobAccess blobstore.BlobAccess, maximumMessageSizeBytes int) remoteexecution.ActionCacheServer {
+// of a Bazel Action Cache (AC) to Bazel. Requests that don't specify a
+// digest function use the defaults provided by defaultDigestFunctions.
+// ActionResult messages are limited to the maximum message size that
+// maximumMessageSizeResolver provides for the instance name.
+//
+// If the AC is capable of offloading large ActionResult messages into
+// the Content Addressable Storage (CAS), maximumOffloadedActionResultSizeBytes
+// may be used to permit messages up to that size instead. Zero should
+// be provided if offloading is not enabled.
+func NewActionCacheServer(blobAccess blobstore.BlobAccess, maximumMessageSizeResolver *digest.MaximumMessageSizeResolver, maximumOffloadedActionResultSizeBytes int64, defaultDigestFunctions *digest.DefaultFunctionResolver) remoteexecution.ActionCacheServer {
 	return &actionCacheServer{
-		blobAccess:              blobAccess,
-		maximumMessageSizeBytes: maximumMessageSizeBytes,
+		blobAccess:                            blobAccess,
+		maximumMessageSizeResolver:            maximumMessageSizeResolver,
+		maximumOffloadedActionResultSizeBytes: maximumOffloadedActionResultSizeBytes,
+		defaultDigestFunctions:                defaultDigestFunctions,
 	}
 }
 
+// getMaximumActionResultSizeBytes returns the maximum size of
+// ActionResult messages that may be exchanged for a given instance
+// name.
+func (s *actionCacheServer) getMaximumActionResultSizeBytes(instanceName digest.InstanceName) int {
+	maximumSizeBytes := s.maximumMessageSizeResolver.GetMaximumMessageSizeBytes(instanceName)
+	if maximumSizeBytes < s.maximumOffloadedActionResultSizeBytes {
+		maximumSizeBytes = s.maximumOffloadedActionResultSizeBytes
+	}
+	return int(maximumSizeBytes)
+}
+
 func (s *actionCacheServer) GetActionResult(ctx context.Context, in *remoteexecution.GetActionResultRequest) (*remoteexecution.ActionResult, error) {
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
-	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, len(in.ActionDigest.GetHash()))
+	digestFunction, err := s.defaultDigestFunctions.GetDigestFunction(instanceName, in.DigestFunction, len(in.ActionDigest.GetHash()))
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +66,7 @@ func (s *actionCacheServer) GetActionResult(ctx context.Context, in *remoteexecu
 	}
 	actionResult, err := s.blobAccess.Get(ctx, digest).ToProto(
 		&remoteexecution.ActionResult{},
-		s.maximumMessageSizeBytes)
+		s.getMaximumActionResultSizeBytes(instanceName))
 	if err != nil {
 		return nil, err
 	}
@@ -47,11 +74,11 @@ func (s *actionCacheServer) GetActionResult(ctx context.Context, in *remoteexecu
 }
 
 func (s *actionCacheServer) UpdateActionResult(ctx context.Context, in *remoteexecution.UpdateActionResultRequest) (*remoteexecution.ActionResult, error) {
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
-	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, len(in.ActionDigest.GetHash()))
+	digestFunction, err := s.defaultDigestFunctions.GetDigestFunction(instanceName, in.DigestFunction, len(in.ActionDigest.GetHash()))
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +86,9 @@ func (s *actionCacheServer) UpdateActionResult(ctx context.Context, in *remoteex
 	if err != nil {
 		return nil, err
 	}
+	if sizeBytes, maximumSizeBytes := proto.Size(in.ActionResult), s.getMaximumActionResultSizeBytes(instanceName); sizeBytes > maximumSizeBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "Action result is %d bytes in size, while a maximum of %d bytes is permitted", sizeBytes, maximumSizeBytes)
+	}
 	return in.ActionResult, s.blobAccess.Put(
 		ctx,
 		digest,
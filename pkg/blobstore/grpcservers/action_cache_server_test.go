@@ -0,0 +1,231 @@
+package grpcservers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	blobstore_configuration "github.com/buildbarn/bb-storage/pkg/blobstore/configuration"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.uber.org/mock/gomock"
+)
+
+// newInMemoryBlobAccessConfiguration returns the configuration of a
+// small local storage backend that keeps all data in memory.
+func newInMemoryBlobAccessConfiguration() *pb.BlobAccessConfiguration {
+	return &pb.BlobAccessConfiguration{
+		Backend: &pb.BlobAccessConfiguration_Local{
+			Local: &pb.LocalBlobAccessConfiguration{
+				KeyLocationMapBackend: &pb.LocalBlobAccessConfiguration_KeyLocationMapInMemory_{
+					KeyLocationMapInMemory: &pb.LocalBlobAccessConfiguration_KeyLocationMapInMemory{
+						Entries: 1024,
+					},
+				},
+				KeyLocationMapMaximumGetAttempts: 8,
+				KeyLocationMapMaximumPutAttempts: 32,
+				OldBlocks:                        1,
+				CurrentBlocks:                    2,
+				NewBlocks:                        1,
+				BlocksBackend: &pb.LocalBlobAccessConfiguration_BlocksInMemory_{
+					BlocksInMemory: &pb.LocalBlobAccessConfiguration_BlocksInMemory{
+						BlockSizeBytes: 1 << 20,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestActionCacheServerOffloading(t *testing.T) {
+	// Construct an Action Cache (AC) that offloads ActionResult
+	// messages exceeding the maximum message size of 1000 bytes
+	// into the Content Addressable Storage (CAS). Construct it
+	// through the configuration, so that any decorators that are
+	// placed on top of it are taken into account.
+	require.NoError(t, program.RunLocal(context.Background(), func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		contentAddressableStorage, err := blobstore_configuration.NewBlobAccessFromConfiguration(
+			dependenciesGroup,
+			newInMemoryBlobAccessConfiguration(),
+			blobstore_configuration.NewCASBlobAccessCreator(nil, 1000))
+		require.NoError(t, err)
+		acBlobAccessCreator := blobstore_configuration.NewACBlobAccessCreator(&contentAddressableStorage, nil, 1000)
+		actionCache, err := blobstore_configuration.NewBlobAccessFromConfiguration(
+			dependenciesGroup,
+			&pb.BlobAccessConfiguration{
+				Backend: &pb.BlobAccessConfiguration_ActionResultOffloading{
+					ActionResultOffloading: &pb.ActionResultOffloadingBlobAccessConfiguration{
+						Backend:                   newInMemoryBlobAccessConfiguration(),
+						MaximumOffloadedSizeBytes: 100000,
+					},
+				},
+			},
+			acBlobAccessCreator)
+		require.NoError(t, err)
+		require.Equal(t, 100000, acBlobAccessCreator.GetMaximumOffloadedActionResultSizeBytes())
+
+		defaultDigestFunctions, err := digest.NewDefaultFunctionResolver(nil, nil)
+		require.NoError(t, err)
+		actionCacheServer := grpcservers.NewActionCacheServer(
+			actionCache.BlobAccess,
+			newMaximumMessageSizeResolver(t, 1000),
+			int64(acBlobAccessCreator.GetMaximumOffloadedActionResultSizeBytes()),
+			defaultDigestFunctions)
+
+		actionDigest := &remoteexecution.Digest{
+			Hash:      "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969",
+			SizeBytes: 5,
+		}
+		newActionResult := func(outputFiles int) *remoteexecution.ActionResult {
+			actionResult := &remoteexecution.ActionResult{
+				ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+					WorkerCompletedTimestamp: &timestamppb.Timestamp{Seconds: 1000},
+				},
+			}
+			for i := 0; i < outputFiles; i++ {
+				actionResult.OutputFiles = append(actionResult.OutputFiles, &remoteexecution.OutputFile{
+					Path: fmt.Sprintf("bazel-out/k8-fastbuild/bin/output_%d", i),
+					Digest: &remoteexecution.Digest{
+						Hash:      "8b1a9953c4611296a827abf8c47804d7",
+						SizeBytes: 5,
+					},
+				})
+			}
+			return actionResult
+		}
+
+		t.Run("Small", func(t *testing.T) {
+			actionResult := newActionResult(1)
+			require.Less(t, proto.Size(actionResult), 1000)
+			_, err := actionCacheServer.UpdateActionResult(ctx, &remoteexecution.UpdateActionResultRequest{
+				InstanceName:   "small",
+				ActionDigest:   actionDigest,
+				ActionResult:   actionResult,
+				DigestFunction: remoteexecution.DigestFunction_SHA256,
+			})
+			require.NoError(t, err)
+
+			storedActionResult, err := actionCacheServer.GetActionResult(ctx, &remoteexecution.GetActionResultRequest{
+				InstanceName:   "small",
+				ActionDigest:   actionDigest,
+				DigestFunction: remoteexecution.DigestFunction_SHA256,
+			})
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, actionResult, storedActionResult)
+		})
+
+		t.Run("Offloaded", func(t *testing.T) {
+			// ActionResult messages exceeding the maximum
+			// message size should be accepted by all
+			// decorators, and be returned by the server.
+			actionResult := newActionResult(100)
+			require.Greater(t, proto.Size(actionResult), 1000)
+			_, err := actionCacheServer.UpdateActionResult(ctx, &remoteexecution.UpdateActionResultRequest{
+				InstanceName:   "large",
+				ActionDigest:   actionDigest,
+				ActionResult:   actionResult,
+				DigestFunction: remoteexecution.DigestFunction_SHA256,
+			})
+			require.NoError(t, err)
+
+			storedActionResult, err := actionCacheServer.GetActionResult(ctx, &remoteexecution.GetActionResultRequest{
+				InstanceName:   "large",
+				ActionDigest:   actionDigest,
+				DigestFunction: remoteexecution.DigestFunction_SHA256,
+			})
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, actionResult, storedActionResult)
+		})
+
+		t.Run("TooLarge", func(t *testing.T) {
+			// ActionResult messages exceeding the maximum
+			// offloaded size should still be rejected.
+			actionResult := newActionResult(10000)
+			require.Greater(t, proto.Size(actionResult), 100000)
+			_, err := actionCacheServer.UpdateActionResult(ctx, &remoteexecution.UpdateActionResultRequest{
+				InstanceName:   "huge",
+				ActionDigest:   actionDigest,
+				ActionResult:   actionResult,
+				DigestFunction: remoteexecution.DigestFunction_SHA256,
+			})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+		return nil
+	}))
+}
+
+func TestActionCacheServerPerInstanceNameLimits(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Permit ActionResult messages of up to 10000 bytes for
+	// instance name "large", and up to 1000 bytes otherwise.
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	maximumMessageSizeResolver, err := digest.NewMaximumMessageSizeResolver(1000, map[string]int64{
+		"large": 10000,
+	})
+	require.NoError(t, err)
+	defaultDigestFunctions, err := digest.NewDefaultFunctionResolver(nil, nil)
+	require.NoError(t, err)
+	actionCacheServer := grpcservers.NewActionCacheServer(blobAccess, maximumMessageSizeResolver, 0, defaultDigestFunctions)
+
+	actionDigest := &remoteexecution.Digest{
+		Hash:      "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969",
+		SizeBytes: 5,
+	}
+	actionResult := &remoteexecution.ActionResult{
+		StdoutRaw: make([]byte, 5000),
+	}
+
+	t.Run("UpdateAccepted", func(t *testing.T) {
+		blobAccess.EXPECT().Put(ctx, digest.MustNewDigest("large", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		_, err := actionCacheServer.UpdateActionResult(ctx, &remoteexecution.UpdateActionResultRequest{
+			InstanceName:   "large",
+			ActionDigest:   actionDigest,
+			ActionResult:   actionResult,
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("UpdateRejected", func(t *testing.T) {
+		// The same message should be rejected for other
+		// instance names, without contacting the backend.
+		_, err := actionCacheServer.UpdateActionResult(ctx, &remoteexecution.UpdateActionResultRequest{
+			InstanceName:   "small",
+			ActionDigest:   actionDigest,
+			ActionResult:   actionResult,
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		testutil.RequireEqualStatus(t, status.Errorf(codes.InvalidArgument, "Action result is %d bytes in size, while a maximum of 1000 bytes is permitted", proto.Size(actionResult)), err)
+	})
+
+	t.Run("GetRejected", func(t *testing.T) {
+		blobAccess.EXPECT().Get(ctx, digest.MustNewDigest("small", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)).
+			Return(buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided))
+
+		_, err := actionCacheServer.GetActionResult(ctx, &remoteexecution.GetActionResultRequest{
+			InstanceName:   "small",
+			ActionDigest:   actionDigest,
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,130 @@
+package grpcservers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+type bandwidthLimiterBucket struct {
+	tokens     float64
+	lastUpdate time.Time
+}
+
+// BandwidthLimiter limits the rate at which data may be transferred
+// by individual principals, where principals are identified by the
+// authentication metadata that is attached to the request. It is used
+// by the ByteStream server to prevent a single client from saturating
+// the network bandwidth of the server.
+//
+// Limiting is implemented using a token bucket algorithm, where tokens
+// correspond to bytes. Each principal may transfer up to burstBytes
+// bytes in quick succession, and bytesPerSecond bytes per second on
+// average. Transfers exceeding the limit are delayed, as opposed to
+// being rejected.
+//
+// A nil BandwidthLimiter may be used to disable limiting entirely.
+type BandwidthLimiter struct {
+	clock             clock.Clock
+	bytesPerSecond    float64
+	burstBytes        float64
+	maximumPrincipals int
+	marshalOptions    proto.MarshalOptions
+
+	lock               sync.Mutex
+	bucketsByPrincipal map[string]*bandwidthLimiterBucket
+	evictionSet        eviction.Set[string]
+}
+
+// NewBandwidthLimiter creates a BandwidthLimiter. State is only
+// tracked for up to maximumPrincipals principals. When exceeded, the
+// state of principals is discarded according to the provided eviction
+// set.
+func NewBandwidthLimiter(clock clock.Clock, evictionSet eviction.Set[string], maximumPrincipals int, bytesPerSecond, burstBytes int64) *BandwidthLimiter {
+	return &BandwidthLimiter{
+		clock:              clock,
+		bytesPerSecond:     float64(bytesPerSecond),
+		burstBytes:         float64(burstBytes),
+		maximumPrincipals:  maximumPrincipals,
+		marshalOptions:     proto.MarshalOptions{Deterministic: true},
+		bucketsByPrincipal: map[string]*bandwidthLimiterBucket{},
+		evictionSet:        evictionSet,
+	}
+}
+
+// takeTokens consumes tokens from the bucket belonging to a principal.
+// The bucket is permitted to go into debt. The amount of time the
+// caller needs to wait for the debt to be paid off is returned.
+func (l *BandwidthLimiter) takeTokens(principal string, sizeBytes int) time.Duration {
+	now := l.clock.Now()
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	bucket, ok := l.bucketsByPrincipal[principal]
+	if ok {
+		l.evictionSet.Touch(principal)
+
+		// Replenish tokens for the time that has passed
+		// since the last transfer.
+		if elapsed := now.Sub(bucket.lastUpdate); elapsed > 0 {
+			bucket.tokens += elapsed.Seconds() * l.bytesPerSecond
+			if bucket.tokens > l.burstBytes {
+				bucket.tokens = l.burstBytes
+			}
+		}
+		bucket.lastUpdate = now
+	} else {
+		// Principal is not known yet. Create a full bucket,
+		// while making space for it if needed.
+		for len(l.bucketsByPrincipal) >= l.maximumPrincipals && len(l.bucketsByPrincipal) > 0 {
+			delete(l.bucketsByPrincipal, l.evictionSet.Peek())
+			l.evictionSet.Remove()
+		}
+		l.evictionSet.Insert(principal)
+		bucket = &bandwidthLimiterBucket{
+			tokens:     l.burstBytes,
+			lastUpdate: now,
+		}
+		l.bucketsByPrincipal[principal] = bucket
+	}
+
+	bucket.tokens -= float64(sizeBytes)
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / l.bytesPerSecond * float64(time.Second))
+}
+
+// Wait until the principal associated with the context is permitted
+// to transfer a given amount of data.
+func (l *BandwidthLimiter) Wait(ctx context.Context, sizeBytes int) error {
+	if l == nil || sizeBytes == 0 {
+		return nil
+	}
+	principal, err := l.marshalOptions.Marshal(auth.AuthenticationMetadataFromContext(ctx).GetFullProto())
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal authentication metadata")
+	}
+	delay := l.takeTokens(string(principal), sizeBytes)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer, t := l.clock.NewTimer(delay)
+	select {
+	case <-t:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		return util.StatusFromContext(ctx)
+	}
+}
@@ -9,31 +9,78 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 
+	"golang.org/x/sync/semaphore"
+
 	"google.golang.org/genproto/googleapis/bytestream"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type byteStreamServer struct {
-	blobAccess    blobstore.BlobAccess
-	readChunkSize int
+	blobAccess             blobstore.BlobAccess
+	readChunkSize          int
+	streams                *semaphore.Weighted
+	inFlightBytesLimiter   *InFlightBytesLimiter
+	defaultDigestFunctions *digest.DefaultFunctionResolver
+	bandwidthLimiter       *BandwidthLimiter
 }
 
 // NewByteStreamServer creates a GRPC service for reading blobs from and
 // writing blobs to a BlobAccess. It is used by Bazel to access the
-// Content Addressable Storage (CAS).
-func NewByteStreamServer(blobAccess blobstore.BlobAccess, readChunkSize int) bytestream.ByteStreamServer {
+// Content Addressable Storage (CAS). Resource names that don't contain
+// a digest function use the defaults provided by
+// defaultDigestFunctions.
+//
+// If streams is not nil, every Read() and Write() call acquires a unit
+// of capacity from it. Calls for which no capacity is available are
+// rejected with RESOURCE_EXHAUSTED, thereby placing a bound on the
+// amount of memory used for buffering. The same semaphore may be
+// provided to the servers of all listeners, so that the limit applies
+// to the process as a whole.
+//
+// If inFlightBytesLimiter is not nil, the total amount of data of
+// Write() calls that has been handed to the backend, but not yet been
+// stored by it, is limited. Once this limit is reached, Write() calls
+// stop receiving data from their clients until the backend catches up,
+// causing gRPC flow control to push back on the clients.
+//
+// If bandwidthLimiter is not nil, the rate at which data is sent by
+// Read() and received by Write() is limited for each principal.
+func NewByteStreamServer(blobAccess blobstore.BlobAccess, readChunkSize int, streams *semaphore.Weighted, inFlightBytesLimiter *InFlightBytesLimiter, defaultDigestFunctions *digest.DefaultFunctionResolver, bandwidthLimiter *BandwidthLimiter) bytestream.ByteStreamServer {
 	return &byteStreamServer{
-		blobAccess:    blobAccess,
-		readChunkSize: readChunkSize,
+		blobAccess:             blobAccess,
+		readChunkSize:          readChunkSize,
+		streams:                streams,
+		inFlightBytesLimiter:   inFlightBytesLimiter,
+		defaultDigestFunctions: defaultDigestFunctions,
+		bandwidthLimiter:       bandwidthLimiter,
+	}
+}
+
+// acquireStream reserves capacity for processing a single stream.
+func (s *byteStreamServer) acquireStream() error {
+	if s.streams != nil && !s.streams.TryAcquire(1) {
+		return status.Error(codes.ResourceExhausted, "Maximum number of concurrent streams reached")
+	}
+	return nil
+}
+
+func (s *byteStreamServer) releaseStream() {
+	if s.streams != nil {
+		s.streams.Release(1)
 	}
 }
 
 func (s *byteStreamServer) Read(in *bytestream.ReadRequest, out bytestream.ByteStream_ReadServer) error {
+	if err := s.acquireStream(); err != nil {
+		return err
+	}
+	defer s.releaseStream()
+
 	if in.ReadLimit != 0 {
 		return status.Error(codes.Unimplemented, "This service does not support downloading partial files")
 	}
-	digest, compressor, err := digest.NewDigestFromByteStreamReadPath(in.ResourceName)
+	digest, compressor, err := s.defaultDigestFunctions.NewDigestFromByteStreamReadPath(in.ResourceName)
 	if err != nil {
 		return err
 	}
@@ -52,6 +99,9 @@ func (s *byteStreamServer) Read(in *bytestream.ReadRequest, out bytestream.ByteS
 		if readErr != nil {
 			return readErr
 		}
+		if err := s.bandwidthLimiter.Wait(out.Context(), len(readBuf)); err != nil {
+			return err
+		}
 		if writeErr := out.Send(&bytestream.ReadResponse{Data: readBuf}); writeErr != nil {
 			return writeErr
 		}
@@ -63,6 +113,8 @@ type byteStreamWriteServerChunkReader struct {
 	writeOffset   int64
 	data          []byte
 	finishedWrite bool
+
+	bandwidthLimiter *BandwidthLimiter
 }
 
 func (r *byteStreamWriteServerChunkReader) setRequest(request *bytestream.WriteRequest) error {
@@ -92,6 +144,9 @@ func (r *byteStreamWriteServerChunkReader) Read() ([]byte, error) {
 		if err := r.setRequest(request); err != nil {
 			return nil, err
 		}
+		if err := r.bandwidthLimiter.Wait(r.stream.Context(), len(r.data)); err != nil {
+			return nil, err
+		}
 	}
 
 	data := r.data
@@ -102,11 +157,16 @@ func (r *byteStreamWriteServerChunkReader) Read() ([]byte, error) {
 func (r *byteStreamWriteServerChunkReader) Close() {}
 
 func (s *byteStreamServer) Write(stream bytestream.ByteStream_WriteServer) error {
+	if err := s.acquireStream(); err != nil {
+		return err
+	}
+	defer s.releaseStream()
+
 	request, err := stream.Recv()
 	if err != nil {
 		return err
 	}
-	digest, compressor, err := digest.NewDigestFromByteStreamWritePath(request.ResourceName)
+	digest, compressor, err := s.defaultDigestFunctions.NewDigestFromByteStreamWritePath(request.ResourceName)
 	if err != nil {
 		return err
 	}
@@ -114,10 +174,26 @@ func (s *byteStreamServer) Write(stream bytestream.ByteStream_WriteServer) error
 		return status.Error(codes.Unimplemented, "This service does not support uploading compressed files")
 	}
 
-	r := &byteStreamWriteServerChunkReader{stream: stream}
+	// Block until the backend has stored enough data of other
+	// Write() calls. Capacity is only released after the backend's
+	// Put() call returns, as the backend may still buffer data that
+	// it has obtained up to that point.
+	acquiredBytes, err := s.inFlightBytesLimiter.acquire(stream.Context(), digest.GetSizeBytes())
+	if err != nil {
+		return err
+	}
+	defer s.inFlightBytesLimiter.release(acquiredBytes)
+
+	r := &byteStreamWriteServerChunkReader{
+		stream:           stream,
+		bandwidthLimiter: s.bandwidthLimiter,
+	}
 	if err := r.setRequest(request); err != nil {
 		return err
 	}
+	if err := s.bandwidthLimiter.Wait(stream.Context(), len(r.data)); err != nil {
+		return err
+	}
 	if err := s.blobAccess.Put(
 		stream.Context(),
 		digest,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"net"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,9 +13,11 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
 	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
 	"github.com/stretchr/testify/require"
 
+	"golang.org/x/sync/semaphore"
 	"google.golang.org/genproto/googleapis/bytestream"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -31,7 +34,7 @@ func TestByteStreamServer(t *testing.T) {
 	l := bufconn.Listen(1 << 20)
 	server := grpc.NewServer()
 	blobAccess := mock.NewMockBlobAccess(ctrl)
-	bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(blobAccess, 10))
+	bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(blobAccess, 10, nil, nil, nil, nil))
 	go func() {
 		require.NoError(t, server.Serve(l))
 	}()
@@ -320,3 +323,432 @@ func TestByteStreamServer(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.Unimplemented, "This service does not support querying write status"), err)
 	})
 }
+
+func TestByteStreamServerLimits(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create an RPC server/client pair that uses a small chunk
+	// size, and permits only a single stream at a time.
+	l := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(blobAccess, 4, semaphore.NewWeighted(1), nil, nil, nil))
+	go func() {
+		require.NoError(t, server.Serve(l))
+	}()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return l.Dial()
+	}), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer server.Stop()
+	defer conn.Close()
+	client := bytestream.NewByteStreamClient(conn)
+
+	t.Run("ReadChunkSize", func(t *testing.T) {
+		// Data should be returned in chunks of the configured
+		// size.
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "blobs/8b1a9953c4611296a827abf8c47804d7/5",
+		})
+		require.NoError(t, err)
+		readResponse, err := req.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hell"), readResponse.Data)
+		readResponse, err = req.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []byte("o"), readResponse.Data)
+		_, err = req.Recv()
+		require.Equal(t, io.EOF, err)
+	})
+
+	t.Run("MaximumConcurrentStreams", func(t *testing.T) {
+		// Start a write that blocks inside the backend.
+		putStarted := make(chan struct{})
+		putUnblock := make(chan struct{})
+		blobAccess.EXPECT().Put(
+			gomock.Any(),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+			gomock.Any(),
+		).DoAndReturn(func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+			close(putStarted)
+			<-putUnblock
+			data, err := b.ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("Hello"), data)
+			return nil
+		})
+
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: "uploads/da2f7c5e-3d9a-4fe4-8ba5-9d4d3bbd5a64/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			Data:         []byte("Hello"),
+			FinishWrite:  true,
+		}))
+		<-putStarted
+
+		// While the write is in progress, additional streams
+		// should be rejected.
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "blobs/8b1a9953c4611296a827abf8c47804d7/5",
+		})
+		require.NoError(t, err)
+		_, err = req.Recv()
+		testutil.RequireEqualStatus(t, status.Error(codes.ResourceExhausted, "Maximum number of concurrent streams reached"), err)
+
+		// Once the write completes, capacity should be released.
+		close(putUnblock)
+		response, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), response.CommittedSize)
+
+		blobAccess.EXPECT().Get(
+			gomock.Any(),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		req, err = client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "blobs/8b1a9953c4611296a827abf8c47804d7/5",
+		})
+		require.NoError(t, err)
+		readResponse, err := req.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hell"), readResponse.Data)
+		readResponse, err = req.Recv()
+		require.NoError(t, err)
+		require.Equal(t, []byte("o"), readResponse.Data)
+		_, err = req.Recv()
+		require.Equal(t, io.EOF, err)
+	})
+}
+
+func TestByteStreamServerMaximumInFlightWriteBytes(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create two RPC server/client pairs, corresponding to separate
+	// listeners. Both share a limiter that permits no more than
+	// five bytes to be handed to the backend at a time.
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	inFlightBytesLimiter := grpcservers.NewInFlightBytesLimiter(5)
+	newClient := func() bytestream.ByteStreamClient {
+		l := bufconn.Listen(1 << 20)
+		server := grpc.NewServer()
+		bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(blobAccess, 10, nil, inFlightBytesLimiter, nil, nil))
+		go func() {
+			require.NoError(t, server.Serve(l))
+		}()
+		conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return l.Dial()
+		}), grpc.WithInsecure())
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			conn.Close()
+			server.Stop()
+		})
+		return bytestream.NewByteStreamClient(conn)
+	}
+	client1 := newClient()
+	client2 := newClient()
+
+	blobDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	// Upload the blob in two chunks. This ensures that the backend
+	// can obtain the first chunk without the server needing to
+	// receive any further data.
+	startWrite := func(client bytestream.ByteStreamClient) bytestream.ByteStream_WriteClient {
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: "uploads/da2f7c5e-3d9a-4fe4-8ba5-9d4d3bbd5a64/blobs/8b1a9953c4611296a827abf8c47804d7/5",
+			Data:         []byte("Hel"),
+		}))
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			WriteOffset: 3,
+			Data:        []byte("lo"),
+			FinishWrite: true,
+		}))
+		require.NoError(t, stream.CloseSend())
+		return stream
+	}
+
+	t.Run("SlowBackend", func(t *testing.T) {
+		// Let the first write obtain all data from the client,
+		// but let it not finish storing it. The data should
+		// remain accounted for until Put() returns, even though
+		// the chunk reader has already been drained.
+		firstHolding := make(chan struct{})
+		firstRelease := make(chan struct{})
+		blobAccess.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				r := b.ToChunkReader(0, 100)
+				defer r.Close()
+				data, err := r.Read()
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hel"), data)
+				data, err = r.Read()
+				require.NoError(t, err)
+				require.Equal(t, []byte("lo"), data)
+				_, err = r.Read()
+				require.Equal(t, io.EOF, err)
+				close(firstHolding)
+				<-firstRelease
+				return nil
+			})
+
+		stream1 := startWrite(client1)
+		<-firstHolding
+
+		// A second write through another listener should not be
+		// able to hand any data to the backend, as that would
+		// cause the limit to be exceeded.
+		blobAccess.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				select {
+				case <-firstRelease:
+				default:
+					t.Error("Second write started while the limit was reached")
+				}
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		stream2 := startWrite(client2)
+
+		// Once the first write completes, the second write
+		// should be able to proceed.
+		close(firstRelease)
+		response, err := stream1.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), response.CommittedSize)
+
+		response, err = stream2.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), response.CommittedSize)
+	})
+
+	t.Run("CapacityReleased", func(t *testing.T) {
+		// Writes that failed should not leak any capacity.
+		blobAccess.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				r := b.ToChunkReader(0, 100)
+				defer r.Close()
+				_, err := r.Read()
+				require.NoError(t, err)
+				return status.Error(codes.Internal, "Disk on fire")
+			})
+
+		stream := startWrite(client1)
+		_, err := stream.CloseAndRecv()
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Disk on fire"), err)
+
+		blobAccess.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		stream = startWrite(client2)
+		response, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(5), response.CommittedSize)
+	})
+
+	t.Run("ConcurrentWrites", func(t *testing.T) {
+		// Two writes that together exceed the limit should not
+		// deadlock. If capacity were acquired for each chunk
+		// separately, both writes could obtain their first
+		// chunk, after which neither of them is able to obtain
+		// its second chunk.
+		smallBlobDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "1824e8e0307cbfdd1993511ab040075c", 4)
+		var firstChunksRead sync.WaitGroup
+		firstChunksRead.Add(2)
+		blobAccess.EXPECT().Put(gomock.Any(), smallBlobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				r := b.ToChunkReader(0, 100)
+				defer r.Close()
+				data, err := r.Read()
+				require.NoError(t, err)
+				require.Equal(t, []byte("He"), data)
+
+				// Give the other write the opportunity to
+				// obtain its first chunk as well.
+				firstChunksRead.Done()
+				bothRead := make(chan struct{})
+				go func() {
+					firstChunksRead.Wait()
+					close(bothRead)
+				}()
+				select {
+				case <-bothRead:
+				case <-time.After(100 * time.Millisecond):
+				}
+
+				data, err = r.Read()
+				require.NoError(t, err)
+				require.Equal(t, []byte("ll"), data)
+				_, err = r.Read()
+				require.Equal(t, io.EOF, err)
+				return nil
+			}).Times(2)
+
+		writeCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		var writes sync.WaitGroup
+		for _, client := range []bytestream.ByteStreamClient{client1, client2} {
+			writes.Add(1)
+			go func() {
+				defer writes.Done()
+				stream, err := client.Write(writeCtx)
+				require.NoError(t, err)
+				require.NoError(t, stream.Send(&bytestream.WriteRequest{
+					ResourceName: "uploads/da2f7c5e-3d9a-4fe4-8ba5-9d4d3bbd5a64/blobs/1824e8e0307cbfdd1993511ab040075c/4",
+					Data:         []byte("He"),
+				}))
+				require.NoError(t, stream.Send(&bytestream.WriteRequest{
+					WriteOffset: 2,
+					Data:        []byte("ll"),
+					FinishWrite: true,
+				}))
+				response, err := stream.CloseAndRecv()
+				require.NoError(t, err)
+				require.Equal(t, int64(4), response.CommittedSize)
+			}()
+		}
+		writes.Wait()
+	})
+
+	t.Run("LargerThanLimit", func(t *testing.T) {
+		// Blobs larger than the limit should still be
+		// accepted, as a single write never holds more
+		// capacity than the limit.
+		largeBlobDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "91db2d4279a42766759cfa87e9d633b4", 10)
+		blobAccess.EXPECT().Put(gomock.Any(), largeBlobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("HelloHello"), data)
+				return nil
+			})
+
+		stream, err := client1.Write(ctx)
+		require.NoError(t, err)
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			ResourceName: "uploads/da2f7c5e-3d9a-4fe4-8ba5-9d4d3bbd5a64/blobs/91db2d4279a42766759cfa87e9d633b4/10",
+			Data:         []byte("Hello"),
+		}))
+		require.NoError(t, stream.Send(&bytestream.WriteRequest{
+			WriteOffset: 5,
+			Data:        []byte("Hello"),
+			FinishWrite: true,
+		}))
+		response, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(10), response.CommittedSize)
+	})
+}
+
+func TestByteStreamServerBandwidthLimit(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create an RPC server/client pair that permits 10 bytes per
+	// second to be transferred, with a burst of 10 bytes. Timers
+	// fire immediately, while advancing the clock.
+	clock := mock.NewMockClock(ctrl)
+	now := time.Unix(1000, 0)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+	expectTimer := func(d time.Duration) {
+		clock.EXPECT().NewTimer(d).DoAndReturn(func(d time.Duration) (*mock.MockTimer, <-chan time.Time) {
+			now = now.Add(d)
+			t := make(chan time.Time, 1)
+			t <- now
+			return mock.NewMockTimer(ctrl), t
+		})
+	}
+	bandwidthLimiter := grpcservers.NewBandwidthLimiter(clock, eviction.NewLRUSet[string](), 10, 10, 10)
+
+	l := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	bytestream.RegisterByteStreamServer(server, grpcservers.NewByteStreamServer(blobAccess, 10, nil, nil, nil, bandwidthLimiter))
+	go func() {
+		require.NoError(t, server.Serve(l))
+	}()
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return l.Dial()
+	}), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer server.Stop()
+	defer conn.Close()
+	client := bytestream.NewByteStreamClient(conn)
+
+	blobDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "ee1971d22087018201395a03690c3cb3", 30)
+
+	t.Run("Unlimited", func(t *testing.T) {
+		// A nil limiter should never cause transfers to be
+		// delayed.
+		var unlimited *grpcservers.BandwidthLimiter
+		require.NoError(t, unlimited.Wait(ctx, 1<<30))
+	})
+
+	t.Run("Read", func(t *testing.T) {
+		// The first chunk fits in the burst. Every subsequent
+		// chunk of 10 bytes should be delayed by a second.
+		blobAccess.EXPECT().Get(gomock.Any(), blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("0123456789abcdefghijABCDEFGHIJ")))
+		expectTimer(time.Second)
+		expectTimer(time.Second)
+
+		req, err := client.Read(ctx, &bytestream.ReadRequest{
+			ResourceName: "blobs/ee1971d22087018201395a03690c3cb3/30",
+		})
+		require.NoError(t, err)
+		for _, expectedData := range []string{"0123456789", "abcdefghij", "ABCDEFGHIJ"} {
+			readResponse, err := req.Recv()
+			require.NoError(t, err)
+			require.Equal(t, []byte(expectedData), readResponse.Data)
+		}
+		_, err = req.Recv()
+		require.Equal(t, io.EOF, err)
+		require.Equal(t, time.Unix(1002, 0), now)
+	})
+
+	t.Run("Write", func(t *testing.T) {
+		// The bucket has no tokens left as a result of the
+		// previous read, meaning every chunk should be delayed
+		// by a second.
+		blobAccess.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("0123456789abcdefghijABCDEFGHIJ"), data)
+				return nil
+			})
+		expectTimer(time.Second)
+		expectTimer(time.Second)
+		expectTimer(time.Second)
+
+		stream, err := client.Write(ctx)
+		require.NoError(t, err)
+		for i, data := range []string{"0123456789", "abcdefghij", "ABCDEFGHIJ"} {
+			require.NoError(t, stream.Send(&bytestream.WriteRequest{
+				ResourceName: "uploads/da2f7c5e-3d9a-4fe4-8ba5-9d4d3bbd5a64/blobs/ee1971d22087018201395a03690c3cb3/30",
+				WriteOffset:  int64(i * 10),
+				Data:         []byte(data),
+				FinishWrite:  i == 2,
+			}))
+		}
+		response, err := stream.CloseAndRecv()
+		require.NoError(t, err)
+		require.Equal(t, int64(30), response.CommittedSize)
+		require.Equal(t, time.Unix(1005, 0), now)
+	})
+}
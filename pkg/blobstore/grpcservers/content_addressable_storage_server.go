@@ -14,16 +14,30 @@ import (
 )
 
 type contentAddressableStorageServer struct {
-	contentAddressableStorage blobstore.BlobAccess
-	maximumMessageSizeBytes   int64
+	contentAddressableStorage  blobstore.BlobAccess
+	maximumMessageSizeResolver *digest.MaximumMessageSizeResolver
+	defaultDigestFunctions     *digest.DefaultFunctionResolver
+	maximumFindMissingDigests  int
 }
 
 // NewContentAddressableStorageServer creates a GRPC service for serving
 // the contents of a Bazel Content Addressable Storage (CAS) to Bazel.
-func NewContentAddressableStorageServer(contentAddressableStorage blobstore.BlobAccess, maximumMessageSizeBytes int64) remoteexecution.ContentAddressableStorageServer {
+// Requests that don't specify a digest function use the defaults
+// provided by defaultDigestFunctions.
+//
+// The total size of the blobs in BatchReadBlobs() and
+// BatchUpdateBlobs() requests is limited to the maximum message size
+// that maximumMessageSizeResolver provides for the instance name.
+//
+// FindMissingBlobs() requests containing more than
+// maximumFindMissingDigests digests are rejected. If zero, no limit is
+// enforced.
+func NewContentAddressableStorageServer(contentAddressableStorage blobstore.BlobAccess, maximumMessageSizeResolver *digest.MaximumMessageSizeResolver, defaultDigestFunctions *digest.DefaultFunctionResolver, maximumFindMissingDigests int) remoteexecution.ContentAddressableStorageServer {
 	return &contentAddressableStorageServer{
-		contentAddressableStorage: contentAddressableStorage,
-		maximumMessageSizeBytes:   maximumMessageSizeBytes,
+		contentAddressableStorage:  contentAddressableStorage,
+		maximumMessageSizeResolver: maximumMessageSizeResolver,
+		defaultDigestFunctions:     defaultDigestFunctions,
+		maximumFindMissingDigests:  maximumFindMissingDigests,
 	}
 }
 
@@ -31,11 +45,14 @@ func (s *contentAddressableStorageServer) FindMissingBlobs(ctx context.Context,
 	if len(in.BlobDigests) == 0 {
 		return &remoteexecution.FindMissingBlobsResponse{}, nil
 	}
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	if s.maximumFindMissingDigests > 0 && len(in.BlobDigests) > s.maximumFindMissingDigests {
+		return nil, status.Errorf(codes.InvalidArgument, "Request contains %d digests, while a maximum of %d digests is permitted", len(in.BlobDigests), s.maximumFindMissingDigests)
+	}
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
-	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, len(in.BlobDigests[0].GetHash()))
+	digestFunction, err := s.defaultDigestFunctions.GetDigestFunction(instanceName, in.DigestFunction, len(in.BlobDigests[0].GetHash()))
 	if err != nil {
 		return nil, err
 	}
@@ -65,16 +82,17 @@ func (s *contentAddressableStorageServer) BatchReadBlobs(ctx context.Context, in
 	if len(in.Digests) == 0 {
 		return &remoteexecution.BatchReadBlobsResponse{}, nil
 	}
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
-	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, len(in.Digests[0].GetHash()))
+	digestFunction, err := s.defaultDigestFunctions.GetDigestFunction(instanceName, in.DigestFunction, len(in.Digests[0].GetHash()))
 	if err != nil {
 		return nil, err
 	}
 
-	bytesRemaining := s.maximumMessageSizeBytes
+	maximumMessageSizeBytes := s.maximumMessageSizeResolver.GetMaximumMessageSizeBytes(instanceName)
+	bytesRemaining := maximumMessageSizeBytes
 	digests := make([]digest.Digest, 0, len(in.Digests))
 	for _, reqDigest := range in.Digests {
 		digest, err := digestFunction.NewDigestFromProto(reqDigest)
@@ -86,8 +104,8 @@ func (s *contentAddressableStorageServer) BatchReadBlobs(ctx context.Context, in
 			return nil, status.Errorf(
 				codes.InvalidArgument,
 				"Attempted to read a total of at least %d bytes, while a maximum of %d bytes is permitted",
-				uint64(s.maximumMessageSizeBytes-bytesRemaining)+uint64(sizeBytes),
-				s.maximumMessageSizeBytes)
+				uint64(maximumMessageSizeBytes-bytesRemaining)+uint64(sizeBytes),
+				maximumMessageSizeBytes)
 		}
 		bytesRemaining -= sizeBytes
 		digests = append(digests, digest)
@@ -114,15 +132,29 @@ func (s *contentAddressableStorageServer) BatchUpdateBlobs(ctx context.Context,
 	if len(in.Requests) == 0 {
 		return &remoteexecution.BatchUpdateBlobsResponse{}, nil
 	}
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
-	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, len(in.Requests[0].Digest.GetHash()))
+	digestFunction, err := s.defaultDigestFunctions.GetDigestFunction(instanceName, in.DigestFunction, len(in.Requests[0].Digest.GetHash()))
 	if err != nil {
 		return nil, err
 	}
 
+	maximumMessageSizeBytes := s.maximumMessageSizeResolver.GetMaximumMessageSizeBytes(instanceName)
+	bytesRemaining := maximumMessageSizeBytes
+	for _, request := range in.Requests {
+		sizeBytes := int64(len(request.Data))
+		if sizeBytes > bytesRemaining {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"Attempted to write a total of at least %d bytes, while a maximum of %d bytes is permitted",
+				uint64(maximumMessageSizeBytes-bytesRemaining)+uint64(sizeBytes),
+				maximumMessageSizeBytes)
+		}
+		bytesRemaining -= sizeBytes
+	}
+
 	response := &remoteexecution.BatchUpdateBlobsResponse{
 		Responses: make([]*remoteexecution.BatchUpdateBlobsResponse_Response, 0, len(in.Requests)),
 	}
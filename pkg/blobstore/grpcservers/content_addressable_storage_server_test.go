@@ -19,6 +19,12 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+func newMaximumMessageSizeResolver(t *testing.T, maximumMessageSizeBytes int64) *digest.MaximumMessageSizeResolver {
+	maximumMessageSizeResolver, err := digest.NewMaximumMessageSizeResolver(maximumMessageSizeBytes, nil)
+	require.NoError(t, err)
+	return maximumMessageSizeResolver
+}
+
 func TestContentAddressableStorageServerBatchReadBlobsSuccess(t *testing.T) {
 	ctrl, ctx := gomock.WithContext(context.Background(), t)
 
@@ -55,7 +61,7 @@ func TestContentAddressableStorageServerBatchReadBlobsSuccess(t *testing.T) {
 	buf3 := buffer.NewBufferFromError(status.Error(codes.NotFound, "The object you requested could not be found"))
 	contentAddressableStorage.EXPECT().Get(ctx, digest3).Return(buf3)
 
-	contentAddressableStorageServer := grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, 1<<16)
+	contentAddressableStorageServer := grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, newMaximumMessageSizeResolver(t, 1<<16), nil, 0)
 
 	response, err := contentAddressableStorageServer.BatchReadBlobs(ctx, request)
 	require.NoError(t, err)
@@ -108,8 +114,160 @@ func TestContentAddressableStorageServerBatchReadBlobsFailure(t *testing.T) {
 
 	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
 
-	contentAddressableStorageServer := grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, 200)
+	contentAddressableStorageServer := grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, newMaximumMessageSizeResolver(t, 200), nil, 0)
 
 	_, err := contentAddressableStorageServer.BatchReadBlobs(ctx, request)
 	testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Attempted to read a total of at least 357 bytes, while a maximum of 200 bytes is permitted"), err)
 }
+
+func TestContentAddressableStorageServerFindMissingBlobsMaximumDigests(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	contentAddressableStorageServer := grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, newMaximumMessageSizeResolver(t, 1<<16), nil, 2)
+
+	digest1 := digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_SHA256, "409a7f83ac6b31dc8c77e3ec18038f209bd2f545e0f4177c2e2381aa4e067b49", 123)
+	digest2 := digest.MustNewDigest("ubuntu1804", remoteexecution.DigestFunction_SHA256, "0479688f99e8cbc70291ce272876ff8e0db71a0889daf2752884b0996056b4a0", 234)
+
+	t.Run("AtLimit", func(t *testing.T) {
+		contentAddressableStorage.EXPECT().FindMissing(ctx, digest.NewSetBuilder().Add(digest1).Add(digest2).Build()).
+			Return(digest2.ToSingletonSet(), nil)
+
+		response, err := contentAddressableStorageServer.FindMissingBlobs(ctx, &remoteexecution.FindMissingBlobsRequest{
+			InstanceName: "ubuntu1804",
+			BlobDigests: []*remoteexecution.Digest{
+				digest1.GetProto(),
+				digest2.GetProto(),
+			},
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.FindMissingBlobsResponse{
+			MissingBlobDigests: []*remoteexecution.Digest{
+				digest2.GetProto(),
+			},
+		}, response)
+	})
+
+	t.Run("AboveLimit", func(t *testing.T) {
+		_, err := contentAddressableStorageServer.FindMissingBlobs(ctx, &remoteexecution.FindMissingBlobsRequest{
+			InstanceName: "ubuntu1804",
+			BlobDigests: []*remoteexecution.Digest{
+				digest1.GetProto(),
+				digest2.GetProto(),
+				{
+					Hash:      "7821919ee052d21515cf4e36788138a301c18c36931290270aece8d79ea2cca6",
+					SizeBytes: 345,
+				},
+			},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Request contains 3 digests, while a maximum of 2 digests is permitted"), err)
+	})
+}
+
+func TestContentAddressableStorageServerPerInstanceNameMaximumMessageSize(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	contentAddressableStorage := mock.NewMockBlobAccess(ctrl)
+	maximumMessageSizeResolver, err := digest.NewMaximumMessageSizeResolver(1<<16, map[string]int64{
+		"small": 10,
+		"large": 20,
+	})
+	require.NoError(t, err)
+	contentAddressableStorageServer := grpcservers.NewContentAddressableStorageServer(contentAddressableStorage, maximumMessageSizeResolver, nil, 0)
+
+	blob1 := []byte("Hello, world")
+	blob2 := []byte("Goodbye")
+	newBatchUpdateBlobsRequest := func(instanceName string) *remoteexecution.BatchUpdateBlobsRequest {
+		return &remoteexecution.BatchUpdateBlobsRequest{
+			InstanceName:   instanceName,
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			Requests: []*remoteexecution.BatchUpdateBlobsRequest_Request{
+				{
+					Digest: &remoteexecution.Digest{
+						Hash:      "bc6e6f16b8a077ef5fbc8d59d0b931b9",
+						SizeBytes: 12,
+					},
+					Data: blob1,
+				},
+				{
+					Digest: &remoteexecution.Digest{
+						Hash:      "6fc422233a40a75a1f028e11c3cd1140",
+						SizeBytes: 7,
+					},
+					Data: blob2,
+				},
+			},
+		}
+	}
+	newBatchReadBlobsRequest := func(instanceName string) *remoteexecution.BatchReadBlobsRequest {
+		return &remoteexecution.BatchReadBlobsRequest{
+			InstanceName:   instanceName,
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			Digests: []*remoteexecution.Digest{
+				{
+					Hash:      "bc6e6f16b8a077ef5fbc8d59d0b931b9",
+					SizeBytes: 12,
+				},
+				{
+					Hash:      "6fc422233a40a75a1f028e11c3cd1140",
+					SizeBytes: 7,
+				},
+			},
+		}
+	}
+
+	t.Run("SmallInstanceName", func(t *testing.T) {
+		// The batches exceed the limit of 10 bytes of this
+		// instance name, meaning they should be rejected.
+		_, err := contentAddressableStorageServer.BatchUpdateBlobs(ctx, newBatchUpdateBlobsRequest("small"))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Attempted to write a total of at least 12 bytes, while a maximum of 10 bytes is permitted"), err)
+
+		_, err = contentAddressableStorageServer.BatchReadBlobs(ctx, newBatchReadBlobsRequest("small"))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Attempted to read a total of at least 12 bytes, while a maximum of 10 bytes is permitted"), err)
+	})
+
+	t.Run("LargeInstanceName", func(t *testing.T) {
+		// The batches fit within the limit of 20 bytes of this
+		// instance name, meaning they should be processed.
+		digest1 := digest.MustNewDigest("large", remoteexecution.DigestFunction_MD5, "bc6e6f16b8a077ef5fbc8d59d0b931b9", 12)
+		digest2 := digest.MustNewDigest("large", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+		contentAddressableStorage.EXPECT().Put(ctx, digest1, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		contentAddressableStorage.EXPECT().Put(ctx, digest2, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		updateResponse, err := contentAddressableStorageServer.BatchUpdateBlobs(ctx, newBatchUpdateBlobsRequest("large"))
+		require.NoError(t, err)
+		require.Len(t, updateResponse.Responses, 2)
+
+		contentAddressableStorage.EXPECT().Get(ctx, digest1).Return(buffer.NewValidatedBufferFromByteSlice(blob1))
+		contentAddressableStorage.EXPECT().Get(ctx, digest2).Return(buffer.NewValidatedBufferFromByteSlice(blob2))
+
+		readResponse, err := contentAddressableStorageServer.BatchReadBlobs(ctx, newBatchReadBlobsRequest("large"))
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.BatchReadBlobsResponse{
+			Responses: []*remoteexecution.BatchReadBlobsResponse_Response{
+				{
+					Digest: &remoteexecution.Digest{
+						Hash:      "bc6e6f16b8a077ef5fbc8d59d0b931b9",
+						SizeBytes: 12,
+					},
+					Data: blob1,
+				},
+				{
+					Digest: &remoteexecution.Digest{
+						Hash:      "6fc422233a40a75a1f028e11c3cd1140",
+						SizeBytes: 7,
+					},
+					Data: blob2,
+				},
+			},
+		}, readResponse)
+	})
+}
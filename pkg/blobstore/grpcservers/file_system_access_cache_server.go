@@ -3,12 +3,16 @@ package grpcservers
 import (
 	"context"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/proto/fsac"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -30,7 +34,7 @@ func NewFileSystemAccessCacheServer(blobAccess blobstore.BlobAccess, maximumMess
 }
 
 func (s *fileSystemAccessCacheServer) GetFileSystemAccessProfile(ctx context.Context, in *fsac.GetFileSystemAccessProfileRequest) (*fsac.FileSystemAccessProfile, error) {
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
@@ -53,7 +57,7 @@ func (s *fileSystemAccessCacheServer) GetFileSystemAccessProfile(ctx context.Con
 }
 
 func (s *fileSystemAccessCacheServer) UpdateFileSystemAccessProfile(ctx context.Context, in *fsac.UpdateFileSystemAccessProfileRequest) (*emptypb.Empty, error) {
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
@@ -71,3 +75,37 @@ func (s *fileSystemAccessCacheServer) UpdateFileSystemAccessProfile(ctx context.
 		digest,
 		buffer.NewProtoBufferFromProto(in.FileSystemAccessProfile, buffer.UserProvided))
 }
+
+func (s *fileSystemAccessCacheServer) FindMissingFileSystemAccessProfiles(ctx context.Context, in *remoteexecution.FindMissingBlobsRequest) (*remoteexecution.FindMissingBlobsResponse, error) {
+	if sizeBytes := proto.Size(in); sizeBytes > s.maximumMessageSizeBytes {
+		return nil, status.Errorf(codes.InvalidArgument, "Request is %d bytes in size, while a maximum of %d bytes is permitted", sizeBytes, s.maximumMessageSizeBytes)
+	}
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(in.DigestFunction, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	inDigests := digest.NewSetBuilder()
+	for _, partialDigest := range in.BlobDigests {
+		digest, err := digestFunction.NewDigestFromProto(partialDigest)
+		if err != nil {
+			return nil, err
+		}
+		inDigests.Add(digest)
+	}
+	outDigests, err := s.blobAccess.FindMissing(ctx, inDigests.Build())
+	if err != nil {
+		return nil, err
+	}
+	partialDigests := make([]*remoteexecution.Digest, 0, outDigests.Length())
+	for _, outDigest := range outDigests.Items() {
+		partialDigests = append(partialDigests, outDigest.GetProto())
+	}
+	return &remoteexecution.FindMissingBlobsResponse{
+		MissingBlobDigests: partialDigests,
+	}, nil
+}
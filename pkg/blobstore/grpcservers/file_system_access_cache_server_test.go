@@ -0,0 +1,97 @@
+package grpcservers_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/grpcservers"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestFileSystemAccessCacheServerFindMissingFileSystemAccessProfiles(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	s := grpcservers.NewFileSystemAccessCacheServer(blobAccess, 100)
+
+	t.Run("RequestTooLarge", func(t *testing.T) {
+		// Requests exceeding the maximum message size should
+		// be rejected without calling into the backend.
+		blobDigests := make([]*remoteexecution.Digest, 0, 3)
+		for i := 0; i < 3; i++ {
+			blobDigests = append(blobDigests, &remoteexecution.Digest{
+				Hash:      "8b1a9953c4611296a827abf8c47804d7",
+				SizeBytes: 5,
+			})
+		}
+		_, err := s.FindMissingFileSystemAccessProfiles(ctx, &remoteexecution.FindMissingBlobsRequest{
+			InstanceName:   "example",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			BlobDigests:    blobDigests,
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Request is 125 bytes in size, while a maximum of 100 bytes is permitted"), err)
+	})
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		blobAccess.EXPECT().FindMissing(
+			ctx,
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5).ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.Internal, "Hardware failure"))
+
+		_, err := s.FindMissingFileSystemAccessProfiles(ctx, &remoteexecution.FindMissingBlobsRequest{
+			InstanceName:   "example",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			BlobDigests: []*remoteexecution.Digest{
+				{
+					Hash:      "8b1a9953c4611296a827abf8c47804d7",
+					SizeBytes: 5,
+				},
+			},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Hardware failure"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Only the entries that are absent should be returned.
+		blobAccess.EXPECT().FindMissing(
+			ctx,
+			digest.NewSetBuilder().
+				Add(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)).
+				Add(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)).
+				Build()).
+			Return(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7).ToSingletonSet(), nil)
+
+		response, err := s.FindMissingFileSystemAccessProfiles(ctx, &remoteexecution.FindMissingBlobsRequest{
+			InstanceName:   "example",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			BlobDigests: []*remoteexecution.Digest{
+				{
+					Hash:      "8b1a9953c4611296a827abf8c47804d7",
+					SizeBytes: 5,
+				},
+				{
+					Hash:      "6fc422233a40a75a1f028e11c3cd1140",
+					SizeBytes: 7,
+				},
+			},
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.FindMissingBlobsResponse{
+			MissingBlobDigests: []*remoteexecution.Digest{
+				{
+					Hash:      "6fc422233a40a75a1f028e11c3cd1140",
+					SizeBytes: 7,
+				},
+			},
+		}, response)
+	})
+}
@@ -0,0 +1,65 @@
+package grpcservers
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// InFlightBytesLimiter limits the total amount of data that Write()
+// calls of the ByteStream server may hand to the storage backend before
+// the backend has finished storing it. Capacity acquired by a Write()
+// call is only released after the backend's Put() call returns, meaning
+// that the limit accounts for data that is buffered by the backend
+// itself.
+//
+// A single InFlightBytesLimiter may be shared by the ByteStream servers
+// of all listeners, so that the limit applies to the process as a
+// whole. A nil InFlightBytesLimiter may be used to disable limiting
+// entirely.
+type InFlightBytesLimiter struct {
+	semaphore    *semaphore.Weighted
+	maximumBytes int64
+}
+
+// NewInFlightBytesLimiter creates an InFlightBytesLimiter that permits
+// up to maximumBytes bytes of data to be in flight.
+func NewInFlightBytesLimiter(maximumBytes int64) *InFlightBytesLimiter {
+	return &InFlightBytesLimiter{
+		semaphore:    semaphore.NewWeighted(maximumBytes),
+		maximumBytes: maximumBytes,
+	}
+}
+
+// acquire capacity for a Write() call of a blob that is sizeBytes in
+// size. The amount of capacity that was acquired is returned.
+//
+// Capacity for the entire blob is acquired at once, before any data is
+// handed to the backend. Acquiring it incrementally while receiving
+// data would permit concurrent Write() calls to each hold part of the
+// capacity while waiting for the others to release theirs, causing
+// them to deadlock. A single call never acquires more capacity than
+// the limit. Once it holds the full limit, no other data can be in
+// flight, meaning blobs larger than the limit can still be uploaded.
+func (l *InFlightBytesLimiter) acquire(ctx context.Context, sizeBytes int64) (int64, error) {
+	if l == nil {
+		return 0, nil
+	}
+	sizeBytes = min(sizeBytes, l.maximumBytes)
+	if sizeBytes <= 0 {
+		return 0, nil
+	}
+	if err := l.semaphore.Acquire(ctx, sizeBytes); err != nil {
+		return 0, util.StatusFromContext(ctx)
+	}
+	return sizeBytes, nil
+}
+
+// release capacity that was previously acquired.
+func (l *InFlightBytesLimiter) release(sizeBytes int64) {
+	if sizeBytes > 0 {
+		l.semaphore.Release(sizeBytes)
+	}
+}
@@ -30,7 +30,7 @@ func NewIndirectContentAddressableStorageServer(blobAccess blobstore.BlobAccess,
 }
 
 func (s *indirectContentAddressableStorageServer) FindMissingReferences(ctx context.Context, in *remoteexecution.FindMissingBlobsRequest) (*remoteexecution.FindMissingBlobsResponse, error) {
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
@@ -61,7 +61,7 @@ func (s *indirectContentAddressableStorageServer) FindMissingReferences(ctx cont
 }
 
 func (s *indirectContentAddressableStorageServer) BatchUpdateReferences(ctx context.Context, in *icas.BatchUpdateReferencesRequest) (*remoteexecution.BatchUpdateBlobsResponse, error) {
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
@@ -91,7 +91,7 @@ func (s *indirectContentAddressableStorageServer) BatchUpdateReferences(ctx cont
 }
 
 func (s *indirectContentAddressableStorageServer) GetReference(ctx context.Context, in *icas.GetReferenceRequest) (*icas.Reference, error) {
-	instanceName, err := digest.NewInstanceName(in.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(in.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", in.InstanceName)
 	}
@@ -0,0 +1,94 @@
+package blobstore
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type instanceNameAliasingBlobAccess struct {
+	BlobAccess
+	aliasesTrie *digest.InstanceNameTrie
+	patchers    []digest.InstanceNamePatcher
+}
+
+// NewInstanceNameAliasingBlobAccess creates a decorator for BlobAccess
+// that rewrites instance names, so that multiple instance names may
+// refer to the same logical cache. The provided map is keyed by alias.
+// Its values contain the canonical instance name to use instead.
+//
+// Aliases are matched by prefix, where the longest matching alias is
+// used. For example, if "ci-legacy" is an alias of "ci", requests for
+// instance name "ci-legacy/linux" are forwarded to the backend with
+// instance name "ci/linux". Instance names that don't match any of the
+// aliases are forwarded as is.
+//
+// Results of FindMissing() are translated back to the instance names
+// used by the caller.
+func NewInstanceNameAliasingBlobAccess(base BlobAccess, aliases map[digest.InstanceName]digest.InstanceName) BlobAccess {
+	ba := &instanceNameAliasingBlobAccess{
+		BlobAccess:  base,
+		aliasesTrie: digest.NewInstanceNameTrie(),
+		patchers:    make([]digest.InstanceNamePatcher, 0, len(aliases)),
+	}
+	for alias, canonical := range aliases {
+		ba.aliasesTrie.Set(alias, len(ba.patchers))
+		ba.patchers = append(ba.patchers, digest.NewInstanceNamePatcher(alias, canonical))
+	}
+	return ba
+}
+
+func (ba *instanceNameAliasingBlobAccess) getPatcher(instanceName digest.InstanceName) digest.InstanceNamePatcher {
+	if idx := ba.aliasesTrie.GetLongestPrefix(instanceName); idx >= 0 {
+		return ba.patchers[idx]
+	}
+	return digest.NoopInstanceNamePatcher
+}
+
+func (ba *instanceNameAliasingBlobAccess) patchDigest(blobDigest digest.Digest) digest.Digest {
+	return ba.getPatcher(blobDigest.GetInstanceName()).PatchDigest(blobDigest)
+}
+
+func (ba *instanceNameAliasingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	return ba.BlobAccess.Get(ctx, ba.patchDigest(blobDigest))
+}
+
+func (ba *instanceNameAliasingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return ba.BlobAccess.GetFromComposite(ctx, ba.patchDigest(parentDigest), ba.patchDigest(childDigest), slicer)
+}
+
+func (ba *instanceNameAliasingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	return ba.BlobAccess.Put(ctx, ba.patchDigest(blobDigest), b)
+}
+
+func (ba *instanceNameAliasingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	// Multiple digests provided by the caller may map to the same
+	// canonical digest. Keep track of all of them, so that every
+	// one of them is reported as missing.
+	originalDigests := map[digest.Digest][]digest.Digest{}
+	canonicalDigests := digest.NewSetBuilder()
+	for _, blobDigest := range digests.Items() {
+		canonicalDigest := ba.patchDigest(blobDigest)
+		originalDigests[canonicalDigest] = append(originalDigests[canonicalDigest], blobDigest)
+		canonicalDigests.Add(canonicalDigest)
+	}
+
+	canonicalMissing, err := ba.BlobAccess.FindMissing(ctx, canonicalDigests.Build())
+	if err != nil {
+		return digest.EmptySet, err
+	}
+	missing := digest.NewSetBuilder()
+	for _, canonicalDigest := range canonicalMissing.Items() {
+		for _, blobDigest := range originalDigests[canonicalDigest] {
+			missing.Add(blobDigest)
+		}
+	}
+	return missing.Build(), nil
+}
+
+func (ba *instanceNameAliasingBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	return ba.BlobAccess.GetCapabilities(ctx, ba.getPatcher(instanceName).PatchInstanceName(instanceName))
+}
@@ -0,0 +1,112 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestInstanceNameAliasingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Let the backend store blobs in a map, so that we can
+	// validate that data written under one alias can be read back
+	// under another.
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	contents := map[digest.Digest][]byte{}
+	baseBlobAccess.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+			data, err := b.ToByteSlice(100)
+			if err != nil {
+				return err
+			}
+			contents[blobDigest] = data
+			return nil
+		}).AnyTimes()
+	baseBlobAccess.EXPECT().Get(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+			data, ok := contents[blobDigest]
+			if !ok {
+				return buffer.NewBufferFromError(status.Errorf(codes.NotFound, "Blob %s not found", blobDigest))
+			}
+			return buffer.NewValidatedBufferFromByteSlice(data)
+		}).AnyTimes()
+	baseBlobAccess.EXPECT().FindMissing(ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+			missing := digest.NewSetBuilder()
+			for _, blobDigest := range digests.Items() {
+				if _, ok := contents[blobDigest]; !ok {
+					missing.Add(blobDigest)
+				}
+			}
+			return missing.Build(), nil
+		}).AnyTimes()
+
+	blobAccess := blobstore.NewInstanceNameAliasingBlobAccess(
+		baseBlobAccess,
+		map[digest.InstanceName]digest.InstanceName{
+			digest.MustNewInstanceName("ci-legacy"): digest.MustNewInstanceName("ci"),
+			digest.MustNewInstanceName("ci-old"):    digest.MustNewInstanceName("ci"),
+		})
+
+	newDigest := func(instanceName string) digest.Digest {
+		return digest.MustNewDigest(instanceName, remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	}
+
+	t.Run("ReadAfterWriteAcrossAliases", func(t *testing.T) {
+		// A blob written under one alias should be stored under
+		// the canonical instance name, and be readable through
+		// any of the other aliases.
+		require.NoError(t, blobAccess.Put(ctx, newDigest("ci-legacy/linux"), buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.Equal(t, map[digest.Digest][]byte{newDigest("ci/linux"): []byte("Hello")}, contents)
+
+		for _, instanceName := range []string{"ci/linux", "ci-legacy/linux", "ci-old/linux"} {
+			data, err := blobAccess.Get(ctx, newDigest(instanceName)).ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("Hello"), data)
+		}
+
+		// Instance names that don't match any of the aliases
+		// should be left alone.
+		_, err := blobAccess.Get(ctx, newDigest("ci-legacy2/linux")).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Blob 3-8b1a9953c4611296a827abf8c47804d7-5-ci-legacy2/linux not found"), err)
+	})
+
+	t.Run("FindMissingAcrossAliases", func(t *testing.T) {
+		// Results should be consistent across aliases, and be
+		// reported using the instance names provided by the
+		// caller. Digests that map to the same canonical digest
+		// should all be reported.
+		missing, err := blobAccess.FindMissing(
+			ctx,
+			digest.NewSetBuilder().
+				Add(newDigest("ci/linux")).
+				Add(newDigest("ci-legacy/linux")).
+				Add(newDigest("ci-old/linux")).
+				Add(newDigest("ci-legacy/windows")).
+				Add(newDigest("ci-old/windows")).
+				Add(newDigest("other")).
+				Build())
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			digest.NewSetBuilder().
+				Add(newDigest("ci-legacy/windows")).
+				Add(newDigest("ci-old/windows")).
+				Add(newDigest("other")).
+				Build(),
+			missing)
+	})
+}
@@ -0,0 +1,260 @@
+package blobstore
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The compact encoding of PreviousExecutionStats starts with a zero
+// byte. As zero is not a valid Protobuf field tag, this allows
+// distinguishing it from the regular Protobuf encoding.
+const (
+	isccCompactEncodingMarker    = 0x00
+	isccCompactEncodingVersion1  = 0x01
+	isccCompactOutcomeFailed     = 0x00
+	isccCompactOutcomeTimedOut   = 0x01
+	isccCompactOutcomeSucceeded  = 0x02
+	isccCompactLastSeenFailure   = 0x01
+	isccCompactNoLastSeenFailure = 0x00
+)
+
+// MarshalCompactPreviousExecutionStats converts a PreviousExecutionStats
+// message to a compact binary encoding. Compared to the Protobuf
+// encoding, it has less per-entry overhead, which is significant
+// considering that these messages consist of many small entries.
+//
+// Durations and timestamps are stored with nanosecond precision.
+// Durations that cannot be represented as a time.Duration are clamped.
+func MarshalCompactPreviousExecutionStats(stats *iscc.PreviousExecutionStats) ([]byte, error) {
+	sizeClasses := make([]uint32, 0, len(stats.SizeClasses))
+	for sizeClass := range stats.SizeClasses {
+		sizeClasses = append(sizeClasses, sizeClass)
+	}
+	sort.Slice(sizeClasses, func(i, j int) bool { return sizeClasses[i] < sizeClasses[j] })
+
+	data := []byte{isccCompactEncodingMarker, isccCompactEncodingVersion1}
+	data = binary.AppendUvarint(data, uint64(len(sizeClasses)))
+	for _, sizeClass := range sizeClasses {
+		perSizeClassStats := stats.SizeClasses[sizeClass]
+		data = binary.AppendUvarint(data, uint64(sizeClass))
+		data = binary.LittleEndian.AppendUint64(data, math.Float64bits(perSizeClassStats.GetInitialPageRankProbability()))
+		previousExecutions := perSizeClassStats.GetPreviousExecutions()
+		data = binary.AppendUvarint(data, uint64(len(previousExecutions)))
+		for i, previousExecution := range previousExecutions {
+			switch outcome := previousExecution.Outcome.(type) {
+			case *iscc.PreviousExecution_Failed:
+				data = append(data, isccCompactOutcomeFailed)
+			case *iscc.PreviousExecution_TimedOut:
+				data = append(data, isccCompactOutcomeTimedOut)
+				data = binary.AppendVarint(data, int64(outcome.TimedOut.AsDuration()))
+			case *iscc.PreviousExecution_Succeeded:
+				data = append(data, isccCompactOutcomeSucceeded)
+				data = binary.AppendVarint(data, int64(outcome.Succeeded.AsDuration()))
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, "Previous execution at index %d of size class %d has an unknown outcome", i, sizeClass)
+			}
+		}
+	}
+
+	if lastSeenFailure := stats.LastSeenFailure; lastSeenFailure != nil {
+		data = append(data, isccCompactLastSeenFailure)
+		data = binary.AppendVarint(data, lastSeenFailure.Seconds)
+		data = binary.AppendVarint(data, int64(lastSeenFailure.Nanos))
+	} else {
+		data = append(data, isccCompactNoLastSeenFailure)
+	}
+	return data, nil
+}
+
+type isccCompactDecoder struct {
+	data []byte
+}
+
+var errISCCCompactTruncated = status.Error(codes.InvalidArgument, "Compact encoding is truncated")
+
+func (d *isccCompactDecoder) readByte() (byte, error) {
+	if len(d.data) < 1 {
+		return 0, errISCCCompactTruncated
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b, nil
+}
+
+func (d *isccCompactDecoder) readUvarint() (uint64, error) {
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		return 0, errISCCCompactTruncated
+	}
+	d.data = d.data[n:]
+	return v, nil
+}
+
+func (d *isccCompactDecoder) readVarint() (int64, error) {
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		return 0, errISCCCompactTruncated
+	}
+	d.data = d.data[n:]
+	return v, nil
+}
+
+func (d *isccCompactDecoder) readFloat64() (float64, error) {
+	if len(d.data) < 8 {
+		return 0, errISCCCompactTruncated
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.data))
+	d.data = d.data[8:]
+	return v, nil
+}
+
+func (d *isccCompactDecoder) readPreviousExecution() (*iscc.PreviousExecution, error) {
+	outcome, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
+	switch outcome {
+	case isccCompactOutcomeFailed:
+		return &iscc.PreviousExecution{
+			Outcome: &iscc.PreviousExecution_Failed{Failed: &emptypb.Empty{}},
+		}, nil
+	case isccCompactOutcomeTimedOut:
+		duration, err := d.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		return &iscc.PreviousExecution{
+			Outcome: &iscc.PreviousExecution_TimedOut{TimedOut: durationpb.New(time.Duration(duration))},
+		}, nil
+	case isccCompactOutcomeSucceeded:
+		duration, err := d.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		return &iscc.PreviousExecution{
+			Outcome: &iscc.PreviousExecution_Succeeded{Succeeded: durationpb.New(time.Duration(duration))},
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unknown outcome %d", outcome)
+	}
+}
+
+func (d *isccCompactDecoder) readPreviousExecutionStats() (*iscc.PreviousExecutionStats, error) {
+	sizeClassesCount, err := d.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	// Each size class takes up at least ten bytes. Reject counts
+	// that cannot be valid before allocating any memory.
+	if sizeClassesCount > uint64(len(d.data)) {
+		return nil, errISCCCompactTruncated
+	}
+	stats := &iscc.PreviousExecutionStats{
+		SizeClasses: make(map[uint32]*iscc.PerSizeClassStats, sizeClassesCount),
+	}
+	for i := uint64(0); i < sizeClassesCount; i++ {
+		sizeClass, err := d.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if sizeClass > math.MaxUint32 {
+			return nil, status.Errorf(codes.InvalidArgument, "Size class %d is out of range", sizeClass)
+		}
+		initialPageRankProbability, err := d.readFloat64()
+		if err != nil {
+			return nil, err
+		}
+		previousExecutionsCount, err := d.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+		if previousExecutionsCount > uint64(len(d.data)) {
+			return nil, errISCCCompactTruncated
+		}
+		previousExecutions := make([]*iscc.PreviousExecution, 0, previousExecutionsCount)
+		for j := uint64(0); j < previousExecutionsCount; j++ {
+			previousExecution, err := d.readPreviousExecution()
+			if err != nil {
+				return nil, err
+			}
+			previousExecutions = append(previousExecutions, previousExecution)
+		}
+		stats.SizeClasses[uint32(sizeClass)] = &iscc.PerSizeClassStats{
+			PreviousExecutions:         previousExecutions,
+			InitialPageRankProbability: initialPageRankProbability,
+		}
+	}
+
+	hasLastSeenFailure, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
+	switch hasLastSeenFailure {
+	case isccCompactNoLastSeenFailure:
+	case isccCompactLastSeenFailure:
+		seconds, err := d.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		nanos, err := d.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		if nanos < math.MinInt32 || nanos > math.MaxInt32 {
+			return nil, status.Error(codes.InvalidArgument, "Last seen failure timestamp is out of range")
+		}
+		stats.LastSeenFailure = &timestamppb.Timestamp{Seconds: seconds, Nanos: int32(nanos)}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid last seen failure marker %d", hasLastSeenFailure)
+	}
+
+	if len(d.data) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Compact encoding contains %d bytes of trailing data", len(d.data))
+	}
+	return stats, nil
+}
+
+// UnmarshalPreviousExecutionStats converts the binary representation
+// of a PreviousExecutionStats message back to its original form. Both
+// the regular Protobuf encoding and the compact encoding generated by
+// MarshalCompactPreviousExecutionStats() are accepted, optionally
+// compressed by ZstdCompressingBlobAccess.
+func UnmarshalPreviousExecutionStats(data []byte) (*iscc.PreviousExecutionStats, error) {
+	if isZstdCompressedEncoding(data) {
+		decompressed, err := unmarshalZstdCompressedEncoding(data)
+		if err != nil {
+			return nil, err
+		}
+		data = decompressed
+	}
+
+	if len(data) == 0 || data[0] != isccCompactEncodingMarker {
+		var stats iscc.PreviousExecutionStats
+		if err := proto.Unmarshal(data, &stats); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Failed to unmarshal message: %s", err)
+		}
+		return &stats, nil
+	}
+
+	if len(data) < 2 {
+		return nil, errISCCCompactTruncated
+	}
+	switch version := data[1]; version {
+	case isccCompactEncodingVersion1:
+		d := isccCompactDecoder{data: data[2:]}
+		return d.readPreviousExecutionStats()
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported compact encoding version %d", version)
+	}
+}
@@ -0,0 +1,44 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type isccCompactEncodingBlobAccess struct {
+	BlobAccess
+	maximumMessageSizeBytes int
+}
+
+// NewISCCCompactEncodingBlobAccess creates a decorator for BlobAccess
+// that converts PreviousExecutionStats messages that are written into
+// the Initial Size Class Cache (ISCC) to the compact encoding generated
+// by MarshalCompactPreviousExecutionStats(). This reduces the amount of
+// space these messages occupy in storage.
+//
+// This decorator should be placed directly on top of backends that
+// store data, such as LocalBlobAccess. As ISCCReadBufferFactory is
+// capable of decoding both the compact and the regular Protobuf
+// encoding, existing entries remain readable.
+func NewISCCCompactEncodingBlobAccess(base BlobAccess, maximumMessageSizeBytes int) BlobAccess {
+	return &isccCompactEncodingBlobAccess{
+		BlobAccess:              base,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+	}
+}
+
+func (ba *isccCompactEncodingBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	m, err := b.ToProto(&iscc.PreviousExecutionStats{}, ba.maximumMessageSizeBytes)
+	if err != nil {
+		return err
+	}
+	data, err := MarshalCompactPreviousExecutionStats(m.(*iscc.PreviousExecutionStats))
+	if err != nil {
+		return util.StatusWrap(err, "Failed to marshal previous execution stats")
+	}
+	return ba.BlobAccess.Put(ctx, digest, buffer.NewValidatedBufferFromByteSlice(data))
+}
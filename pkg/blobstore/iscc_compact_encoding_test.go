@@ -0,0 +1,163 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.uber.org/mock/gomock"
+)
+
+var examplePreviousExecutionStats = &iscc.PreviousExecutionStats{
+	SizeClasses: map[uint32]*iscc.PerSizeClassStats{
+		1: {
+			PreviousExecutions: []*iscc.PreviousExecution{
+				{Outcome: &iscc.PreviousExecution_Failed{Failed: &emptypb.Empty{}}},
+				{Outcome: &iscc.PreviousExecution_TimedOut{TimedOut: durationpb.New(15 * time.Minute)}},
+			},
+			InitialPageRankProbability: 0.25,
+		},
+		8: {
+			PreviousExecutions: []*iscc.PreviousExecution{
+				{Outcome: &iscc.PreviousExecution_Succeeded{Succeeded: durationpb.New(1500 * time.Millisecond)}},
+				{Outcome: &iscc.PreviousExecution_Succeeded{Succeeded: durationpb.New(1234567891 * time.Nanosecond)}},
+			},
+			InitialPageRankProbability: 0.75,
+		},
+	},
+	LastSeenFailure: &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 123456789},
+}
+
+func TestISCCCompactEncoding(t *testing.T) {
+	t.Run("RoundTrip", func(t *testing.T) {
+		data, err := blobstore.MarshalCompactPreviousExecutionStats(examplePreviousExecutionStats)
+		require.NoError(t, err)
+
+		protoData, err := proto.Marshal(examplePreviousExecutionStats)
+		require.NoError(t, err)
+		require.Less(t, len(data), len(protoData))
+
+		stats, err := blobstore.UnmarshalPreviousExecutionStats(data)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, examplePreviousExecutionStats, stats)
+	})
+
+	t.Run("RoundTripEmpty", func(t *testing.T) {
+		data, err := blobstore.MarshalCompactPreviousExecutionStats(&iscc.PreviousExecutionStats{})
+		require.NoError(t, err)
+
+		stats, err := blobstore.UnmarshalPreviousExecutionStats(data)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &iscc.PreviousExecutionStats{}, stats)
+	})
+
+	t.Run("Legacy", func(t *testing.T) {
+		// Entries that were written using the Protobuf encoding
+		// should still be readable.
+		data, err := proto.Marshal(examplePreviousExecutionStats)
+		require.NoError(t, err)
+
+		stats, err := blobstore.UnmarshalPreviousExecutionStats(data)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, examplePreviousExecutionStats, stats)
+	})
+
+	t.Run("UnsupportedVersion", func(t *testing.T) {
+		_, err := blobstore.UnmarshalPreviousExecutionStats([]byte{0x00, 0x03})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Unsupported compact encoding version 3"), err)
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		data, err := blobstore.MarshalCompactPreviousExecutionStats(examplePreviousExecutionStats)
+		require.NoError(t, err)
+
+		_, err = blobstore.UnmarshalPreviousExecutionStats(data[:len(data)-1])
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Compact encoding is truncated"), err)
+	})
+}
+
+func TestISCCReadBufferFactory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "e0ebc0bc7ab3f4d3d2b6b8bc6ee3b8b40b5d1dc4ad8b7ab1a3e6c8d9e0f1a2b3", 100)
+
+	t.Run("Compact", func(t *testing.T) {
+		data, err := blobstore.MarshalCompactPreviousExecutionStats(examplePreviousExecutionStats)
+		require.NoError(t, err)
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		m, err := blobstore.ISCCReadBufferFactory.NewBufferFromByteSlice(blobDigest, data, dataIntegrityCallback.Call).
+			ToProto(&iscc.PreviousExecutionStats{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, examplePreviousExecutionStats, m)
+	})
+
+	t.Run("Legacy", func(t *testing.T) {
+		data, err := proto.Marshal(examplePreviousExecutionStats)
+		require.NoError(t, err)
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		m, err := blobstore.ISCCReadBufferFactory.NewBufferFromByteSlice(blobDigest, data, dataIntegrityCallback.Call).
+			ToProto(&iscc.PreviousExecutionStats{}, 10000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, examplePreviousExecutionStats, m)
+	})
+
+	t.Run("Corrupted", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(false)
+
+		_, err := blobstore.ISCCReadBufferFactory.NewBufferFromByteSlice(blobDigest, []byte{0x00, 0x01, 0x05}, dataIntegrityCallback.Call).
+			ToProto(&iscc.PreviousExecutionStats{}, 10000)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Failed to decode previous execution stats: Compact encoding is truncated"), err)
+	})
+}
+
+func TestISCCCompactEncodingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewISCCCompactEncodingBlobAccess(baseBlobAccess, 10000)
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "e0ebc0bc7ab3f4d3d2b6b8bc6ee3b8b40b5d1dc4ad8b7ab1a3e6c8d9e0f1a2b3", 100)
+
+	t.Run("Success", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(10000)
+				require.NoError(t, err)
+				stats, err := blobstore.UnmarshalPreviousExecutionStats(data)
+				require.NoError(t, err)
+				require.Equal(t, byte(0x00), data[0])
+				testutil.RequireEqualProto(t, examplePreviousExecutionStats, stats)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(examplePreviousExecutionStats, buffer.UserProvided)))
+	})
+
+	t.Run("InvalidMessage", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Server offline"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline"))))
+	})
+}
@@ -6,19 +6,34 @@ import (
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/digest"
-	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/proto"
 )
 
 type isccReadBufferFactory struct{}
 
 func (f isccReadBufferFactory) NewBufferFromByteSlice(digest digest.Digest, data []byte, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
-	return buffer.NewProtoBufferFromByteSlice(&iscc.PreviousExecutionStats{}, data, buffer.BackendProvided(dataIntegrityCallback))
+	// Entries may either be stored using the regular Protobuf
+	// encoding, or using the compact encoding. Decode them up front,
+	// so that consumers always observe a PreviousExecutionStats
+	// message.
+	stats, err := UnmarshalPreviousExecutionStats(data)
+	if err != nil {
+		dataIntegrityCallback(false)
+		return buffer.NewBufferFromError(util.StatusWrapWithCode(err, codes.Internal, "Failed to decode previous execution stats"))
+	}
+	return buffer.NewProtoBufferFromProto(stats, buffer.BackendProvided(dataIntegrityCallback))
 }
 
 func (f isccReadBufferFactory) NewBufferFromReader(digest digest.Digest, r io.ReadCloser, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
-	return buffer.NewProtoBufferFromReader(&iscc.PreviousExecutionStats{}, r, buffer.BackendProvided(dataIntegrityCallback))
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return f.NewBufferFromByteSlice(digest, data, dataIntegrityCallback)
 }
 
 func (f isccReadBufferFactory) NewBufferFromReaderAt(digest digest.Digest, r buffer.ReadAtCloser, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
@@ -26,7 +41,11 @@ func (f isccReadBufferFactory) NewBufferFromReaderAt(digest digest.Digest, r buf
 }
 
 // ISCCReadBufferFactory is capable of creating identifiers and buffers
-// for objects stored in the Initial Size Class Cache (ISCC).
+// for objects stored in the Initial Size Class Cache (ISCC). Objects
+// may be stored either using the regular Protobuf encoding, or using
+// the compact encoding generated by
+// MarshalCompactPreviousExecutionStats(). Either encoding may
+// additionally be compressed by ZstdCompressingBlobAccess.
 var ISCCReadBufferFactory ReadBufferFactory = isccReadBufferFactory{}
 
 // GetReducedActionDigest computes the digest of an Initial Size Class
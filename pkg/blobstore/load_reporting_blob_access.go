@@ -0,0 +1,122 @@
+package blobstore
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// LoadHeaderKey is the name of the gRPC response header in which
+// LoadReportingBlobAccess reports the load factor of the backend. Its
+// value is a decimal number, where 1.0 indicates that the number of
+// operations in flight is equal to the configured capacity.
+const LoadHeaderKey = "x-bb-load"
+
+var (
+	loadReportingBlobAccessPrometheusMetrics sync.Once
+
+	loadReportingBlobAccessLoadFactor = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "load_reporting_blob_access_load_factor",
+			Help:      "Number of operations in flight, divided by the configured capacity",
+		},
+		[]string{"storage_type"})
+)
+
+type loadReportingBlobAccess struct {
+	BlobAccess
+	capacity         float64
+	emitHeader       bool
+	inFlight         atomic.Int64
+	loadFactorMetric prometheus.Gauge
+}
+
+// NewLoadReportingBlobAccess creates a decorator for BlobAccess that
+// tracks the number of operations in flight against the backend, and
+// reports it as a load factor relative to a given capacity. The load
+// factor is exposed as a Prometheus metric. If emitHeader is set, it is
+// also returned to clients as a gRPC response header, allowing them to
+// reduce their request rate when the backend becomes saturated.
+//
+// The header is only emitted if response headers have not been sent
+// yet. For streaming RPCs this means that only the load factor at the
+// time of the first operation is reported.
+func NewLoadReportingBlobAccess(base BlobAccess, capacity int, emitHeader bool, storageType string) BlobAccess {
+	loadReportingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(loadReportingBlobAccessLoadFactor)
+	})
+
+	return &loadReportingBlobAccess{
+		BlobAccess:       base,
+		capacity:         float64(capacity),
+		emitHeader:       emitHeader,
+		loadFactorMetric: loadReportingBlobAccessLoadFactor.WithLabelValues(storageType),
+	}
+}
+
+func (ba *loadReportingBlobAccess) updateInFlight(ctx context.Context, delta int64) {
+	loadFactor := float64(ba.inFlight.Add(delta)) / ba.capacity
+	ba.loadFactorMetric.Set(loadFactor)
+	if delta > 0 && ba.emitHeader {
+		// Errors are ignored, as they merely indicate that
+		// the call is not part of a gRPC server, or that
+		// headers have already been sent.
+		grpc.SetHeader(ctx, metadata.Pairs(LoadHeaderKey, strconv.FormatFloat(loadFactor, 'f', 3, 64)))
+	}
+}
+
+func (ba *loadReportingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	ba.updateInFlight(ctx, 1)
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, blobDigest),
+		&loadReportingErrorHandler{
+			blobAccess: ba,
+			ctx:        ctx,
+		})
+}
+
+func (ba *loadReportingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	ba.updateInFlight(ctx, 1)
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+		&loadReportingErrorHandler{
+			blobAccess: ba,
+			ctx:        ctx,
+		})
+}
+
+func (ba *loadReportingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	ba.updateInFlight(ctx, 1)
+	defer ba.updateInFlight(ctx, -1)
+	return ba.BlobAccess.Put(ctx, blobDigest, b)
+}
+
+func (ba *loadReportingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	ba.updateInFlight(ctx, 1)
+	defer ba.updateInFlight(ctx, -1)
+	return ba.BlobAccess.FindMissing(ctx, digests)
+}
+
+type loadReportingErrorHandler struct {
+	blobAccess *loadReportingBlobAccess
+	ctx        context.Context
+}
+
+func (eh *loadReportingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	return nil, err
+}
+
+func (eh *loadReportingErrorHandler) Done() {
+	eh.blobAccess.updateInFlight(eh.ctx, -1)
+}
@@ -0,0 +1,99 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"go.uber.org/mock/gomock"
+)
+
+// fakeServerTransportStream captures response headers that are set
+// through grpc.SetHeader().
+type fakeServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *fakeServerTransportStream) Method() string {
+	return "/build.bazel.remote.execution.v2.ContentAddressableStorage/FindMissingBlobs"
+}
+
+func (s *fakeServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *fakeServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *fakeServerTransportStream) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+func TestLoadReportingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+	t.Run("HeaderEnabled", func(t *testing.T) {
+		baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+		blobAccess := blobstore.NewLoadReportingBlobAccess(baseBlobAccess, 4, true, "cas")
+
+		// Start two reads without consuming their buffers,
+		// meaning both remain in flight. The load factor
+		// reported to each of the callers should increase.
+		stream1 := &fakeServerTransportStream{}
+		ctx1 := grpc.NewContextWithServerTransportStream(ctx, stream1)
+		baseBlobAccess.EXPECT().Get(ctx1, blobDigest).Return(buffer.NewCASBufferFromReader(blobDigest, io.NopCloser(bytes.NewBufferString("Hello")), buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+		b1 := blobAccess.Get(ctx1, blobDigest)
+		require.Equal(t, []string{"0.250"}, stream1.header.Get(blobstore.LoadHeaderKey))
+
+		stream2 := &fakeServerTransportStream{}
+		ctx2 := grpc.NewContextWithServerTransportStream(ctx, stream2)
+		baseBlobAccess.EXPECT().Get(ctx2, blobDigest).Return(buffer.NewCASBufferFromReader(blobDigest, io.NopCloser(bytes.NewBufferString("Hello")), buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+		b2 := blobAccess.Get(ctx2, blobDigest)
+		require.Equal(t, []string{"0.500"}, stream2.header.Get(blobstore.LoadHeaderKey))
+
+		// Once the buffers are consumed, the load should drop
+		// back to zero.
+		data, err := b1.ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		b2.Discard()
+
+		stream3 := &fakeServerTransportStream{}
+		ctx3 := grpc.NewContextWithServerTransportStream(ctx, stream3)
+		baseBlobAccess.EXPECT().FindMissing(ctx3, blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		missing, err := blobAccess.FindMissing(ctx3, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+		require.Equal(t, []string{"0.250"}, stream3.header.Get(blobstore.LoadHeaderKey))
+	})
+
+	t.Run("HeaderDisabled", func(t *testing.T) {
+		baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+		blobAccess := blobstore.NewLoadReportingBlobAccess(baseBlobAccess, 4, false, "cas")
+
+		stream := &fakeServerTransportStream{}
+		ctxWithStream := grpc.NewContextWithServerTransportStream(ctx, stream)
+		baseBlobAccess.EXPECT().Put(ctxWithStream, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		require.NoError(t, blobAccess.Put(ctxWithStream, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.Empty(t, stream.header)
+	})
+}
@@ -0,0 +1,252 @@
+package local
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	local_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	blobPinnerPrometheusMetrics sync.Once
+
+	blobPinnerPinnedBlobs = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "blob_pinner_pinned_blobs",
+			Help:      "Number of pinned blobs, as observed during the last refresh",
+		},
+		[]string{"storage_type", "state"})
+)
+
+// BlockRotationSource is implemented by LocationBlobMaps that can
+// report when blocks are rotated, causing the blobs contained within to
+// be at risk of being released.
+type BlockRotationSource interface {
+	GetBlockRotationWakeup() <-chan struct{}
+}
+
+// BlobPinner is a decorator for BlobAccess that can be used to exempt
+// a set of objects stored in a local storage backend from eviction.
+// This is useful for objects that are used by every build (e.g.,
+// toolchains), which would otherwise cause builds to stall when
+// evicted.
+//
+// Pinning works by calling FindMissing() against the local storage
+// backend for all pinned objects every time a block is moved into the
+// "old" group. This causes pinned objects stored in that block to be
+// copied into "new" blocks before the block is released. As block
+// rotations are caused by writes, this check is performed as part of
+// Put(), meaning that writes causing blocks to be rotated block until
+// pinned objects have been refreshed.
+//
+// Block rotations may also be caused by objects being refreshed as
+// part of Get(). These are picked up by the next call to Put().
+type BlobPinner struct {
+	blobstore.BlobAccess
+	blockRotationSource BlockRotationSource
+	blockRotationLock   *sync.RWMutex
+	errorLogger         util.ErrorLogger
+
+	lock                sync.Mutex
+	pinnedBlobs         *local_pb.PinnedBlobs
+	digests             digest.Set
+	blockRotationWakeup <-chan struct{}
+
+	presentBlobsMetric prometheus.Gauge
+	missingBlobsMetric prometheus.Gauge
+}
+
+// NewBlobPinner creates a BlobPinner that initially has no pinned
+// objects. The lock that is provided must be the one that protects the
+// BlockRotationSource against concurrent access.
+func NewBlobPinner(base blobstore.BlobAccess, blockRotationSource BlockRotationSource, blockRotationLock *sync.RWMutex, errorLogger util.ErrorLogger, storageType string) *BlobPinner {
+	blobPinnerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(blobPinnerPinnedBlobs)
+	})
+
+	blockRotationLock.RLock()
+	blockRotationWakeup := blockRotationSource.GetBlockRotationWakeup()
+	blockRotationLock.RUnlock()
+
+	return &BlobPinner{
+		BlobAccess:          base,
+		blockRotationSource: blockRotationSource,
+		blockRotationLock:   blockRotationLock,
+		errorLogger:         errorLogger,
+
+		pinnedBlobs:         &local_pb.PinnedBlobs{},
+		digests:             digest.EmptySet,
+		blockRotationWakeup: blockRotationWakeup,
+
+		presentBlobsMetric: blobPinnerPinnedBlobs.WithLabelValues(storageType, "Present"),
+		missingBlobsMetric: blobPinnerPinnedBlobs.WithLabelValues(storageType, "Missing"),
+	}
+}
+
+// SetPinnedBlobs replaces the set of objects that are pinned. Objects
+// that are no longer part of the set become subject to regular
+// eviction.
+func (bp *BlobPinner) SetPinnedBlobs(pinnedBlobs *local_pb.PinnedBlobs) error {
+	instanceName, err := digest.NewInstanceName(pinnedBlobs.InstanceName)
+	if err != nil {
+		return util.StatusWrapf(err, "Invalid instance name %#v", pinnedBlobs.InstanceName)
+	}
+	digests := digest.NewSetBuilder()
+	if len(pinnedBlobs.Digests) > 0 {
+		// Infer the digest function from the length of the first
+		// hash if none is provided explicitly.
+		digestFunction, err := instanceName.GetDigestFunction(pinnedBlobs.DigestFunction, len(pinnedBlobs.Digests[0].GetHash()))
+		if err != nil {
+			return err
+		}
+		for i, blobDigest := range pinnedBlobs.Digests {
+			d, err := digestFunction.NewDigestFromProto(blobDigest)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid digest at index %d", i)
+			}
+			digests.Add(d)
+		}
+	}
+
+	bp.lock.Lock()
+	bp.pinnedBlobs = pinnedBlobs
+	bp.digests = digests.Build()
+	bp.lock.Unlock()
+	return nil
+}
+
+// GetPinnedBlobs returns the set of objects that are currently pinned.
+func (bp *BlobPinner) GetPinnedBlobs() *local_pb.PinnedBlobs {
+	bp.lock.Lock()
+	defer bp.lock.Unlock()
+	return bp.pinnedBlobs
+}
+
+// RefreshPinnedBlobs refreshes all pinned objects that are at risk of
+// being evicted. Pinned objects that are absent cannot be refreshed,
+// and are only reported through Prometheus metrics.
+func (bp *BlobPinner) RefreshPinnedBlobs(ctx context.Context) error {
+	bp.lock.Lock()
+	digests := bp.digests.Items()
+	bp.lock.Unlock()
+
+	missingCount := 0
+	for len(digests) > 0 {
+		batchSize := min(len(digests), blobstore.RecommendedFindMissingDigestsCount)
+		batch := digest.NewSetBuilder()
+		for _, blobDigest := range digests[:batchSize] {
+			batch.Add(blobDigest)
+		}
+		missing, err := bp.BlobAccess.FindMissing(ctx, batch.Build())
+		if err != nil {
+			return util.StatusWrap(err, "Failed to refresh pinned blobs")
+		}
+		missingCount += missing.Length()
+		digests = digests[batchSize:]
+	}
+
+	bp.lock.Lock()
+	totalCount := bp.digests.Length()
+	bp.lock.Unlock()
+	bp.presentBlobsMetric.Set(float64(max(totalCount-missingCount, 0)))
+	bp.missingBlobsMetric.Set(float64(missingCount))
+	return nil
+}
+
+// Put an object in the local storage backend. If writing the object
+// caused a block to be rotated, all pinned objects are refreshed before
+// returning.
+func (bp *BlobPinner) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	err := bp.BlobAccess.Put(ctx, blobDigest, b)
+	bp.refreshPinnedBlobsAfterBlockRotation(ctx)
+	return err
+}
+
+func (bp *BlobPinner) refreshPinnedBlobsAfterBlockRotation(ctx context.Context) {
+	bp.lock.Lock()
+	blockRotationWakeup := bp.blockRotationWakeup
+	bp.lock.Unlock()
+	select {
+	case <-blockRotationWakeup:
+	default:
+		// No blocks have been rotated since the last refresh.
+		return
+	}
+
+	// Obtain a new channel before refreshing, so that block
+	// rotations caused by refreshing are detected as well. If
+	// multiple writes observe the same block rotation, only one
+	// of them needs to perform the refresh.
+	bp.blockRotationLock.RLock()
+	newBlockRotationWakeup := bp.blockRotationSource.GetBlockRotationWakeup()
+	bp.blockRotationLock.RUnlock()
+	bp.lock.Lock()
+	if bp.blockRotationWakeup != blockRotationWakeup {
+		bp.lock.Unlock()
+		return
+	}
+	bp.blockRotationWakeup = newBlockRotationWakeup
+	bp.lock.Unlock()
+
+	// Failing to refresh pinned objects should not cause the write
+	// that triggered the refresh to fail, as the object was stored
+	// successfully.
+	if err := bp.RefreshPinnedBlobs(context.WithoutCancel(ctx)); err != nil {
+		bp.errorLogger.Log(err)
+	}
+}
+
+// ServeHTTP exposes the set of pinned objects through HTTP. GET
+// requests return the set of pinned objects in the form of a JSON
+// encoded PinnedBlobs message. PUT requests replace the set of pinned
+// objects.
+func (bp *BlobPinner) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var pinnedBlobs local_pb.PinnedBlobs
+		if err := protojson.Unmarshal(body, &pinnedBlobs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := bp.SetPinnedBlobs(&pinnedBlobs); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// Objects that were pinned just now may already be
+		// stored in "old" blocks.
+		if err := bp.RefreshPinnedBlobs(req.Context()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := protojson.Marshal(proto.Message(bp.GetPinnedBlobs()))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
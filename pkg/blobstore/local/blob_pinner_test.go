@@ -0,0 +1,149 @@
+package local_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	local_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func newBlobPinnerTestBlob(data string) (digest.Digest, []byte) {
+	hash := sha256.Sum256([]byte(data))
+	return digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, hex.EncodeToString(hash[:]), int64(len(data))), []byte(data)
+}
+
+func newBlobPinnerTestBlobAccess() *local.BlobPinner {
+	// Create a small storage backend, where every block can hold
+	// two objects. Writing a couple of objects causes blocks to
+	// be rotated, meaning that objects are evicted quickly.
+	blockList := local.NewVolatileBlockList(local.NewInMemoryBlockAllocator(16))
+	locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewImmutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 1,
+			/* newBlocksCount = */ 1),
+		util.DefaultErrorLogger,
+		"cas",
+		/* blockSizeBytes = */ 16,
+		/* oldBlocksCount = */ 2,
+		/* newBlocksCount = */ 1,
+		/* initialBlocksCount = */ 0,
+		/* readRefreshCurrentBlocksCount = */ 0)
+	locationRecordArray := local.NewInMemoryLocationRecordArray(101, locationBlobMap)
+	keyLocationMap := local.NewHashingKeyLocationMap(
+		locationRecordArray,
+		101,
+		/* hashInitialization = */ 0x5c8e2b4f9a1d3e67,
+		/* maximumGetAttempts = */ 16,
+		/* maximumPutAttempts = */ 64,
+		"cas")
+	var lock sync.RWMutex
+	return local.NewBlobPinner(
+		local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &lock, "cas", nil, nil),
+		locationBlobMap,
+		&lock,
+		util.DefaultErrorLogger,
+		"cas")
+}
+
+func TestBlobPinnerBlockRotation(t *testing.T) {
+	ctx := context.Background()
+
+	blobAccess := newBlobPinnerTestBlobAccess()
+
+	pinnedDigest, pinnedData := newBlobPinnerTestBlob("Pinned!!")
+	unpinnedDigest, unpinnedData := newBlobPinnerTestBlob("Unpinned")
+	require.NoError(t, blobAccess.Put(ctx, pinnedDigest, buffer.NewValidatedBufferFromByteSlice(pinnedData)))
+	require.NoError(t, blobAccess.Put(ctx, unpinnedDigest, buffer.NewValidatedBufferFromByteSlice(unpinnedData)))
+
+	require.NoError(t, blobAccess.SetPinnedBlobs(&local_pb.PinnedBlobs{
+		DigestFunction: remoteexecution.DigestFunction_SHA256,
+		Digests:        []*remoteexecution.Digest{pinnedDigest.GetProto()},
+	}))
+
+	// Write a large number of objects, causing blocks to be
+	// rotated many times. Because these writes cause pinned
+	// objects to be refreshed, they should be retained without
+	// any explicit calls to RefreshPinnedBlobs().
+	for i := 0; i < 100; i++ {
+		fillerDigest, fillerData := newBlobPinnerTestBlob(fmt.Sprintf("Fill%04d", i))
+		require.NoError(t, blobAccess.Put(ctx, fillerDigest, buffer.NewValidatedBufferFromByteSlice(fillerData)))
+	}
+
+	missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(pinnedDigest).Add(unpinnedDigest).Build())
+	require.NoError(t, err)
+	require.Equal(t, unpinnedDigest.ToSingletonSet(), missing)
+
+	data, err := blobAccess.Get(ctx, pinnedDigest).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, pinnedData, data)
+
+	// Once unpinned, the object should be evicted like any other.
+	require.NoError(t, blobAccess.SetPinnedBlobs(&local_pb.PinnedBlobs{}))
+	for i := 0; i < 100; i++ {
+		fillerDigest, fillerData := newBlobPinnerTestBlob(fmt.Sprintf("Fill%04d", i+100))
+		require.NoError(t, blobAccess.Put(ctx, fillerDigest, buffer.NewValidatedBufferFromByteSlice(fillerData)))
+	}
+
+	missing, err = blobAccess.FindMissing(ctx, pinnedDigest.ToSingletonSet())
+	require.NoError(t, err)
+	require.Equal(t, pinnedDigest.ToSingletonSet(), missing)
+}
+
+func TestBlobPinnerSetPinnedBlobs(t *testing.T) {
+	blobPinner := newBlobPinnerTestBlobAccess()
+
+	t.Run("InvalidDigest", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Invalid digest at index 0: Hash has length 3, while 64 characters were expected"),
+			blobPinner.SetPinnedBlobs(&local_pb.PinnedBlobs{
+				DigestFunction: remoteexecution.DigestFunction_SHA256,
+				Digests: []*remoteexecution.Digest{
+					{Hash: "abc", SizeBytes: 3},
+				},
+			}))
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		// Pinned objects can be replaced through HTTP.
+		body := `{"digestFunction":"SHA256","digests":[{"hash":"185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969","sizeBytes":"5"}]}`
+		w := httptest.NewRecorder()
+		blobPinner.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/pinned_blobs/cas", strings.NewReader(body)))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		w = httptest.NewRecorder()
+		blobPinner.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/pinned_blobs/cas", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		var pinnedBlobs local_pb.PinnedBlobs
+		require.NoError(t, protojson.Unmarshal(w.Body.Bytes(), &pinnedBlobs))
+		testutil.RequireEqualProto(t, &local_pb.PinnedBlobs{
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+			Digests: []*remoteexecution.Digest{
+				{Hash: "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", SizeBytes: 5},
+			},
+		}, &pinnedBlobs)
+
+		w = httptest.NewRecorder()
+		blobPinner.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/pinned_blobs/cas", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
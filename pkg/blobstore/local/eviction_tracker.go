@@ -0,0 +1,183 @@
+package local
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	evictionTrackerPrometheusMetrics sync.Once
+
+	evictionTrackerEvictedBlobsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "eviction_tracker_evicted_blobs_total",
+			Help:      "Number of blobs that were evicted, because the block containing them was released",
+		},
+		[]string{"storage_type", "refreshed"})
+	evictionTrackerEvictedBlobsAgeSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "eviction_tracker_evicted_blobs_age_seconds",
+			Help:      "Amount of time between blobs being stored and being evicted, in seconds",
+			Buckets:   util.DecimalExponentialBuckets(0, 7, 2),
+		},
+		[]string{"storage_type"})
+)
+
+// EvictedBlob contains information on a blob that was removed from
+// storage, because the block containing it was released.
+type EvictedBlob struct {
+	// The digest of the blob, which includes its size.
+	Digest digest.Digest
+	// The amount of time between the blob being stored and it being
+	// evicted.
+	Age time.Duration
+	// Whether the copy of the blob that got evicted was created by
+	// refreshing the blob, as opposed to the blob being written by a
+	// client.
+	Refreshed bool
+}
+
+// EvictionHandler is called into by EvictionTracker for every blob that
+// is evicted. As it is called while locks are held, implementations
+// should not block.
+type EvictionHandler interface {
+	HandleEvictedBlob(evictedBlob EvictedBlob)
+}
+
+type evictionTrackerBlob struct {
+	absoluteBlockIndex uint64
+	digest             digest.Digest
+	storedAt           time.Time
+	refreshed          bool
+}
+
+// EvictionTracker keeps track of which blobs are stored in which
+// blocks, so that events can be emitted when blobs are evicted. Blobs
+// whose most recent copy is stored in a block that is released are
+// considered evicted. Blobs that were refreshed into another block
+// prior to their block being released are not.
+//
+// EvictionTracker retains the digests of all blobs that are stored,
+// meaning that it causes memory usage to be proportional to the number
+// of blobs in storage. Blobs that were already present in storage
+// before the EvictionTracker was created (e.g., because they were
+// restored from persistent state) are not tracked.
+//
+// EvictionTracker is not thread-safe. Calls into it must be made while
+// holding the same write lock that is used to serialize calls to
+// BlockList.PopFront() and LocationBlobPutFinalizer.
+type EvictionTracker struct {
+	clock   clock.Clock
+	handler EvictionHandler
+
+	blocksReleased uint64
+	blocks         map[uint64][]Key
+	blobs          map[Key]evictionTrackerBlob
+
+	evictedBlobsRefreshed    prometheus.Counter
+	evictedBlobsNotRefreshed prometheus.Counter
+	evictedBlobsAgeSeconds   prometheus.Observer
+}
+
+// NewEvictionTracker creates a new EvictionTracker that does not track
+// any blobs. The EvictionHandler is optional. If nil, evictions are
+// only reported through Prometheus metrics.
+func NewEvictionTracker(clock clock.Clock, handler EvictionHandler, storageType string) *EvictionTracker {
+	evictionTrackerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(evictionTrackerEvictedBlobsTotal)
+		prometheus.MustRegister(evictionTrackerEvictedBlobsAgeSeconds)
+	})
+
+	return &EvictionTracker{
+		clock:   clock,
+		handler: handler,
+
+		blocks: map[uint64][]Key{},
+		blobs:  map[Key]evictionTrackerBlob{},
+
+		evictedBlobsRefreshed:    evictionTrackerEvictedBlobsTotal.WithLabelValues(storageType, strconv.FormatBool(true)),
+		evictedBlobsNotRefreshed: evictionTrackerEvictedBlobsTotal.WithLabelValues(storageType, strconv.FormatBool(false)),
+		evictedBlobsAgeSeconds:   evictionTrackerEvictedBlobsAgeSeconds.WithLabelValues(storageType),
+	}
+}
+
+// BlobStored records that a blob has been written to a given location.
+// This replaces any location at which the blob was stored previously.
+func (et *EvictionTracker) BlobStored(key Key, blobDigest digest.Digest, location Location, refreshed bool) {
+	absoluteBlockIndex := et.blocksReleased + uint64(location.BlockIndex)
+	et.blocks[absoluteBlockIndex] = append(et.blocks[absoluteBlockIndex], key)
+	et.blobs[key] = evictionTrackerBlob{
+		absoluteBlockIndex: absoluteBlockIndex,
+		digest:             blobDigest,
+		storedAt:           et.clock.Now(),
+		refreshed:          refreshed,
+	}
+}
+
+// blockReleased is called when the oldest block of the BlockList is
+// released. All blobs whose most recent copy is stored in this block
+// are reported as evicted.
+func (et *EvictionTracker) blockReleased() {
+	absoluteBlockIndex := et.blocksReleased
+	et.blocksReleased++
+	keys, ok := et.blocks[absoluteBlockIndex]
+	if !ok {
+		return
+	}
+	delete(et.blocks, absoluteBlockIndex)
+
+	now := et.clock.Now()
+	for _, key := range keys {
+		blob, ok := et.blobs[key]
+		if !ok || blob.absoluteBlockIndex != absoluteBlockIndex {
+			// Blob was refreshed into another block, or it
+			// was stored in this block multiple times.
+			continue
+		}
+		delete(et.blobs, key)
+
+		age := now.Sub(blob.storedAt)
+		if blob.refreshed {
+			et.evictedBlobsRefreshed.Inc()
+		} else {
+			et.evictedBlobsNotRefreshed.Inc()
+		}
+		et.evictedBlobsAgeSeconds.Observe(age.Seconds())
+		if et.handler != nil {
+			et.handler.HandleEvictedBlob(EvictedBlob{
+				Digest:    blob.digest,
+				Age:       age,
+				Refreshed: blob.refreshed,
+			})
+		}
+	}
+}
+
+type evictionTrackingBlockList struct {
+	BlockList
+	tracker *EvictionTracker
+}
+
+// NewEvictionTrackingBlockList creates a decorator for BlockList that
+// informs an EvictionTracker whenever the oldest block is released.
+func NewEvictionTrackingBlockList(base BlockList, tracker *EvictionTracker) BlockList {
+	return &evictionTrackingBlockList{
+		BlockList: base,
+		tracker:   tracker,
+	}
+}
+
+func (bl *evictionTrackingBlockList) PopFront() {
+	bl.BlockList.PopFront()
+	bl.tracker.blockReleased()
+}
@@ -0,0 +1,116 @@
+package local_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestEvictionTracker(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := mock.NewMockClock(ctrl)
+	now := time.Unix(1000, 0)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+	evictionHandler := mock.NewMockEvictionHandler(ctrl)
+	var evictedBlobs []local.EvictedBlob
+	evictionHandler.EXPECT().HandleEvictedBlob(gomock.Any()).Do(func(evictedBlob local.EvictedBlob) {
+		evictedBlobs = append(evictedBlobs, evictedBlob)
+	}).AnyTimes()
+	evictionTracker := local.NewEvictionTracker(clock, evictionHandler, "eviction_tracker")
+
+	// Create a storage backend that is backed by a small number of
+	// blocks, so that blocks get released frequently.
+	blockList := local.NewEvictionTrackingBlockList(
+		local.NewVolatileBlockList(local.NewInMemoryBlockAllocator(1024)),
+		evictionTracker)
+	locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewImmutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 2,
+			/* newBlocksCount = */ 1),
+		util.DefaultErrorLogger,
+		"eviction_tracker",
+		/* blockSizeBytes = */ 1024,
+		/* oldBlocksCount = */ 1,
+		/* newBlocksCount = */ 1,
+		/* initialBlocksCount = */ 0,
+		/* readRefreshCurrentBlocksCount = */ 0)
+	locationRecordArray := local.NewInMemoryLocationRecordArray(1021, locationBlobMap)
+	keyLocationMap := local.NewHashingKeyLocationMap(
+		locationRecordArray,
+		1021,
+		/* hashInitialization = */ 0x3f1b5c7a92e4d068,
+		/* maximumGetAttempts = */ 16,
+		/* maximumPutAttempts = */ 64,
+		"eviction_tracker")
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "eviction_tracker", nil, evictionTracker)
+
+	random := rand.New(rand.NewSource(42))
+	putRandomBlob := func() digest.Digest {
+		data := make([]byte, 100)
+		random.Read(data)
+		hash := sha256.Sum256(data)
+		blobDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, hex.EncodeToString(hash[:]), int64(len(data)))
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)))
+		now = now.Add(time.Second)
+		return blobDigest
+	}
+	getEvictedBlob := func(blobDigest digest.Digest) (local.EvictedBlob, bool) {
+		for _, evictedBlob := range evictedBlobs {
+			if evictedBlob.Digest == blobDigest {
+				return evictedBlob, true
+			}
+		}
+		return local.EvictedBlob{}, false
+	}
+
+	// Store two blobs, of which only one is read repeatedly. Store
+	// enough other data to cause many blocks to be released.
+	readDigest := putRandomBlob()
+	unreadDigest := putRandomBlob()
+	for i := 0; i < 100; i++ {
+		putRandomBlob()
+		_, err := blobAccess.Get(ctx, readDigest).ToByteSlice(1000)
+		require.NoError(t, err)
+	}
+
+	// The blob that wasn't read should have been reported as
+	// evicted. The blob that was read should not have been
+	// reported, as it got refreshed prior to its block being
+	// released.
+	evictedBlob, ok := getEvictedBlob(unreadDigest)
+	require.True(t, ok)
+	require.False(t, evictedBlob.Refreshed)
+	require.Less(t, time.Duration(0), evictedBlob.Age)
+	_, ok = getEvictedBlob(readDigest)
+	require.False(t, ok)
+
+	// Once the blob is no longer read, it should also be evicted.
+	// As the copy that got evicted was created by refreshing, this
+	// should be reported.
+	for i := 0; i < 100; i++ {
+		putRandomBlob()
+	}
+	evictedBlob, ok = getEvictedBlob(readDigest)
+	require.True(t, ok)
+	require.True(t, evictedBlob.Refreshed)
+
+	missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(readDigest).Add(unreadDigest).Build())
+	require.NoError(t, err)
+	require.Equal(t, digest.NewSetBuilder().Add(readDigest).Add(unreadDigest).Build(), missing)
+}
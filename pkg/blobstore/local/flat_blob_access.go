@@ -58,8 +58,9 @@ type flatBlobAccess struct {
 	locationBlobMap LocationBlobMap
 	digestKeyFormat digest.KeyFormat
 
-	lock        *sync.RWMutex
-	refreshLock sync.Mutex
+	lock            *sync.RWMutex
+	refreshLock     sync.Mutex
+	evictionTracker *EvictionTracker
 
 	refreshesBlobsGet              prometheus.Observer
 	refreshesBlobsGetFromComposite prometheus.Observer
@@ -79,7 +80,10 @@ type flatBlobAccess struct {
 // either ignores the REv2 instance name in digests entirely, or it
 // strongly partitions objects by instance name. It does not introduce
 // any hierarchy.
-func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBlobMap, digestKeyFormat digest.KeyFormat, lock *sync.RWMutex, storageType string, capabilitiesProvider capabilities.Provider) blobstore.BlobAccess {
+//
+// If an EvictionTracker is provided, it is informed of all blobs that
+// are written, so that it can report them once they are evicted.
+func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBlobMap, digestKeyFormat digest.KeyFormat, lock *sync.RWMutex, storageType string, capabilitiesProvider capabilities.Provider, evictionTracker *EvictionTracker) blobstore.BlobAccess {
 	flatBlobAccessPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(flatBlobAccessRefreshesBlobs)
 		prometheus.MustRegister(flatBlobAccessRefreshesDurationSeconds)
@@ -93,6 +97,7 @@ func NewFlatBlobAccess(keyLocationMap KeyLocationMap, locationBlobMap LocationBl
 		locationBlobMap: locationBlobMap,
 		digestKeyFormat: digestKeyFormat,
 		lock:            lock,
+		evictionTracker: evictionTracker,
 
 		refreshesBlobsGet:              flatBlobAccessRefreshesBlobs.WithLabelValues(storageType, "Get"),
 		refreshesBlobsGetFromComposite: flatBlobAccessRefreshesBlobs.WithLabelValues(storageType, "GetFromComposite"),
@@ -113,12 +118,18 @@ func (ba *flatBlobAccess) getKey(digest digest.Digest) Key {
 
 // finalizePut is called to finalize a write to the data store. This
 // method must be called while holding the write lock.
-func (ba *flatBlobAccess) finalizePut(putFinalizer LocationBlobPutFinalizer, key Key) (Location, error) {
+func (ba *flatBlobAccess) finalizePut(putFinalizer LocationBlobPutFinalizer, key Key, blobDigest digest.Digest, refreshed bool) (Location, error) {
 	location, err := putFinalizer()
 	if err != nil {
 		return Location{}, err
 	}
-	return location, ba.keyLocationMap.Put(key, location)
+	if err := ba.keyLocationMap.Put(key, location); err != nil {
+		return location, err
+	}
+	if ba.evictionTracker != nil {
+		ba.evictionTracker.BlobStored(key, blobDigest, location, refreshed)
+	}
+	return location, nil
 }
 
 func (ba *flatBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
@@ -131,7 +142,7 @@ func (ba *flatBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buf
 		ba.lock.RUnlock()
 		return buffer.NewBufferFromError(err)
 	}
-	getter, needsRefresh := ba.locationBlobMap.Get(location)
+	getter, needsRefresh := ba.locationBlobMap.GetForRead(location)
 	if !needsRefresh {
 		// The blob doesn't need to be refreshed, so we can
 		// return its data directly.
@@ -158,7 +169,7 @@ func (ba *flatBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buf
 		ba.lock.Unlock()
 		return buffer.NewBufferFromError(err)
 	}
-	getter, needsRefresh = ba.locationBlobMap.Get(location)
+	getter, needsRefresh = ba.locationBlobMap.GetForRead(location)
 	b := getter(blobDigest)
 	if !needsRefresh {
 		// Some other thread managed to refresh the blob before
@@ -181,7 +192,7 @@ func (ba *flatBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buf
 	return b1.WithTask(func() error {
 		putFinalizer := putWriter(b2)
 		ba.lock.Lock()
-		_, err := ba.finalizePut(putFinalizer, key)
+		_, err := ba.finalizePut(putFinalizer, key, blobDigest, true)
 		if err == nil {
 			ba.refreshesBlobsGet.Observe(1)
 			ba.refreshesBlobsSizeGet.Observe(float64(location.SizeBytes))
@@ -209,12 +220,12 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 		ba.lock.RUnlock()
 		return buffer.NewBufferFromError(err)
 	}
-	if _, needsRefresh := ba.locationBlobMap.Get(parentLocation); !needsRefresh {
+	if _, needsRefresh := ba.locationBlobMap.GetForRead(parentLocation); !needsRefresh {
 		if childLocation, err := ba.keyLocationMap.Get(childKey); err == nil {
 			// The parent object doesn't need to be
 			// refreshed, and the child object exists.
 			// Return the child object immediately.
-			childGetter, _ := ba.locationBlobMap.Get(childLocation)
+			childGetter, _ := ba.locationBlobMap.GetForRead(childLocation)
 			b := childGetter(childDigest)
 			ba.lock.RUnlock()
 			return b
@@ -241,7 +252,7 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 
 	var bParentSlicing buffer.Buffer
 	var putFinalizer LocationBlobPutFinalizer
-	parentGetter, needsRefresh := ba.locationBlobMap.Get(parentLocation)
+	parentGetter, needsRefresh := ba.locationBlobMap.GetForRead(parentLocation)
 	// Add refresh start time
 	refreshStart := time.Now()
 	if needsRefresh {
@@ -264,7 +275,7 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 		if childLocation, err := ba.keyLocationMap.Get(childKey); err == nil {
 			// The parent object was refreshed and sliced in
 			// the meantime.
-			childGetter, _ := ba.locationBlobMap.Get(childLocation)
+			childGetter, _ := ba.locationBlobMap.GetForRead(childLocation)
 			b := childGetter(childDigest)
 			ba.lock.Unlock()
 			return b
@@ -288,7 +299,7 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 	// Complete refreshing in case it was performed.
 	ba.lock.Lock()
 	if needsRefresh {
-		parentLocation, err = ba.finalizePut(putFinalizer, parentKey)
+		parentLocation, err = ba.finalizePut(putFinalizer, parentKey, parentDigest, true)
 		// Add size metric before refresh
 		ba.refreshesBlbosSizeGetFromComposite.Observe(float64(parentLocation.SizeBytes))
 		if err != nil {
@@ -302,7 +313,12 @@ func (ba *flatBlobAccess) GetFromComposite(ctx context.Context, parentDigest, ch
 
 	// Create key-location map entries for each of the slices. This
 	// permits subsequent GetFromComposite() calls to access the
-	// individual parts without any slicing.
+	// individual parts without any slicing. This cannot be done for
+	// parent objects that are stored inline, as the slices don't
+	// have a location of their own.
+	if isInlineLocation(parentLocation) {
+		slices = nil
+	}
 	for i, slice := range slices {
 		if err := ba.keyLocationMap.Put(sliceKeys[i], Location{
 			BlockIndex:  parentLocation.BlockIndex,
@@ -341,7 +357,7 @@ func (ba *flatBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b b
 
 	key := ba.getKey(blobDigest)
 	ba.lock.Lock()
-	_, err = ba.finalizePut(putFinalizer, key)
+	_, err = ba.finalizePut(putFinalizer, key, blobDigest, false)
 	ba.lock.Unlock()
 	return err
 }
@@ -354,33 +370,61 @@ func (ba *flatBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 	}
 
 	// Perform an initial scan to determine which blobs are present
-	// in storage.
+	// in storage. All lookups are performed while holding the read
+	// lock once.
+	//
+	// Multiple digests may map to the same key (e.g., when the
+	// instance name is not part of the key), in which case the
+	// KeyLocationMap is only consulted once. Furthermore, whether a
+	// blob needs to be refreshed only depends on the block in which
+	// it is stored, meaning the LocationBlobMap only needs to be
+	// consulted once per block.
 	type blobToRefresh struct {
 		digest digest.Digest
 		key    Key
 	}
+	type keyLookupResult struct {
+		present      bool
+		needsRefresh bool
+	}
 	var blobsToRefresh []blobToRefresh
+	refreshingDisabled := blobstore.IsRefreshingDisabled(ctx)
 	missing := digest.NewSetBuilder()
+	keyLookupResults := make(map[Key]keyLookupResult, len(keys))
+	needsRefreshByBlockIndex := map[int]bool{}
 	ba.lock.RLock()
 	for i, blobDigest := range digests.Items() {
 		key := keys[i]
-		if location, err := ba.keyLocationMap.Get(key); err == nil {
-			_, needsRefresh := ba.locationBlobMap.Get(location)
-			if needsRefresh {
-				// Blob is present, but it must be
-				// refreshed for it to remain present.
-				// Enqueue it for the second scan.
-				blobsToRefresh = append(blobsToRefresh, blobToRefresh{
-					digest: blobDigest,
-					key:    key,
-				})
+		result, ok := keyLookupResults[key]
+		if !ok {
+			if location, err := ba.keyLocationMap.Get(key); err == nil {
+				needsRefresh, ok := needsRefreshByBlockIndex[location.BlockIndex]
+				if !ok {
+					_, needsRefresh = ba.locationBlobMap.Get(location)
+					needsRefreshByBlockIndex[location.BlockIndex] = needsRefresh
+				}
+				result = keyLookupResult{
+					present:      true,
+					needsRefresh: needsRefresh,
+				}
+			} else if status.Code(err) != codes.NotFound {
+				ba.lock.RUnlock()
+				return digest.EmptySet, util.StatusWrapf(err, "Failed to get blob %#v", blobDigest.String())
 			}
-		} else if status.Code(err) == codes.NotFound {
+			keyLookupResults[key] = result
+		}
+
+		if !result.present {
 			// Blob is absent.
 			missing.Add(blobDigest)
-		} else {
-			ba.lock.RUnlock()
-			return digest.EmptySet, util.StatusWrapf(err, "Failed to get blob %#v", blobDigest.String())
+		} else if result.needsRefresh && !refreshingDisabled {
+			// Blob is present, but it must be refreshed for
+			// it to remain present. Enqueue it for the
+			// second scan.
+			blobsToRefresh = append(blobsToRefresh, blobToRefresh{
+				digest: blobDigest,
+				key:    key,
+			})
 		}
 	}
 	ba.lock.RUnlock()
@@ -423,7 +467,7 @@ func (ba *flatBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (
 				putFinalizer := putWriter(b)
 
 				ba.lock.Lock()
-				if _, err := ba.finalizePut(putFinalizer, blobToRefresh.key); err != nil {
+				if _, err := ba.finalizePut(putFinalizer, blobToRefresh.key, blobToRefresh.digest, true); err != nil {
 					ba.lock.Unlock()
 					return digest.EmptySet, util.StatusWrapf(err, "Failed to refresh blob %#v", blobToRefresh.digest.String())
 				}
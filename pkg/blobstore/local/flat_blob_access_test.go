@@ -2,16 +2,21 @@ package local_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/rand"
 	"sync"
 	"testing"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/grpc/codes"
@@ -26,7 +31,7 @@ func TestFlatBlobAccessGet(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider, nil)
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
 	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
 	location1 := local.Location{
@@ -55,7 +60,7 @@ func TestFlatBlobAccessGet(t *testing.T) {
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter.Call, false)
 		getter.EXPECT().Call(helloDigest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
@@ -72,7 +77,7 @@ func TestFlatBlobAccessGet(t *testing.T) {
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter.Call, true)
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(local.Location{}, status.Error(codes.NotFound, "Blob not found"))
@@ -88,12 +93,12 @@ func TestFlatBlobAccessGet(t *testing.T) {
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter1.Call, true)
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter2.Call, false)
 		getter2.EXPECT().Call(helloDigest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
@@ -109,12 +114,12 @@ func TestFlatBlobAccessGet(t *testing.T) {
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter1.Call, true)
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter2.Call, true)
 		getter2.EXPECT().Call(helloDigest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
@@ -131,12 +136,12 @@ func TestFlatBlobAccessGet(t *testing.T) {
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter1.Call, true)
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter2.Call, true)
 		getter2.EXPECT().Call(helloDigest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
@@ -162,12 +167,12 @@ func TestFlatBlobAccessGet(t *testing.T) {
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter1.Call, true)
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
 		getter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter2.Call, true)
 		getter2.EXPECT().Call(helloDigest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
@@ -197,7 +202,7 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider, nil)
 	parentDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "3e25960a79dbc69b674cd4ec67a72c62", 11)
 	parentKey := local.NewKeyFromString("3-3e25960a79dbc69b674cd4ec67a72c62-11")
 	child1Digest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
@@ -233,7 +238,7 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(local.Location{}, status.Error(codes.Internal, "I/O error"))
@@ -251,12 +256,12 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(location2, nil)
 		childGetter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location2).
+		locationBlobMap.EXPECT().GetForRead(location2).
 			Return(childGetter.Call, true)
 		childGetter.EXPECT().Call(child1Digest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
@@ -273,7 +278,7 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		getter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(getter.Call, true)
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(local.Location{}, status.Error(codes.NotFound, "Blob not found"))
@@ -286,14 +291,14 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter1.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(local.Location{}, status.Error(codes.NotFound, "Blob not found"))
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter2.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(local.Location{}, status.Error(codes.Internal, "I/O error"))
@@ -309,17 +314,17 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter1.Call, true)
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter2.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(location2, nil)
 		childGetter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location2).
+		locationBlobMap.EXPECT().GetForRead(location2).
 			Return(childGetter.Call, true)
 		childGetter.EXPECT().Call(child1Digest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
@@ -336,14 +341,14 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter1.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(local.Location{}, status.Error(codes.NotFound, "Blob not found"))
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter2.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(local.Location{}, status.Error(codes.NotFound, "Blob not found"))
@@ -374,14 +379,14 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter1.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(local.Location{}, status.Error(codes.NotFound, "Blob not found"))
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter2.Call, false)
 		keyLocationMap.EXPECT().Get(child1Key).
 			Return(local.Location{}, status.Error(codes.NotFound, "Blob not found"))
@@ -418,12 +423,12 @@ func TestFlatBlobAccessGetFromComposite(t *testing.T) {
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter1.Call, true)
 		keyLocationMap.EXPECT().Get(parentKey).
 			Return(location1, nil)
 		parentGetter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).
+		locationBlobMap.EXPECT().GetForRead(location1).
 			Return(parentGetter2.Call, true)
 		parentGetter2.EXPECT().Call(parentDigest).
 			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
@@ -475,7 +480,7 @@ func TestFlatBlobAccessPut(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider, nil)
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
 	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
 	location := local.Location{
@@ -550,7 +555,7 @@ func TestFlatBlobAccessFindMissing(t *testing.T) {
 	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
 	locationBlobMap := mock.NewMockLocationBlobMap(ctrl)
 	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
-	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider)
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", capabilitiesProvider, nil)
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
 	helloKey := local.NewKeyFromString("1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-5")
 	location1 := local.Location{
@@ -593,6 +598,52 @@ func TestFlatBlobAccessFindMissing(t *testing.T) {
 		require.Equal(t, digest.EmptySet, missing)
 	})
 
+	t.Run("Phase1SharedLookups", func(t *testing.T) {
+		// As the instance name is not part of the key, the
+		// KeyLocationMap should only be consulted once for
+		// digests that only differ in instance name. The
+		// LocationBlobMap should only be consulted once per
+		// block.
+		otherInstanceHelloDigest := digest.MustNewDigest("other", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+		worldDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "78ae647dc5544d227130a0682a51e30bc7777fbb6d8a8f17007463a3ecd1d524", 5)
+		worldKey := local.NewKeyFromString("1-78ae647dc5544d227130a0682a51e30bc7777fbb6d8a8f17007463a3ecd1d524-5")
+		getter := mock.NewMockLocationBlobGetter(ctrl)
+		keyLocationMap.EXPECT().Get(helloKey).
+			Return(location1, nil)
+		keyLocationMap.EXPECT().Get(worldKey).
+			Return(local.Location{
+				BlockIndex:  7,
+				OffsetBytes: 47,
+				SizeBytes:   5,
+			}, nil)
+		locationBlobMap.EXPECT().Get(location1).
+			Return(getter.Call, false)
+
+		missing, err := blobAccess.FindMissing(
+			ctx,
+			digest.NewSetBuilder().
+				Add(helloDigest).
+				Add(otherInstanceHelloDigest).
+				Add(worldDigest).
+				Build())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+
+	t.Run("Phase1FoundRefreshingDisabled", func(t *testing.T) {
+		// Blobs that need to be refreshed should be reported as
+		// present without being refreshed, if requested.
+		getter := mock.NewMockLocationBlobGetter(ctrl)
+		keyLocationMap.EXPECT().Get(helloKey).
+			Return(location1, nil)
+		locationBlobMap.EXPECT().Get(location1).
+			Return(getter.Call, true)
+
+		missing, err := blobAccess.FindMissing(blobstore.NewContextWithRefreshingDisabled(ctx), helloDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+
 	t.Run("Phase2GetFailure", func(t *testing.T) {
 		keyLocationMap.EXPECT().Get(helloKey).
 			Return(location1, nil)
@@ -718,3 +769,140 @@ func TestFlatBlobAccessFindMissing(t *testing.T) {
 		require.Equal(t, digest.EmptySet, missing)
 	})
 }
+
+func TestFlatBlobAccessFindMissingManyObjects(t *testing.T) {
+	ctx := context.Background()
+
+	// Create a storage backend that spreads objects across many
+	// blocks, while having enough "current" blocks to ensure that
+	// no objects need to be refreshed.
+	blockList := local.NewVolatileBlockList(local.NewInMemoryBlockAllocator(1024))
+	locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewImmutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 16,
+			/* newBlocksCount = */ 2),
+		util.DefaultErrorLogger,
+		"cas",
+		/* blockSizeBytes = */ 1024,
+		/* oldBlocksCount = */ 2,
+		/* newBlocksCount = */ 2,
+		/* initialBlocksCount = */ 0,
+		/* readRefreshCurrentBlocksCount = */ 0)
+	locationRecordArray := local.NewInMemoryLocationRecordArray(4099, locationBlobMap)
+	keyLocationMap := local.NewHashingKeyLocationMap(
+		locationRecordArray,
+		4099,
+		/* hashInitialization = */ 0x2f1d6a9c83e4b705,
+		/* maximumGetAttempts = */ 16,
+		/* maximumPutAttempts = */ 64,
+		"cas")
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", nil, nil)
+
+	// Store a large number of random objects. As the instance name
+	// is not part of the key, objects should also be reported as
+	// present when requested through other instance names.
+	instanceNames := []digest.InstanceName{
+		digest.EmptyInstanceName,
+		digest.MustNewInstanceName("a"),
+		digest.MustNewInstanceName("b"),
+	}
+	random := rand.New(rand.NewSource(42))
+	digests := digest.NewSetBuilder()
+	expectedMissing := digest.NewSetBuilder()
+	for i := 0; i < 1000; i++ {
+		data := make([]byte, 16+random.Intn(16))
+		random.Read(data)
+		hash := sha256.Sum256(data)
+		present := random.Intn(2) == 0
+		for j, instanceName := range instanceNames {
+			blobDigest := digest.MustNewDigest(instanceName.String(), remoteexecution.DigestFunction_SHA256, hex.EncodeToString(hash[:]), int64(len(data)))
+			if present && j == i%len(instanceNames) {
+				require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)))
+			}
+			digests.Add(blobDigest)
+			if !present {
+				expectedMissing.Add(blobDigest)
+			}
+		}
+	}
+
+	// Calling FindMissing() against the full set of digests should
+	// only report the objects that were never stored. This should
+	// yield the same results as calling it for every digest
+	// individually, in which case no lookups can be shared.
+	allDigests := digests.Build()
+	perDigestMissing := digest.NewSetBuilder()
+	for _, blobDigest := range allDigests.Items() {
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		for _, missingDigest := range missing.Items() {
+			perDigestMissing.Add(missingDigest)
+		}
+	}
+	require.Equal(t, expectedMissing.Build(), perDigestMissing.Build())
+
+	missing, err := blobAccess.FindMissing(ctx, allDigests)
+	require.NoError(t, err)
+	require.Equal(t, expectedMissing.Build(), missing)
+}
+
+func TestFlatBlobAccessGetReadRefresh(t *testing.T) {
+	ctx := context.Background()
+
+	// Create a storage backend that refreshes blobs that are read
+	// from the oldest two "current" blocks.
+	blockList := local.NewVolatileBlockList(local.NewInMemoryBlockAllocator(1024))
+	locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewImmutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 4,
+			/* newBlocksCount = */ 1),
+		util.DefaultErrorLogger,
+		"read_refresh",
+		/* blockSizeBytes = */ 1024,
+		/* oldBlocksCount = */ 2,
+		/* newBlocksCount = */ 1,
+		/* initialBlocksCount = */ 0,
+		/* readRefreshCurrentBlocksCount = */ 2)
+	locationRecordArray := local.NewInMemoryLocationRecordArray(1021, locationBlobMap)
+	keyLocationMap := local.NewHashingKeyLocationMap(
+		locationRecordArray,
+		1021,
+		/* hashInitialization = */ 0x7c3a09e6f2b158d4,
+		/* maximumGetAttempts = */ 16,
+		/* maximumPutAttempts = */ 64,
+		"read_refresh")
+	blobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "read_refresh", nil, nil)
+
+	random := rand.New(rand.NewSource(42))
+	putRandomBlob := func() ([]byte, digest.Digest) {
+		data := make([]byte, 100)
+		random.Read(data)
+		hash := sha256.Sum256(data)
+		blobDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, hex.EncodeToString(hash[:]), int64(len(data)))
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)))
+		return data, blobDigest
+	}
+
+	// Store two blobs, of which only one is read. Store enough
+	// other data to cause many block rotations.
+	readData, readDigest := putRandomBlob()
+	_, unreadDigest := putRandomBlob()
+	for i := 0; i < 200; i++ {
+		putRandomBlob()
+		if i%5 == 0 {
+			data, err := blobAccess.Get(ctx, readDigest).ToByteSlice(1000)
+			require.NoError(t, err)
+			require.Equal(t, readData, data)
+		}
+	}
+
+	// The blob that was read should have been refreshed before
+	// ending up in an "old" block, while the blob that wasn't read
+	// should have been discarded.
+	require.Zero(t, getGetsPerGeneration(t, "read_refresh")["old"])
+	missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(readDigest).Add(unreadDigest).Build())
+	require.NoError(t, err)
+	require.Equal(t, unreadDigest.ToSingletonSet(), missing)
+}
@@ -0,0 +1,74 @@
+package local
+
+import (
+	"context"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	freeSpaceCheckingBlobAccessPrometheusMetrics sync.Once
+
+	freeSpaceCheckingBlobAccessPutsRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "free_space_checking_blob_access_puts_rejected_total",
+			Help:      "Number of Put() operations that were rejected by FreeSpaceCheckingBlobAccess due to insufficient free space",
+		},
+		[]string{"storage_type"})
+)
+
+type freeSpaceCheckingBlobAccess struct {
+	blobstore.BlobAccess
+	freeSpaceProvider  FreeSpaceProvider
+	minimumFreeBytes   uint64
+	minimumFreeRatio   float64
+	putsRejectedMetric prometheus.Counter
+}
+
+// NewFreeSpaceCheckingBlobAccess creates a decorator for BlobAccess
+// that rejects Put() operations with RESOURCE_EXHAUSTED if the amount
+// of free space on the underlying file system drops below a threshold.
+// This causes storage backends to degrade to being read-only when
+// running out of disk space, as opposed to writes failing with opaque
+// I/O errors. Once space has been reclaimed, writes are permitted once
+// again.
+//
+// Free space must be at least minimumFreeBytes, and at least
+// minimumFreeRatio times the total size of the file system.
+func NewFreeSpaceCheckingBlobAccess(base blobstore.BlobAccess, freeSpaceProvider FreeSpaceProvider, minimumFreeBytes uint64, minimumFreeRatio float64, storageType string) blobstore.BlobAccess {
+	freeSpaceCheckingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(freeSpaceCheckingBlobAccessPutsRejected)
+	})
+
+	return &freeSpaceCheckingBlobAccess{
+		BlobAccess:         base,
+		freeSpaceProvider:  freeSpaceProvider,
+		minimumFreeBytes:   minimumFreeBytes,
+		minimumFreeRatio:   minimumFreeRatio,
+		putsRejectedMetric: freeSpaceCheckingBlobAccessPutsRejected.WithLabelValues(storageType),
+	}
+}
+
+func (ba *freeSpaceCheckingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	freeBytes, totalBytes, err := ba.freeSpaceProvider.GetFreeSpace()
+	if err != nil {
+		b.Discard()
+		return util.StatusWrap(err, "Failed to obtain amount of free space")
+	}
+	if freeBytes < ba.minimumFreeBytes || float64(freeBytes) < ba.minimumFreeRatio*float64(totalBytes) {
+		b.Discard()
+		ba.putsRejectedMetric.Inc()
+		return status.Errorf(codes.ResourceExhausted, "Storage is read-only, as only %d out of %d bytes of space are free", freeBytes, totalBytes)
+	}
+	return ba.BlobAccess.Put(ctx, blobDigest, b)
+}
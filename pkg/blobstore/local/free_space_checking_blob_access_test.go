@@ -0,0 +1,96 @@
+package local_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestFreeSpaceCheckingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	freeSpaceProvider := mock.NewMockFreeSpaceProvider(ctrl)
+	blobAccess := local.NewFreeSpaceCheckingBlobAccess(
+		baseBlobAccess,
+		freeSpaceProvider,
+		/* minimumFreeBytes = */ 1000,
+		/* minimumFreeRatio = */ 0.1,
+		"cas")
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	expectPut := func() {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+	}
+
+	t.Run("FreeSpaceFailure", func(t *testing.T) {
+		freeSpaceProvider.EXPECT().GetFreeSpace().
+			Return(uint64(0), uint64(0), status.Error(codes.Internal, "Failed to obtain file system statistics of \"/storage\": Input/output error"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to obtain amount of free space: Failed to obtain file system statistics of \"/storage\": Input/output error"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("SufficientSpace", func(t *testing.T) {
+		freeSpaceProvider.EXPECT().GetFreeSpace().Return(uint64(2000), uint64(10000), nil)
+		expectPut()
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("BelowMinimumFreeBytes", func(t *testing.T) {
+		// Writes should be rejected, while reads continue to
+		// work.
+		freeSpaceProvider.EXPECT().GetFreeSpace().Return(uint64(999), uint64(5000), nil)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Storage is read-only, as only 999 out of 5000 bytes of space are free"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("BelowMinimumFreeRatio", func(t *testing.T) {
+		freeSpaceProvider.EXPECT().GetFreeSpace().Return(uint64(9999), uint64(100000), nil)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Storage is read-only, as only 9999 out of 100000 bytes of space are free"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("Recovery", func(t *testing.T) {
+		// Once space has been reclaimed, writes should be
+		// permitted once again.
+		freeSpaceProvider.EXPECT().GetFreeSpace().Return(uint64(10000), uint64(100000), nil)
+		expectPut()
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
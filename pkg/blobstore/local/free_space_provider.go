@@ -0,0 +1,8 @@
+package local
+
+// FreeSpaceProvider is used by FreeSpaceCheckingBlobAccess to obtain
+// the amount of space that is available on the file system on which
+// data is stored.
+type FreeSpaceProvider interface {
+	GetFreeSpace() (freeBytes, totalBytes uint64, err error)
+}
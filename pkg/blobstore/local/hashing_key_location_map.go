@@ -201,3 +201,20 @@ func (klm *hashingKeyLocationMap) Put(key Key, location Location) error {
 	klm.putTooManyIterations.Inc()
 	return nil
 }
+
+func (klm *hashingKeyLocationMap) Scan(lock sync.Locker, entryFunc func(key Key, location Location) error) error {
+	for slot := 0; slot < klm.recordsCount; slot++ {
+		lock.Lock()
+		record, err := klm.recordArray.Get(slot)
+		lock.Unlock()
+		if err == ErrLocationRecordInvalid {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := entryFunc(record.RecordKey.Key, record.Location); err != nil {
+			return err
+		}
+	}
+	return nil
+}
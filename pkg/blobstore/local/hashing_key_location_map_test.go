@@ -1,6 +1,8 @@
 package local_test
 
 import (
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/buildbarn/bb-storage/internal/mock"
@@ -151,3 +153,138 @@ func TestHashingKeyLocationMapPut(t *testing.T) {
 }
 
 // TODO: Make unit testing coverage more complete.
+
+func TestHashingKeyLocationMapScan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	array := mock.NewMockLocationRecordArray(ctrl)
+	klm := local.NewHashingKeyLocationMap(array, 5, 0x970aef1f90c7f916, 2, 2, "cas")
+
+	key1 := local.Key{
+		0xca, 0x2b, 0xd6, 0xc9, 0xc9, 0x9e, 0x7b, 0xc0,
+		0x0a, 0x44, 0x09, 0x73, 0xd6, 0xe1, 0xa3, 0x69,
+	}
+	key2 := local.Key{
+		0x49, 0x42, 0x69, 0x1f, 0x59, 0x07, 0xd5, 0xed,
+		0xdb, 0x71, 0x81, 0x8f, 0x65, 0x8f, 0x20, 0x71,
+	}
+	location1 := local.Location{
+		BlockIndex:  17,
+		OffsetBytes: 864,
+		SizeBytes:   12,
+	}
+	location2 := local.Location{
+		BlockIndex:  3,
+		OffsetBytes: 1024,
+		SizeBytes:   42,
+	}
+	var lock sync.Mutex
+
+	t.Run("IOError", func(t *testing.T) {
+		array.EXPECT().Get(0).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+		array.EXPECT().Get(1).Return(local.LocationRecord{}, status.Error(codes.Internal, "Disk on fire"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Disk on fire"),
+			klm.Scan(&lock, func(key local.Key, location local.Location) error {
+				t.Fatal("Callback should not be invoked")
+				return nil
+			}))
+	})
+
+	t.Run("CallbackError", func(t *testing.T) {
+		// Enumeration should stop as soon as the callback
+		// returns an error.
+		array.EXPECT().Get(0).Return(local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Key: key1},
+			Location:  location1,
+		}, nil)
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Canceled, "Request canceled"),
+			klm.Scan(&lock, func(key local.Key, location local.Location) error {
+				return status.Error(codes.Canceled, "Request canceled")
+			}))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// All valid records should be reported, regardless of
+		// the probing distance at which they are stored.
+		array.EXPECT().Get(0).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+		array.EXPECT().Get(1).Return(local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Key: key1},
+			Location:  location1,
+		}, nil)
+		array.EXPECT().Get(2).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+		array.EXPECT().Get(3).Return(local.LocationRecord{
+			RecordKey: local.LocationRecordKey{Key: key2, Attempt: 1},
+			Location:  location2,
+		}, nil)
+		array.EXPECT().Get(4).Return(local.LocationRecord{}, local.ErrLocationRecordInvalid)
+
+		entries := map[local.Key]local.Location{}
+		require.NoError(t, klm.Scan(&lock, func(key local.Key, location local.Location) error {
+			// The lock should not be held while the
+			// callback is invoked.
+			require.True(t, lock.TryLock())
+			lock.Unlock()
+			entries[key] = location
+			return nil
+		}))
+		require.Equal(t, map[local.Key]local.Location{
+			key1: location1,
+			key2: location2,
+		}, entries)
+	})
+}
+
+func TestHashingKeyLocationMapScanPopulated(t *testing.T) {
+	// Insert a large number of entries into a hash table backed by
+	// an actual LocationRecordArray and BlockList, and check that
+	// all of them are reported by Scan().
+	blockList := local.NewVolatileBlockList(local.NewInMemoryBlockAllocator(1024))
+	for i := 0; i < 7; i++ {
+		require.NoError(t, blockList.PushBack())
+	}
+	array := local.NewInMemoryLocationRecordArray(1021, blockList)
+	klm := local.NewHashingKeyLocationMap(array, 1021, 0x970aef1f90c7f916, 16, 16, "cas")
+
+	expectedEntries := map[local.Key]local.Location{}
+	for i := 0; i < 500; i++ {
+		key := local.NewKeyFromString(strconv.FormatInt(int64(i), 10))
+		location := local.Location{
+			BlockIndex:  i % 7,
+			OffsetBytes: int64(i),
+			SizeBytes:   1,
+		}
+		require.NoError(t, klm.Put(key, location))
+		expectedEntries[key] = location
+	}
+
+	var lock sync.Mutex
+	scan := func() map[local.Key]local.Location {
+		entries := map[local.Key]local.Location{}
+		require.NoError(t, klm.Scan(&lock, func(key local.Key, location local.Location) error {
+			entries[key] = location
+			return nil
+		}))
+		return entries
+	}
+	require.Equal(t, expectedEntries, scan())
+
+	// After releasing the oldest block, entries pointing to it
+	// should no longer be reported. The indices of all other
+	// blocks shift by one.
+	blockList.PopFront()
+	for key, location := range expectedEntries {
+		if location.BlockIndex == 0 {
+			delete(expectedEntries, key)
+		} else {
+			location.BlockIndex--
+			expectedEntries[key] = location
+		}
+	}
+	require.Equal(t, expectedEntries, scan())
+}
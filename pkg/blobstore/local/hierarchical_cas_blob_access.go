@@ -101,13 +101,17 @@ func (ba *hierarchicalCASBlobAccess) getLeastSpecificLookupEntry(lookupKeys []Ke
 // it exists and doesn't need to be refreshed.
 //
 // This method can be used to refresh a key-location map without
-// necessarily copying the data of the underlying object.
-func (ba *hierarchicalCASBlobAccess) syncFromCanonicalEntry(canonicalKey, lookupKey Key) (LocationBlobGetter, error) {
+// necessarily copying the data of the underlying object. The provided
+// function is used to look up the canonical version of the object in
+// the location-blob map. Get() provides LocationBlobMap.GetForRead(),
+// so that the canonical version is only used if it doesn't need to be
+// refreshed due to being read.
+func (ba *hierarchicalCASBlobAccess) syncFromCanonicalEntry(canonicalKey, lookupKey Key, getLocationBlob func(Location) (LocationBlobGetter, bool)) (LocationBlobGetter, error) {
 	canonicalLocation, err := ba.keyLocationMap.Get(canonicalKey)
 	if err != nil {
 		return nil, err
 	}
-	getter, needsRefresh := ba.locationBlobMap.Get(canonicalLocation)
+	getter, needsRefresh := getLocationBlob(canonicalLocation)
 	if needsRefresh {
 		return nil, status.Error(codes.NotFound, "Canonical entry needs to be refreshed")
 	}
@@ -141,7 +145,7 @@ func (ba *hierarchicalCASBlobAccess) Get(ctx context.Context, blobDigest digest.
 		ba.lock.RUnlock()
 		return buffer.NewBufferFromError(err)
 	}
-	if getter, needsRefresh := ba.locationBlobMap.Get(location); !needsRefresh {
+	if getter, needsRefresh := ba.locationBlobMap.GetForRead(location); !needsRefresh {
 		// The blob doesn't need to be refreshed, so we can
 		// return its data directly.
 		b := getter(blobDigest)
@@ -166,7 +170,7 @@ func (ba *hierarchicalCASBlobAccess) Get(ctx context.Context, blobDigest digest.
 		ba.lock.Unlock()
 		return buffer.NewBufferFromError(err)
 	}
-	getter, needsRefresh := ba.locationBlobMap.Get(lookupLocation)
+	getter, needsRefresh := ba.locationBlobMap.GetForRead(lookupLocation)
 	if !needsRefresh {
 		// Some other thread managed to refresh the blob before
 		// we got the write lock. No need to copy anymore.
@@ -178,7 +182,7 @@ func (ba *hierarchicalCASBlobAccess) Get(ctx context.Context, blobDigest digest.
 	// Maybe it already got refreshed as part of another instance
 	// name prefix. First attempt to synchronize from the canonical
 	// entry.
-	if getter, err := ba.syncFromCanonicalEntry(canonicalKey, lookupKey); err == nil {
+	if getter, err := ba.syncFromCanonicalEntry(canonicalKey, lookupKey, ba.locationBlobMap.GetForRead); err == nil {
 		b := getter(blobDigest)
 		ba.lock.Unlock()
 		return b
@@ -303,12 +307,13 @@ func (ba *hierarchicalCASBlobAccess) FindMissing(ctx context.Context, digests di
 		lookupKeys []Key
 	}
 	var blobsToRefresh []blobToRefresh
+	refreshingDisabled := blobstore.IsRefreshingDisabled(ctx)
 	missing := digest.NewSetBuilder()
 	ba.lock.RLock()
 	for i, blobDigest := range digests.Items() {
 		lookupKeys := allLookupKeys[i]
 		if _, location, err := ba.getLeastSpecificLookupEntry(lookupKeys); err == nil {
-			if _, needsRefresh := ba.locationBlobMap.Get(location); needsRefresh {
+			if _, needsRefresh := ba.locationBlobMap.Get(location); needsRefresh && !refreshingDisabled {
 				// Blob is present, but it must be
 				// refreshed for it to remain present.
 				// Enqueue it for the second scan.
@@ -354,7 +359,7 @@ func (ba *hierarchicalCASBlobAccess) FindMissing(ctx context.Context, digests di
 				// First attempt to synchronize from the
 				// canonical entry.
 				canonicalKey := canonicalKeys[i]
-				if _, err := ba.syncFromCanonicalEntry(canonicalKey, lookupKey); err == nil {
+				if _, err := ba.syncFromCanonicalEntry(canonicalKey, lookupKey, ba.locationBlobMap.Get); err == nil {
 					continue
 				} else if status.Code(err) != codes.NotFound {
 					ba.lock.Unlock()
@@ -74,7 +74,7 @@ func TestHierarchicalCASBlobAccessGet(t *testing.T) {
 		// soon, so no refreshing needs to take place.
 		keyLocationMap.EXPECT().Get(lookupKey1).Return(location1, nil)
 		getter := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).Return(getter.Call, false)
+		locationBlobMap.EXPECT().GetForRead(location1).Return(getter.Call, false)
 		reader := mock.NewMockReadCloser(ctrl)
 		getter.EXPECT().Call(helloDigest).
 			Return(buffer.NewCASBufferFromReader(helloDigest, reader, buffer.UserProvided))
@@ -96,13 +96,13 @@ func TestHierarchicalCASBlobAccessGet(t *testing.T) {
 		// that object.
 		keyLocationMap.EXPECT().Get(lookupKey1).Return(location1, nil)
 		getter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).Return(getter1.Call, true)
+		locationBlobMap.EXPECT().GetForRead(location1).Return(getter1.Call, true)
 		keyLocationMap.EXPECT().Get(lookupKey1).Return(location1, nil)
 		getter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).Return(getter2.Call, true)
+		locationBlobMap.EXPECT().GetForRead(location1).Return(getter2.Call, true)
 		keyLocationMap.EXPECT().Get(canonicalKey).Return(location2, nil)
 		getter3 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location2).Return(getter3.Call, false)
+		locationBlobMap.EXPECT().GetForRead(location2).Return(getter3.Call, false)
 		keyLocationMap.EXPECT().Put(lookupKey1, location2)
 		reader := mock.NewMockReadCloser(ctrl)
 		getter3.EXPECT().Call(helloDigest).
@@ -128,10 +128,10 @@ func TestHierarchicalCASBlobAccessGet(t *testing.T) {
 		// updated.
 		keyLocationMap.EXPECT().Get(lookupKey1).Return(location1, nil)
 		getter1 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).Return(getter1.Call, true)
+		locationBlobMap.EXPECT().GetForRead(location1).Return(getter1.Call, true)
 		keyLocationMap.EXPECT().Get(lookupKey1).Return(location1, nil)
 		getter2 := mock.NewMockLocationBlobGetter(ctrl)
-		locationBlobMap.EXPECT().Get(location1).Return(getter2.Call, true)
+		locationBlobMap.EXPECT().GetForRead(location1).Return(getter2.Call, true)
 		keyLocationMap.EXPECT().Get(canonicalKey).
 			Return(local.Location{}, status.Error(codes.NotFound, "Object not found"))
 		reader := mock.NewMockReadCloser(ctrl)
@@ -0,0 +1,115 @@
+package local
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+// InlineLocationBlobMapMaximumSizeBytes is the maximum size of blobs
+// that InlineLocationBlobMap is capable of storing inline. The contents
+// of such blobs are stored in the OffsetBytes field of Location, of
+// which the sign bit is used to distinguish inline locations from
+// regular ones.
+const InlineLocationBlobMapMaximumSizeBytes = 7
+
+// inlineLocationFlag is set in Location.OffsetBytes for blobs that are
+// stored inline.
+const inlineLocationFlag = -1 << 63
+
+// isInlineLocation returns whether a Location refers to a blob whose
+// contents are stored inline, as opposed to being stored in a block.
+func isInlineLocation(location Location) bool {
+	return location.OffsetBytes < 0
+}
+
+type inlineLocationBlobMap struct {
+	LocationBlobMap
+	readBufferFactory blobstore.ReadBufferFactory
+	maximumSizeBytes  int64
+}
+
+// NewInlineLocationBlobMap creates a decorator for LocationBlobMap
+// that stores the contents of small blobs inline, as part of the
+// Location that is stored in the KeyLocationMap. This prevents space in
+// blocks from being consumed by blobs that are only a couple of bytes
+// in size, and causes reads of such blobs to bypass the BlockList.
+//
+// Only a zero-sized allocation is made in the underlying
+// LocationBlobMap for blobs that are stored inline. This ensures that
+// such blobs are still subject to the same policies for refreshing and
+// eviction as blobs that are stored in blocks.
+//
+// The maximum size of blobs that are stored inline may not exceed
+// InlineLocationBlobMapMaximumSizeBytes. If the maximum size is zero, no
+// blobs are stored inline. Blobs that were stored inline previously can
+// still be read. This decorator should therefore be applied regardless
+// of whether storing blobs inline is enabled, as the key-location map
+// may still contain entries that were created while it was.
+func NewInlineLocationBlobMap(base LocationBlobMap, readBufferFactory blobstore.ReadBufferFactory, maximumSizeBytes int64) LocationBlobMap {
+	return &inlineLocationBlobMap{
+		LocationBlobMap:   base,
+		readBufferFactory: readBufferFactory,
+		maximumSizeBytes:  maximumSizeBytes,
+	}
+}
+
+func (lbm *inlineLocationBlobMap) inlineGetter(location Location) LocationBlobGetter {
+	return func(blobDigest digest.Digest) buffer.Buffer {
+		data := make([]byte, location.SizeBytes)
+		for i := range data {
+			data[i] = byte(location.OffsetBytes >> (8 * i))
+		}
+		// There is no way to repair data stored inline, as it
+		// is not part of any block that can be released.
+		return lbm.readBufferFactory.NewBufferFromByteSlice(blobDigest, data, func(dataIsValid bool) {})
+	}
+}
+
+func (lbm *inlineLocationBlobMap) Get(location Location) (LocationBlobGetter, bool) {
+	getter, needsRefresh := lbm.LocationBlobMap.Get(location)
+	if isInlineLocation(location) {
+		return lbm.inlineGetter(location), needsRefresh
+	}
+	return getter, needsRefresh
+}
+
+func (lbm *inlineLocationBlobMap) GetForRead(location Location) (LocationBlobGetter, bool) {
+	getter, needsRefresh := lbm.LocationBlobMap.GetForRead(location)
+	if isInlineLocation(location) {
+		return lbm.inlineGetter(location), needsRefresh
+	}
+	return getter, needsRefresh
+}
+
+func (lbm *inlineLocationBlobMap) Put(sizeBytes int64) (LocationBlobPutWriter, error) {
+	if lbm.maximumSizeBytes == 0 || sizeBytes > lbm.maximumSizeBytes {
+		return lbm.LocationBlobMap.Put(sizeBytes)
+	}
+
+	putWriter, err := lbm.LocationBlobMap.Put(0)
+	if err != nil {
+		return nil, err
+	}
+	return func(b buffer.Buffer) LocationBlobPutFinalizer {
+		data, err := b.ToByteSlice(int(sizeBytes))
+		putFinalizer := putWriter(buffer.NewValidatedBufferFromByteSlice(nil))
+		return func() (Location, error) {
+			location, finalizeErr := putFinalizer()
+			if err != nil {
+				return Location{}, err
+			}
+			if finalizeErr != nil {
+				return Location{}, finalizeErr
+			}
+
+			offsetBytes := int64(inlineLocationFlag)
+			for i, c := range data {
+				offsetBytes |= int64(c) << (8 * i)
+			}
+			location.OffsetBytes = offsetBytes
+			location.SizeBytes = sizeBytes
+			return location, nil
+		}
+	}, nil
+}
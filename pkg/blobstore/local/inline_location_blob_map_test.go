@@ -0,0 +1,198 @@
+package local_test
+
+import (
+	"math"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestInlineLocationBlobMap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	baseLocationBlobMap := mock.NewMockLocationBlobMap(ctrl)
+	locationBlobMap := local.NewInlineLocationBlobMap(baseLocationBlobMap, blobstore.CASReadBufferFactory, local.InlineLocationBlobMapMaximumSizeBytes)
+
+	smallDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	smallLocation := local.Location{
+		BlockIndex:  3,
+		OffsetBytes: math.MinInt64 | 0x6f6c6c6548,
+		SizeBytes:   5,
+	}
+	largeDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "3e25960a79dbc69b674cd4ec67a72c62", 11)
+	largeLocation := local.Location{
+		BlockIndex:  3,
+		OffsetBytes: 1000,
+		SizeBytes:   11,
+	}
+
+	t.Run("PutSmall", func(t *testing.T) {
+		// Small blobs should be stored inline. Only a zero-sized
+		// allocation should be made in the underlying
+		// LocationBlobMap, so that the blob is tied to a block.
+		putWriter := mock.NewMockLocationBlobPutWriter(ctrl)
+		baseLocationBlobMap.EXPECT().Put(int64(0)).Return(putWriter.Call, nil)
+		putFinalizer := mock.NewMockLocationBlobPutFinalizer(ctrl)
+		putWriter.EXPECT().Call(gomock.Any()).DoAndReturn(func(b buffer.Buffer) local.LocationBlobPutFinalizer {
+			data, err := b.ToByteSlice(10)
+			require.NoError(t, err)
+			require.Empty(t, data)
+			return putFinalizer.Call
+		})
+		putFinalizer.EXPECT().Call().Return(local.Location{
+			BlockIndex:  3,
+			OffsetBytes: 1000,
+			SizeBytes:   0,
+		}, nil)
+
+		locationBlobPutWriter, err := locationBlobMap.Put(5)
+		require.NoError(t, err)
+		location, err := locationBlobPutWriter(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
+		require.NoError(t, err)
+		require.Equal(t, smallLocation, location)
+	})
+
+	t.Run("PutLarge", func(t *testing.T) {
+		// Blobs exceeding the maximum size should be stored in
+		// blocks.
+		putWriter := mock.NewMockLocationBlobPutWriter(ctrl)
+		baseLocationBlobMap.EXPECT().Put(int64(11)).Return(putWriter.Call, nil)
+		putFinalizer := mock.NewMockLocationBlobPutFinalizer(ctrl)
+		putWriter.EXPECT().Call(gomock.Any()).DoAndReturn(func(b buffer.Buffer) local.LocationBlobPutFinalizer {
+			data, err := b.ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("Hello world"), data)
+			return putFinalizer.Call
+		})
+		putFinalizer.EXPECT().Call().Return(largeLocation, nil)
+
+		locationBlobPutWriter, err := locationBlobMap.Put(11)
+		require.NoError(t, err)
+		location, err := locationBlobPutWriter(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))()
+		require.NoError(t, err)
+		require.Equal(t, largeLocation, location)
+	})
+
+	t.Run("GetSmall", func(t *testing.T) {
+		// Reads of blobs stored inline should not call into the
+		// getter of the underlying LocationBlobMap. Whether the
+		// blob needs to be refreshed should still be determined
+		// by the underlying LocationBlobMap.
+		baseGetter := mock.NewMockLocationBlobGetter(ctrl)
+		baseLocationBlobMap.EXPECT().GetForRead(smallLocation).Return(baseGetter.Call, true)
+
+		getter, needsRefresh := locationBlobMap.GetForRead(smallLocation)
+		require.True(t, needsRefresh)
+		data, err := getter(smallDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetLarge", func(t *testing.T) {
+		// Reads of blobs stored in blocks should be forwarded.
+		baseGetter := mock.NewMockLocationBlobGetter(ctrl)
+		baseLocationBlobMap.EXPECT().Get(largeLocation).Return(baseGetter.Call, false)
+		baseGetter.EXPECT().Call(largeDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		getter, needsRefresh := locationBlobMap.Get(largeLocation)
+		require.False(t, needsRefresh)
+		data, err := getter(largeDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+}
+
+func TestInlineLocationBlobMapRoundTrip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	// Store a blob inline in a block managed by
+	// OldCurrentNewLocationBlobMap. Only a zero-sized allocation
+	// should be made in the BlockList.
+	blockList := mock.NewMockBlockList(ctrl)
+	baseLocationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewMutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 4),
+		mock.NewMockErrorLogger(ctrl),
+		"cas",
+		/* blockSizeBytes = */ 16,
+		/* oldBlocksCount = */ 2,
+		/* newBlocksCount = */ 1,
+		/* initialBlocksCount = */ 7,
+		/* readRefreshCurrentBlocksCount = */ 0)
+	blockList.EXPECT().HasSpace(gomock.Any(), int64(0)).Return(true).AnyTimes()
+	blockListPutWriter := mock.NewMockBlockListPutWriter(ctrl)
+	blockList.EXPECT().Put(6, int64(0)).Return(blockListPutWriter.Call)
+	blockListPutFinalizer := mock.NewMockBlockListPutFinalizer(ctrl)
+	blockListPutWriter.EXPECT().Call(gomock.Any()).DoAndReturn(func(b buffer.Buffer) local.BlockListPutFinalizer {
+		data, err := b.ToByteSlice(10)
+		require.NoError(t, err)
+		require.Empty(t, data)
+		return blockListPutFinalizer.Call
+	})
+	blockListPutFinalizer.EXPECT().Call().Return(int64(12), nil)
+
+	locationBlobPutWriter, err := local.NewInlineLocationBlobMap(baseLocationBlobMap, blobstore.CASReadBufferFactory, 5).Put(5)
+	require.NoError(t, err)
+	location, err := locationBlobPutWriter(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))()
+	require.NoError(t, err)
+
+	// Write the resulting location into a persistent location
+	// record array and read it back. This should yield an
+	// identical location.
+	blockDevice := mock.NewMockBlockDevice(ctrl)
+	blockReferenceResolver := mock.NewMockBlockReferenceResolver(ctrl)
+	locationRecordArray := local.NewBlockDeviceBackedLocationRecordArray(blockDevice, blockReferenceResolver)
+	blockReference := local.BlockReference{EpochID: 42, BlocksFromLast: 0}
+	blockReferenceResolver.EXPECT().BlockIndexToBlockReference(6).Return(blockReference, uint64(0x5d6e6c4e6a2d1f3b))
+	blockReferenceResolver.EXPECT().BlockReferenceToBlockIndex(blockReference).Return(6, uint64(0x5d6e6c4e6a2d1f3b), true)
+	var record []byte
+	blockDevice.EXPECT().WriteAt(gomock.Any(), int64(0)).DoAndReturn(func(p []byte, off int64) (int, error) {
+		record = append([]byte(nil), p...)
+		return len(p), nil
+	})
+	blockDevice.EXPECT().ReadAt(gomock.Any(), int64(0)).DoAndReturn(func(p []byte, off int64) (int, error) {
+		return copy(p, record), nil
+	})
+
+	require.NoError(t, locationRecordArray.Put(0, local.LocationRecord{Location: location}))
+	locationRecord, err := locationRecordArray.Get(0)
+	require.NoError(t, err)
+	require.Equal(t, location, locationRecord.Location)
+
+	// The blob should be readable without accessing the BlockList,
+	// even if storing blobs inline has been disabled in the
+	// meantime.
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	getter, needsRefresh := local.NewInlineLocationBlobMap(baseLocationBlobMap, blobstore.CASReadBufferFactory, 0).GetForRead(locationRecord.Location)
+	require.False(t, needsRefresh)
+	data, err := getter(blobDigest).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	// Attempting to read the blob without decoding it should not
+	// cause the contents of the blob to be interpreted as an
+	// offset within the block.
+	getter, _ = baseLocationBlobMap.Get(locationRecord.Location)
+	_, err = getter(blobDigest).ToByteSlice(100)
+	testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Location refers to a blob that is stored inline, which cannot be read from a block"), err)
+
+	// Blobs stored inline should be considered to be stored at the
+	// start of their block when compared against blobs stored in
+	// the same block, regardless of their contents.
+	require.True(t, locationRecord.Location.IsOlder(local.Location{BlockIndex: 6, OffsetBytes: 1, SizeBytes: 5}))
+	require.False(t, local.Location{BlockIndex: 6, OffsetBytes: 1, SizeBytes: 5}.IsOlder(locationRecord.Location))
+	require.True(t, local.Location{BlockIndex: 5, OffsetBytes: 1, SizeBytes: 5}.IsOlder(locationRecord.Location))
+}
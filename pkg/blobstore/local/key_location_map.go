@@ -1,5 +1,9 @@
 package local
 
+import (
+	"sync"
+)
+
 // KeyLocationMap is equivalent to a map[Key]Location. It is used by
 // FlatBlobAccess and HierarchicalCASBlobAccess to track where blobs are
 // stored, so that they may be accessed. Implementations are permitted
@@ -8,4 +12,22 @@ package local
 type KeyLocationMap interface {
 	Get(key Key) (Location, error)
 	Put(key Key, location Location) error
+
+	// Scan invokes a callback for every entry in the map that
+	// points to a location that is still valid, in no particular
+	// order. Enumeration stops if the callback returns an error.
+	//
+	// Keys are irreversible, meaning that the digests of the
+	// objects cannot be derived from the entries that are
+	// returned. The same key may be reported more than once, in
+	// which case only the newest location is returned by Get().
+	//
+	// The provided lock is only held while reading individual
+	// entries, and is released while the callback is invoked. This
+	// permits other operations to make progress while large maps
+	// are scanned. Entries that are inserted, displaced or
+	// invalidated while a scan is in progress (e.g., due to blocks
+	// being released by BlockList.PopFront()) may either be missed
+	// or be reported more than once.
+	Scan(lock sync.Locker, entryFunc func(key Key, location Location) error) error
 }
@@ -0,0 +1,65 @@
+package local
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+)
+
+type keyLocationMapListingEntry struct {
+	Key         string `json:"key"`
+	BlockIndex  int    `json:"block_index"`
+	OffsetBytes int64  `json:"offset_bytes"`
+	SizeBytes   int64  `json:"size_bytes"`
+}
+
+type keyLocationMapListingHandler struct {
+	keyLocationMap KeyLocationMap
+	lock           sync.Locker
+}
+
+// NewKeyLocationMapListingHandler creates a HTTP handler that lists all
+// entries contained in a KeyLocationMap, using KeyLocationMap.Scan().
+// Entries are written as a stream of JSON objects, one per line,
+// containing the key in hexadecimal form and the location of the
+// object. This can be used to audit the contents of a local storage
+// backend, or to determine how objects are spread across blocks.
+//
+// As keys are irreversible, the digests of the objects cannot be
+// derived from the output. Because the lock is released while the
+// response is written, entries that are inserted or invalidated while
+// the listing is in progress may be missed or be reported more than
+// once.
+func NewKeyLocationMapListingHandler(keyLocationMap KeyLocationMap, lock sync.Locker) http.Handler {
+	return &keyLocationMapListingHandler{
+		keyLocationMap: keyLocationMap,
+		lock:           lock,
+	}
+}
+
+func (h *keyLocationMapListingHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Errors can only be reported to the client as long as no
+	// entries have been written yet. Errors writing to the client
+	// cause the scan to be aborted.
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	encoder := json.NewEncoder(w)
+	wroteEntries := false
+	if err := h.keyLocationMap.Scan(h.lock, func(key Key, location Location) error {
+		wroteEntries = true
+		return encoder.Encode(keyLocationMapListingEntry{
+			Key:         hex.EncodeToString(key[:]),
+			BlockIndex:  location.BlockIndex,
+			OffsetBytes: location.OffsetBytes,
+			SizeBytes:   location.SizeBytes,
+		})
+	}); err != nil && !wroteEntries {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,72 @@
+package local_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestKeyLocationMapListingHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	keyLocationMap := mock.NewMockKeyLocationMap(ctrl)
+	var lock sync.Mutex
+	handler := local.NewKeyLocationMapListingHandler(keyLocationMap, &lock)
+
+	t.Run("Success", func(t *testing.T) {
+		// All entries reported by Scan() should be written as
+		// JSON objects, one per line.
+		keyLocationMap.EXPECT().Scan(&lock, gomock.Any()).DoAndReturn(
+			func(lock sync.Locker, entryFunc func(key local.Key, location local.Location) error) error {
+				require.NoError(t, entryFunc(local.Key{0x01, 0x02}, local.Location{
+					BlockIndex:  3,
+					OffsetBytes: 1024,
+					SizeBytes:   42,
+				}))
+				require.NoError(t, entryFunc(local.Key{0xff}, local.Location{
+					BlockIndex:  7,
+					OffsetBytes: 0,
+					SizeBytes:   5,
+				}))
+				return nil
+			})
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/local_contents/cas", nil))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(
+			t,
+			`{"key":"0102000000000000000000000000000000000000000000000000000000000000","block_index":3,"offset_bytes":1024,"size_bytes":42}
+{"key":"ff00000000000000000000000000000000000000000000000000000000000000","block_index":7,"offset_bytes":0,"size_bytes":5}
+`,
+			w.Body.String())
+	})
+
+	t.Run("ScanFailure", func(t *testing.T) {
+		// Errors that occur before any entries are written
+		// should be reported through the status code.
+		keyLocationMap.EXPECT().Scan(&lock, gomock.Any()).Return(status.Error(codes.Internal, "Disk on fire"))
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/local_contents/cas", nil))
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "Disk on fire")
+	})
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPut, "/local_contents/cas", nil))
+		require.Equal(t, http.StatusMethodNotAllowed, w.Code)
+		require.Equal(t, "GET", w.Header().Get("Allow"))
+	})
+}
@@ -12,6 +12,17 @@ type Location struct {
 // IsOlder returns true if the receiving Location is stored in Block
 // that is older than the Location argument, or if it is stored prior to
 // the Location argument within the same Block.
+//
+// Locations of blobs that are stored inline (see
+// NewInlineLocationBlobMap()) don't contain an offset within their
+// Block. They are considered to be stored at the start of the Block.
 func (a Location) IsOlder(b Location) bool {
-	return a.BlockIndex < b.BlockIndex || (a.BlockIndex == b.BlockIndex && a.OffsetBytes < b.OffsetBytes)
+	return a.BlockIndex < b.BlockIndex || (a.BlockIndex == b.BlockIndex && a.getOffsetWithinBlock() < b.getOffsetWithinBlock())
+}
+
+func (a Location) getOffsetWithinBlock() int64 {
+	if isInlineLocation(a) {
+		return 0
+	}
+	return a.OffsetBytes
 }
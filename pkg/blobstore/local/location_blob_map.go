@@ -59,8 +59,23 @@ type LocationBlobMap interface {
 	// reupload the blob. Because Put() invalidates
 	// LocationBlobGetters, this function must be called after the
 	// LocationBlobGetters is invoked.
+	//
+	// Whether a blob needs to be refreshed may only depend on the
+	// index of the block in which it is stored. This permits
+	// callers to reuse the result for other blobs in the same
+	// block.
 	Get(location Location) (LocationBlobGetter, bool)
 
+	// GetForRead is identical to Get(), except that it is called by
+	// operations that read the contents of the blob, as opposed to
+	// only checking for its existence. Implementations may use this
+	// to refresh blobs that are read frequently more eagerly, so
+	// that they are retained longer than blobs that are not read.
+	// Implementations may also use this to gather statistics on
+	// reads, meaning that Get() should be used when copying blobs
+	// for the purpose of refreshing them.
+	GetForRead(location Location) (LocationBlobGetter, bool)
+
 	// Put a new blob to storage.
 	//
 	// This function returns a LocationBlobPutWriter, which must be
@@ -25,6 +25,14 @@ var (
 			Help:      "Time at which the last removed block was inserted into the \"old\" queue, which is an indicator for the worst-case blob retention time",
 		},
 		[]string{"storage_type"})
+	oldCurrentNewLocationBlobMapGetsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "old_current_new_location_blob_map_gets_total",
+			Help:      "Number of blobs read, partitioned by the group of blocks from which they were read",
+		},
+		[]string{"storage_type", "generation"})
 )
 
 type oldBlockState struct {
@@ -91,6 +99,13 @@ type oldBlockState struct {
 // the "current" blocks are randomly seeded to reduce 'tidal waves'
 // later on.
 //
+// When reading blobs through GetForRead(), blobs stored in the oldest
+// blocks of the "current" group may optionally be refreshed as well.
+// This causes blobs that are read frequently to remain present, even
+// if they are never written again. This is useful for workloads where
+// objects are read repeatedly (e.g., the Action Cache), as this makes
+// the storage backend behave more like an LRU cache.
+//
 // The number of blocks in the "old" group should not be too low, as
 // this would cause this storage backend to become a FIFO instead of
 // being LRU-like. Setting it too high is also not recommended, as this
@@ -104,6 +119,8 @@ type OldCurrentNewLocationBlobMap struct {
 	desiredOldBlocksCount int
 	desiredNewBlocksCount int
 
+	readRefreshCurrentBlocksCount int
+
 	// The number of blocks present in the underlying BlockList,
 	// partitioned into "old", "current" and "new".
 	oldBlocks     []oldBlockState
@@ -120,7 +137,14 @@ type OldCurrentNewLocationBlobMap struct {
 	allocationAttemptsRemaining int
 	allocationBlockIndex        int
 
+	// Channel that is closed when a block is moved into the "old"
+	// group.
+	blockRotationWakeup chan struct{}
+
 	lastRemovedOldBlockInsertionTime prometheus.Gauge
+	getsOld                          prometheus.Counter
+	getsCurrent                      prometheus.Counter
+	getsNew                          prometheus.Counter
 }
 
 func unixTime() float64 {
@@ -129,9 +153,10 @@ func unixTime() float64 {
 
 // NewOldCurrentNewLocationBlobMap creates a new instance of
 // OldCurrentNewLocationBlobMap.
-func NewOldCurrentNewLocationBlobMap(blockList BlockList, blockListGrowthPolicy BlockListGrowthPolicy, errorLogger util.ErrorLogger, storageType string, blockSizeBytes int64, oldBlocksCount, newBlocksCount, initialBlocksCount int) *OldCurrentNewLocationBlobMap {
+func NewOldCurrentNewLocationBlobMap(blockList BlockList, blockListGrowthPolicy BlockListGrowthPolicy, errorLogger util.ErrorLogger, storageType string, blockSizeBytes int64, oldBlocksCount, newBlocksCount, initialBlocksCount, readRefreshCurrentBlocksCount int) *OldCurrentNewLocationBlobMap {
 	oldCurrentNewLocationBlobMapPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(oldCurrentNewLocationBlobMapLastRemovedOldBlockInsertionTime)
+		prometheus.MustRegister(oldCurrentNewLocationBlobMapGetsTotal)
 	})
 
 	lbm := &OldCurrentNewLocationBlobMap{
@@ -142,7 +167,14 @@ func NewOldCurrentNewLocationBlobMap(blockList BlockList, blockListGrowthPolicy
 		desiredOldBlocksCount: oldBlocksCount,
 		desiredNewBlocksCount: newBlocksCount,
 
+		readRefreshCurrentBlocksCount: readRefreshCurrentBlocksCount,
+
+		blockRotationWakeup: make(chan struct{}),
+
 		lastRemovedOldBlockInsertionTime: oldCurrentNewLocationBlobMapLastRemovedOldBlockInsertionTime.WithLabelValues(storageType),
+		getsOld:                          oldCurrentNewLocationBlobMapGetsTotal.WithLabelValues(storageType, "old"),
+		getsCurrent:                      oldCurrentNewLocationBlobMapGetsTotal.WithLabelValues(storageType, "current"),
+		getsNew:                          oldCurrentNewLocationBlobMapGetsTotal.WithLabelValues(storageType, "new"),
 	}
 	lbm.resetAllocationBlockIndex()
 	now := unixTime()
@@ -236,7 +268,46 @@ func (lbm *OldCurrentNewLocationBlobMap) increaseTotalBlocksToBeReleased(newValu
 // LocationBlobGetter is returned that can be used to fetch the blob's
 // contents.
 func (lbm *OldCurrentNewLocationBlobMap) Get(location Location) (LocationBlobGetter, bool) {
+	return lbm.getter(location, false), location.BlockIndex < len(lbm.oldBlocks)
+}
+
+// GetForRead is identical to Get(), except that blobs stored in the
+// oldest blocks of the "current" group also need to be refreshed. The
+// number of blocks for which this is done is configurable.
+//
+// Every call to the LocationBlobGetter is counted, partitioned by the
+// group of blocks from which the blob is read. This can be used to
+// determine how often blobs are read just before they are discarded,
+// which may indicate that the size of the cache is insufficient.
+// Getters returned by Get() are not counted, as those are also used
+// to copy blobs while refreshing them.
+func (lbm *OldCurrentNewLocationBlobMap) GetForRead(location Location) (LocationBlobGetter, bool) {
+	readRefreshCurrentBlocksCount := lbm.readRefreshCurrentBlocksCount
+	if readRefreshCurrentBlocksCount > lbm.currentBlocks {
+		readRefreshCurrentBlocksCount = lbm.currentBlocks
+	}
+	return lbm.getter(location, true), location.BlockIndex < len(lbm.oldBlocks)+readRefreshCurrentBlocksCount
+}
+
+func (lbm *OldCurrentNewLocationBlobMap) getter(location Location, countRead bool) LocationBlobGetter {
 	return func(digest digest.Digest) buffer.Buffer {
+		if countRead {
+			if location.BlockIndex < len(lbm.oldBlocks) {
+				lbm.getsOld.Inc()
+			} else if location.BlockIndex < len(lbm.oldBlocks)+lbm.currentBlocks {
+				lbm.getsCurrent.Inc()
+			} else {
+				lbm.getsNew.Inc()
+			}
+		}
+
+		// Blobs stored inline don't have any data in the
+		// block. They can only be read through
+		// InlineLocationBlobMap.
+		if isInlineLocation(location) {
+			return buffer.NewBufferFromError(status.Error(codes.Internal, "Location refers to a blob that is stored inline, which cannot be read from a block"))
+		}
+
 		totalBlocksToBeReleased := lbm.totalBlocksReleased + uint64(location.BlockIndex) + 1
 		return lbm.blockList.Get(location.BlockIndex, digest, location.OffsetBytes, location.SizeBytes, func(dataIsValid bool) {
 			if !dataIsValid {
@@ -245,7 +316,7 @@ func (lbm *OldCurrentNewLocationBlobMap) Get(location Location) (LocationBlobGet
 				}
 			}
 		})
-	}, location.BlockIndex < len(lbm.oldBlocks)
+	}
 }
 
 // resetAllocationBlockIndex resets the counters used to determine from
@@ -348,6 +419,8 @@ func (lbm *OldCurrentNewLocationBlobMap) findBlockWithSpace(sizeBytes int64) (in
 				lbm.removeOldestOldBlock()
 				lbm.increaseTotalBlocksToBeReleased(lbm.totalBlocksReleased)
 			}
+			close(lbm.blockRotationWakeup)
+			lbm.blockRotationWakeup = make(chan struct{})
 		}
 		lbm.resetAllocationBlockIndex()
 	}
@@ -365,6 +438,14 @@ func (lbm *OldCurrentNewLocationBlobMap) findBlockWithSpace(sizeBytes int64) (in
 	}
 }
 
+// GetBlockRotationWakeup returns a channel that is closed the next time
+// a block is moved into the "old" group. Blobs stored in that block
+// need to be refreshed to prevent them from being released as part of
+// subsequent block rotations.
+func (lbm *OldCurrentNewLocationBlobMap) GetBlockRotationWakeup() <-chan struct{} {
+	return lbm.blockRotationWakeup
+}
+
 // Put a new blob of a given size to storage.
 func (lbm *OldCurrentNewLocationBlobMap) Put(sizeBytes int64) (LocationBlobPutWriter, error) {
 	blockIndex, err := lbm.findBlockWithSpace(sizeBytes)
@@ -9,6 +9,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/grpc/codes"
@@ -32,7 +33,8 @@ func TestOldCurrentNewLocationBlobMapAllocationPattern(t *testing.T) {
 		/* blockSizeBytes = */ 16,
 		/* oldBlocksCount = */ 2,
 		/* newBlocksCount = */ 4,
-		/* initialBlocksCount = */ 10)
+		/* initialBlocksCount = */ 10,
+		/* readRefreshCurrentBlocksCount = */ 0)
 
 	// After starting up, there should be a uniform distribution on
 	// the "current" blocks and an inverse exponential distribution
@@ -78,6 +80,128 @@ func TestOldCurrentNewLocationBlobMapAllocationPattern(t *testing.T) {
 	}
 }
 
+// getGetsPerGeneration returns the number of reads reported by
+// OldCurrentNewLocationBlobMap for a given storage type, keyed by
+// generation.
+func getGetsPerGeneration(t *testing.T, storageType string) map[string]float64 {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	gets := map[string]float64{}
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "buildbarn_blobstore_old_current_new_location_blob_map_gets_total" {
+			continue
+		}
+		for _, metric := range metricFamily.Metric {
+			labelPairs := map[string]string{}
+			for _, labelPair := range metric.Label {
+				labelPairs[labelPair.GetName()] = labelPair.GetValue()
+			}
+			if labelPairs["storage_type"] == storageType {
+				gets[labelPairs["generation"]] = metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return gets
+}
+
+func TestOldCurrentNewLocationBlobMapGetsPerGeneration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockList := mock.NewMockBlockList(ctrl)
+	locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewMutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 4),
+		mock.NewMockErrorLogger(ctrl),
+		"gets_per_generation",
+		/* blockSizeBytes = */ 16,
+		/* oldBlocksCount = */ 2,
+		/* newBlocksCount = */ 1,
+		/* initialBlocksCount = */ 7,
+		/* readRefreshCurrentBlocksCount = */ 0)
+
+	// Blocks 0 and 1 are "old", blocks 2 to 5 are "current" and
+	// block 6 is "new".
+	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	for _, blockIndex := range []int{1, 2, 5, 6, 6, 6} {
+		blockList.EXPECT().Get(blockIndex, helloDigest, int64(10), int64(5), gomock.Any()).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		locationBlobGetter, needsRefresh := locationBlobMap.GetForRead(local.Location{
+			BlockIndex:  blockIndex,
+			OffsetBytes: 10,
+			SizeBytes:   5,
+		})
+		require.Equal(t, blockIndex < 2, needsRefresh)
+		data, err := locationBlobGetter(helloDigest).ToByteSlice(10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	}
+
+	// Calls to GetForRead() without invoking the LocationBlobGetter
+	// should not be counted.
+	locationBlobMap.GetForRead(local.Location{
+		BlockIndex:  3,
+		OffsetBytes: 10,
+		SizeBytes:   5,
+	})
+
+	// Getters returned by Get() are used by FindMissing() to copy
+	// blobs that need to be refreshed. As these don't correspond to
+	// reads performed by clients, they should not be counted.
+	blockList.EXPECT().Get(0, helloDigest, int64(10), int64(5), gomock.Any()).
+		Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+	locationBlobGetter, needsRefresh := locationBlobMap.Get(local.Location{
+		BlockIndex:  0,
+		OffsetBytes: 10,
+		SizeBytes:   5,
+	})
+	require.True(t, needsRefresh)
+	data, err := locationBlobGetter(helloDigest).ToByteSlice(10)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	require.Equal(t, map[string]float64{
+		"old":     1,
+		"current": 2,
+		"new":     3,
+	}, getGetsPerGeneration(t, "gets_per_generation"))
+}
+
+func TestOldCurrentNewLocationBlobMapGetForRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	blockList := mock.NewMockBlockList(ctrl)
+	locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewMutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 4),
+		mock.NewMockErrorLogger(ctrl),
+		"cas",
+		/* blockSizeBytes = */ 16,
+		/* oldBlocksCount = */ 2,
+		/* newBlocksCount = */ 1,
+		/* initialBlocksCount = */ 7,
+		/* readRefreshCurrentBlocksCount = */ 2)
+
+	// Blocks 0 and 1 are "old", blocks 2 to 5 are "current" and
+	// block 6 is "new". Get() should only request refreshes for
+	// blobs in "old" blocks, while GetForRead() should also do so
+	// for the two oldest "current" blocks.
+	for blockIndex := 0; blockIndex < 7; blockIndex++ {
+		location := local.Location{
+			BlockIndex:  blockIndex,
+			OffsetBytes: 10,
+			SizeBytes:   5,
+		}
+		_, needsRefresh := locationBlobMap.Get(location)
+		require.Equal(t, blockIndex < 2, needsRefresh)
+		_, needsRefresh = locationBlobMap.GetForRead(location)
+		require.Equal(t, blockIndex < 4, needsRefresh)
+	}
+}
+
 func TestOldCurrentNewLocationBlobMapDataCorruption(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -93,7 +217,8 @@ func TestOldCurrentNewLocationBlobMapDataCorruption(t *testing.T) {
 		/* blockSizeBytes = */ 16,
 		/* oldBlocksCount = */ 2,
 		/* newBlocksCount = */ 4,
-		/* initialBlocksCount = */ 10)
+		/* initialBlocksCount = */ 10,
+		/* readRefreshCurrentBlocksCount = */ 0)
 
 	// Perform a Get() call against block 1. Return a buffer that
 	// will trigger a data integrity error, as the digest
@@ -186,7 +311,8 @@ func TestOldCurrentNewLocationBlobMapDataCorruptionInAllBlocks(t *testing.T) {
 		/* blockSizeBytes = */ 16,
 		/* oldBlocksCount = */ 2,
 		/* newBlocksCount = */ 4,
-		/* initialBlocksCount = */ 10)
+		/* initialBlocksCount = */ 10,
+		/* readRefreshCurrentBlocksCount = */ 0)
 
 	// Perform a Get() call against the new block 9. Return a buffer that
 	// will trigger a data integrity error in the last block, as the digest
@@ -0,0 +1,16 @@
+//go:build windows
+// +build windows
+
+package local
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewStatfsFreeSpaceProvider creates a FreeSpaceProvider that obtains
+// the amount of free space of the file system containing a given path
+// by calling statfs(). This is not supported on this platform.
+func NewStatfsFreeSpaceProvider(path string) (FreeSpaceProvider, error) {
+	return nil, status.Error(codes.Unimplemented, "Obtaining the amount of free space of a file system is not supported on this platform")
+}
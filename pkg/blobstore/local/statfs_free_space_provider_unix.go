@@ -0,0 +1,32 @@
+//go:build darwin || freebsd || linux
+// +build darwin freebsd linux
+
+package local
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sys/unix"
+)
+
+type statfsFreeSpaceProvider struct {
+	path string
+}
+
+// NewStatfsFreeSpaceProvider creates a FreeSpaceProvider that obtains
+// the amount of free space of the file system containing a given path
+// by calling statfs(). Only space that is available to unprivileged
+// users is reported as being free.
+func NewStatfsFreeSpaceProvider(path string) (FreeSpaceProvider, error) {
+	return &statfsFreeSpaceProvider{
+		path: path,
+	}, nil
+}
+
+func (fsp *statfsFreeSpaceProvider) GetFreeSpace() (uint64, uint64, error) {
+	var stat unix.Statfs_t
+	if err := unix.Statfs(fsp.path, &stat); err != nil {
+		return 0, 0, util.StatusWrapf(err, "Failed to obtain file system statistics of %#v", fsp.path)
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), uint64(stat.Blocks) * uint64(stat.Bsize), nil
+}
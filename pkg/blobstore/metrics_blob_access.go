@@ -28,7 +28,7 @@ var (
 			Help:      "Size of blobs being inserted/retrieved, in bytes.",
 			Buckets:   prometheus.ExponentialBuckets(1.0, 2.0, 33),
 		},
-		[]string{"storage_type", "backend_type", "operation"})
+		[]string{"storage_type", "backend_type", "operation", "digest_function", "placement"})
 	blobAccessOperationsFindMissingBatchSize = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "buildbarn",
@@ -46,18 +46,20 @@ var (
 			Help:      "Amount of time spent per operation on blob access objects, in seconds.",
 			Buckets:   util.DecimalExponentialBuckets(-3, 6, 2),
 		},
-		[]string{"storage_type", "backend_type", "operation", "grpc_code"})
+		[]string{"storage_type", "backend_type", "operation", "digest_function", "grpc_code"})
 )
 
 type metricsBlobAccess struct {
-	blobAccess BlobAccess
-	clock      clock.Clock
+	blobAccess           BlobAccess
+	clock                clock.Clock
+	reportDigestFunction bool
+	inlineThresholdBytes int64
 
-	getBlobSizeBytes                prometheus.Observer
+	getBlobSizeBytes                prometheus.ObserverVec
 	getDurationSeconds              prometheus.ObserverVec
-	getFromCompositeBlobSizeBytes   prometheus.Observer
+	getFromCompositeBlobSizeBytes   prometheus.ObserverVec
 	getFromCompositeDurationSeconds prometheus.ObserverVec
-	putBlobSizeBytes                prometheus.Observer
+	putBlobSizeBytes                prometheus.ObserverVec
 	putDurationSeconds              prometheus.ObserverVec
 	findMissingBatchSize            prometheus.Observer
 	findMissingDurationSeconds      prometheus.ObserverVec
@@ -66,7 +68,20 @@ type metricsBlobAccess struct {
 
 // NewMetricsBlobAccess creates an adapter for BlobAccess that adds
 // basic instrumentation in the form of Prometheus metrics.
-func NewMetricsBlobAccess(blobAccess BlobAccess, clock clock.Clock, storageType, backendType string) BlobAccess {
+//
+// If reportDigestFunction is set, the size and duration metrics of
+// Get(), GetFromComposite() and Put() are labeled with the name of the
+// digest function of the blob (e.g., "SHA256"). As the number of
+// digest functions is small, this only leads to a limited increase in
+// cardinality. In all other cases, the label is left empty.
+//
+// If inlineThresholdBytes is positive, the size metrics of Get(),
+// GetFromComposite() and Put() are labeled with whether the blob would
+// be stored inline ("Inline") or offloaded ("Offloaded") by a backend
+// that offloads blobs larger than the threshold. This can be used to
+// determine such a threshold empirically. If inlineThresholdBytes is
+// zero, the label is left empty.
+func NewMetricsBlobAccess(blobAccess BlobAccess, clock clock.Clock, storageType, backendType string, reportDigestFunction bool, inlineThresholdBytes int64) BlobAccess {
 	blobAccessOperationsPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(blobAccessOperationsBlobSizeBytes)
 		prometheus.MustRegister(blobAccessOperationsFindMissingBatchSize)
@@ -74,14 +89,16 @@ func NewMetricsBlobAccess(blobAccess BlobAccess, clock clock.Clock, storageType,
 	})
 
 	return &metricsBlobAccess{
-		blobAccess: blobAccess,
-		clock:      clock,
+		blobAccess:           blobAccess,
+		clock:                clock,
+		reportDigestFunction: reportDigestFunction,
+		inlineThresholdBytes: inlineThresholdBytes,
 
-		getBlobSizeBytes:                blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "Get"),
+		getBlobSizeBytes:                blobAccessOperationsBlobSizeBytes.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Get"}),
 		getDurationSeconds:              blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Get"}),
-		getFromCompositeBlobSizeBytes:   blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "GetFromComposite"),
+		getFromCompositeBlobSizeBytes:   blobAccessOperationsBlobSizeBytes.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "GetFromComposite"}),
 		getFromCompositeDurationSeconds: blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "GetFromComposite"}),
-		putBlobSizeBytes:                blobAccessOperationsBlobSizeBytes.WithLabelValues(storageType, backendType, "Put"),
+		putBlobSizeBytes:                blobAccessOperationsBlobSizeBytes.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Put"}),
 		putDurationSeconds:              blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "Put"}),
 		findMissingBatchSize:            blobAccessOperationsFindMissingBatchSize.WithLabelValues(storageType, backendType),
 		findMissingDurationSeconds:      blobAccessOperationsDurationSeconds.MustCurryWith(map[string]string{"storage_type": storageType, "backend_type": backendType, "operation": "FindMissing"}),
@@ -89,38 +106,59 @@ func NewMetricsBlobAccess(blobAccess BlobAccess, clock clock.Clock, storageType,
 	}
 }
 
-func (ba *metricsBlobAccess) updateDurationSeconds(vec prometheus.ObserverVec, code codes.Code, timeStart time.Time) {
-	vec.WithLabelValues(code.String()).Observe(ba.clock.Now().Sub(timeStart).Seconds())
+func (ba *metricsBlobAccess) getDigestFunctionLabel(blobDigest digest.Digest) string {
+	if !ba.reportDigestFunction {
+		return ""
+	}
+	return blobDigest.GetDigestFunction().GetEnumValue().String()
+}
+
+func (ba *metricsBlobAccess) getPlacementLabel(sizeBytes int64) string {
+	if ba.inlineThresholdBytes <= 0 {
+		return ""
+	}
+	if sizeBytes <= ba.inlineThresholdBytes {
+		return "Inline"
+	}
+	return "Offloaded"
+}
+
+func (ba *metricsBlobAccess) updateDurationSeconds(vec prometheus.ObserverVec, digestFunction string, code codes.Code, timeStart time.Time) {
+	vec.WithLabelValues(digestFunction, code.String()).Observe(ba.clock.Now().Sub(timeStart).Seconds())
 }
 
 func (ba *metricsBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
+	digestFunction := ba.getDigestFunctionLabel(digest)
 	timeStart := ba.clock.Now()
 	b := buffer.WithErrorHandler(
 		ba.blobAccess.Get(ctx, digest),
 		&metricsErrorHandler{
 			blobAccess:      ba,
 			timeStart:       timeStart,
+			digestFunction:  digestFunction,
 			errorCode:       codes.OK,
 			durationSeconds: ba.getDurationSeconds,
 		})
 	if sizeBytes, err := b.GetSizeBytes(); err == nil {
-		ba.getBlobSizeBytes.Observe(float64(sizeBytes))
+		ba.getBlobSizeBytes.WithLabelValues(digestFunction, ba.getPlacementLabel(sizeBytes)).Observe(float64(sizeBytes))
 	}
 	return b
 }
 
 func (ba *metricsBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	digestFunction := ba.getDigestFunctionLabel(parentDigest)
 	timeStart := ba.clock.Now()
 	b := buffer.WithErrorHandler(
 		ba.blobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
 		&metricsErrorHandler{
 			blobAccess:      ba,
 			timeStart:       timeStart,
+			digestFunction:  digestFunction,
 			errorCode:       codes.OK,
 			durationSeconds: ba.getFromCompositeDurationSeconds,
 		})
 	if sizeBytes, err := b.GetSizeBytes(); err == nil {
-		ba.getFromCompositeBlobSizeBytes.Observe(float64(sizeBytes))
+		ba.getFromCompositeBlobSizeBytes.WithLabelValues(digestFunction, ba.getPlacementLabel(sizeBytes)).Observe(float64(sizeBytes))
 	}
 	return b
 }
@@ -134,11 +172,12 @@ func (ba *metricsBlobAccess) Put(ctx context.Context, digest digest.Digest, b bu
 		b.Discard()
 		return err
 	}
-	ba.putBlobSizeBytes.Observe(float64(sizeBytes))
+	digestFunction := ba.getDigestFunctionLabel(digest)
+	ba.putBlobSizeBytes.WithLabelValues(digestFunction, ba.getPlacementLabel(sizeBytes)).Observe(float64(sizeBytes))
 
 	timeStart := ba.clock.Now()
 	err = ba.blobAccess.Put(ctx, digest, b)
-	ba.updateDurationSeconds(ba.putDurationSeconds, status.Code(err), timeStart)
+	ba.updateDurationSeconds(ba.putDurationSeconds, digestFunction, status.Code(err), timeStart)
 	return err
 }
 
@@ -153,20 +192,21 @@ func (ba *metricsBlobAccess) FindMissing(ctx context.Context, digests digest.Set
 	ba.findMissingBatchSize.Observe(float64(digests.Length()))
 	timeStart := ba.clock.Now()
 	digests, err := ba.blobAccess.FindMissing(ctx, digests)
-	ba.updateDurationSeconds(ba.findMissingDurationSeconds, status.Code(err), timeStart)
+	ba.updateDurationSeconds(ba.findMissingDurationSeconds, "", status.Code(err), timeStart)
 	return digests, err
 }
 
 func (ba *metricsBlobAccess) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
 	timeStart := ba.clock.Now()
 	capabilities, err := ba.blobAccess.GetCapabilities(ctx, instanceName)
-	ba.updateDurationSeconds(ba.getCapabilitiesSeconds, status.Code(err), timeStart)
+	ba.updateDurationSeconds(ba.getCapabilitiesSeconds, "", status.Code(err), timeStart)
 	return capabilities, err
 }
 
 type metricsErrorHandler struct {
 	blobAccess      *metricsBlobAccess
 	timeStart       time.Time
+	digestFunction  string
 	errorCode       codes.Code
 	durationSeconds prometheus.ObserverVec
 }
@@ -177,5 +217,5 @@ func (eh *metricsErrorHandler) OnError(err error) (buffer.Buffer, error) {
 }
 
 func (eh *metricsErrorHandler) Done() {
-	eh.blobAccess.updateDurationSeconds(eh.durationSeconds, eh.errorCode, eh.timeStart)
+	eh.blobAccess.updateDurationSeconds(eh.durationSeconds, eh.digestFunction, eh.errorCode, eh.timeStart)
 }
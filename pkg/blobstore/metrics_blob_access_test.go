@@ -0,0 +1,134 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+// getMetricsBlobAccessLabels returns the label pairs of all metrics
+// in a metric family reported for a given storage type.
+func getMetricsBlobAccessLabels(t *testing.T, metricFamilyName, storageType string) []map[string]string {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	var labels []map[string]string
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != metricFamilyName {
+			continue
+		}
+		for _, metric := range metricFamily.Metric {
+			labelPairs := map[string]string{}
+			for _, labelPair := range metric.Label {
+				labelPairs[labelPair.GetName()] = labelPair.GetValue()
+			}
+			if labelPairs["storage_type"] == storageType {
+				delete(labelPairs, "storage_type")
+				labels = append(labels, labelPairs)
+			}
+		}
+	}
+	return labels
+}
+
+func TestMetricsBlobAccessDigestFunction(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	sha256Digest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	md5Digest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	for _, reportDigestFunction := range []bool{false, true} {
+		// If reporting is disabled, the digest function label
+		// should be left empty.
+		storageType := "metrics_test_disabled"
+		sha256Label, md5Label := "", ""
+		if reportDigestFunction {
+			storageType = "metrics_test_enabled"
+			sha256Label, md5Label = "SHA256", "MD5"
+		}
+
+		baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+		clock := mock.NewMockClock(ctrl)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0)).AnyTimes()
+		blobAccess := blobstore.NewMetricsBlobAccess(baseBlobAccess, clock, storageType, "mock", reportDigestFunction, 0)
+
+		// Perform a successful Get() using SHA-256, a Get() using
+		// MD5 that fails with NOT_FOUND, and a Put() using MD5.
+		baseBlobAccess.EXPECT().Get(ctx, sha256Digest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		_, err := blobAccess.Get(ctx, sha256Digest).ToByteSlice(100)
+		require.NoError(t, err)
+
+		baseBlobAccess.EXPECT().Get(ctx, md5Digest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		_, err = blobAccess.Get(ctx, md5Digest).ToByteSlice(100)
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		baseBlobAccess.EXPECT().Put(ctx, md5Digest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		require.NoError(t, blobAccess.Put(ctx, md5Digest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		expectedLabels := []map[string]string{
+			{"backend_type": "mock", "operation": "Get", "digest_function": sha256Label, "grpc_code": "OK"},
+			{"backend_type": "mock", "operation": "Get", "digest_function": md5Label, "grpc_code": "NotFound"},
+			{"backend_type": "mock", "operation": "Put", "digest_function": md5Label, "grpc_code": "OK"},
+		}
+		require.ElementsMatch(t, expectedLabels, getMetricsBlobAccessLabels(t, "buildbarn_blobstore_blob_access_operations_duration_seconds", storageType))
+	}
+}
+
+func TestMetricsBlobAccessPlacement(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	smallDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	largeDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "2f2ed3a8ed4c59f4b7b2f0dd8c22a0fe", 11)
+
+	for _, inlineThresholdBytes := range []int64{0, 5} {
+		// If no threshold is provided, the placement label
+		// should be left empty.
+		storageType := "metrics_test_placement_disabled"
+		smallLabel, largeLabel := "", ""
+		if inlineThresholdBytes > 0 {
+			storageType = "metrics_test_placement_enabled"
+			smallLabel, largeLabel = "Inline", "Offloaded"
+		}
+
+		baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+		clock := mock.NewMockClock(ctrl)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0)).AnyTimes()
+		blobAccess := blobstore.NewMetricsBlobAccess(baseBlobAccess, clock, storageType, "mock", false, inlineThresholdBytes)
+
+		// Get() a blob whose size is equal to the threshold,
+		// and Put() a blob that is larger.
+		baseBlobAccess.EXPECT().Get(ctx, smallDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		_, err := blobAccess.Get(ctx, smallDigest).ToByteSlice(100)
+		require.NoError(t, err)
+
+		baseBlobAccess.EXPECT().Put(ctx, largeDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		require.NoError(t, blobAccess.Put(ctx, largeDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello world"))))
+
+		expectedLabels := []map[string]string{
+			{"backend_type": "mock", "operation": "Get", "digest_function": "", "placement": smallLabel},
+			{"backend_type": "mock", "operation": "Put", "digest_function": "", "placement": largeLabel},
+		}
+		require.ElementsMatch(t, expectedLabels, getMetricsBlobAccessLabels(t, "buildbarn_blobstore_blob_access_operations_blob_size_bytes", storageType))
+	}
+}
@@ -0,0 +1,68 @@
+package blobstore
+
+import (
+	"io"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	readBufferFactoryPrometheusMetrics sync.Once
+
+	readBufferFactoryDataIntegrityFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "read_buffer_factory_data_integrity_failures_total",
+			Help:      "Number of objects read from storage that failed data integrity checking",
+		},
+		[]string{"storage_type", "digest_function"})
+)
+
+type metricsReadBufferFactory struct {
+	base        ReadBufferFactory
+	storageType string
+}
+
+// NewMetricsReadBufferFactory creates a decorator for ReadBufferFactory
+// that counts the number of objects that fail data integrity checking,
+// using Prometheus. Counts are labeled by digest function, so that
+// failures caused by a broken implementation of a single digest
+// function can be distinguished from storage corruption.
+func NewMetricsReadBufferFactory(base ReadBufferFactory, storageType string) ReadBufferFactory {
+	readBufferFactoryPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(readBufferFactoryDataIntegrityFailures)
+	})
+
+	return &metricsReadBufferFactory{
+		base:        base,
+		storageType: storageType,
+	}
+}
+
+func (f *metricsReadBufferFactory) wrapDataIntegrityCallback(blobDigest digest.Digest, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.DataIntegrityCallback {
+	return func(dataIsValid bool) {
+		if !dataIsValid {
+			readBufferFactoryDataIntegrityFailures.WithLabelValues(
+				f.storageType,
+				blobDigest.GetDigestFunction().GetEnumValue().String(),
+			).Inc()
+		}
+		dataIntegrityCallback(dataIsValid)
+	}
+}
+
+func (f *metricsReadBufferFactory) NewBufferFromByteSlice(blobDigest digest.Digest, data []byte, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return f.base.NewBufferFromByteSlice(blobDigest, data, f.wrapDataIntegrityCallback(blobDigest, dataIntegrityCallback))
+}
+
+func (f *metricsReadBufferFactory) NewBufferFromReader(blobDigest digest.Digest, r io.ReadCloser, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return f.base.NewBufferFromReader(blobDigest, r, f.wrapDataIntegrityCallback(blobDigest, dataIntegrityCallback))
+}
+
+func (f *metricsReadBufferFactory) NewBufferFromReaderAt(blobDigest digest.Digest, r buffer.ReadAtCloser, sizeBytes int64, dataIntegrityCallback buffer.DataIntegrityCallback) buffer.Buffer {
+	return f.base.NewBufferFromReaderAt(blobDigest, r, sizeBytes, f.wrapDataIntegrityCallback(blobDigest, dataIntegrityCallback))
+}
@@ -0,0 +1,92 @@
+package blobstore_test
+
+import (
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+// getDataIntegrityFailures returns the number of data integrity
+// failures reported for a given storage type, keyed by digest function.
+func getDataIntegrityFailures(t *testing.T, storageType string) map[string]float64 {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	failures := map[string]float64{}
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "buildbarn_blobstore_read_buffer_factory_data_integrity_failures_total" {
+			continue
+		}
+		for _, metric := range metricFamily.Metric {
+			labelPairs := map[string]string{}
+			for _, labelPair := range metric.Label {
+				labelPairs[labelPair.GetName()] = labelPair.GetValue()
+			}
+			if labelPairs["storage_type"] == storageType {
+				failures[labelPairs["digest_function"]] = metric.Counter.GetValue()
+			}
+		}
+	}
+	return failures
+}
+
+func TestMetricsReadBufferFactory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	readBufferFactory := blobstore.NewMetricsReadBufferFactory(blobstore.CASReadBufferFactory, "metrics_read_buffer_factory_test")
+
+	t.Run("ValidData", func(t *testing.T) {
+		// Successful validation should not be counted.
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(true)
+
+		data, err := readBufferFactory.NewBufferFromByteSlice(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5),
+			[]byte("Hello"),
+			dataIntegrityCallback.Call,
+		).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.Empty(t, getDataIntegrityFailures(t, "metrics_read_buffer_factory_test"))
+	})
+
+	t.Run("SHA256Failure", func(t *testing.T) {
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(false)
+
+		_, err := readBufferFactory.NewBufferFromByteSlice(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5),
+			[]byte("Jello"),
+			dataIntegrityCallback.Call,
+		).ToByteSlice(100)
+		require.Error(t, err)
+		require.Equal(t, map[string]float64{
+			"SHA256": 1,
+		}, getDataIntegrityFailures(t, "metrics_read_buffer_factory_test"))
+	})
+
+	t.Run("SHA1Failure", func(t *testing.T) {
+		// Failures for other digest functions should be counted
+		// separately.
+		dataIntegrityCallback := mock.NewMockDataIntegrityCallback(ctrl)
+		dataIntegrityCallback.EXPECT().Call(false)
+
+		_, err := readBufferFactory.NewBufferFromByteSlice(
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA1, "f7ff9e8b7bb2e09b70935a5d785e0cc5d9d0abf0", 5),
+			[]byte("Jello"),
+			dataIntegrityCallback.Call,
+		).ToByteSlice(100)
+		require.Error(t, err)
+		require.Equal(t, map[string]float64{
+			"SHA1":   1,
+			"SHA256": 1,
+		}, getDataIntegrityFailures(t, "metrics_read_buffer_factory_test"))
+	})
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/prometheus/client_golang/prometheus"
@@ -33,6 +35,67 @@ var (
 		[]string{"direction"})
 	mirroredBlobAccessFindMissingSynchronizationsFromAToB = mirroredBlobAccessFindMissingSynchronizations.WithLabelValues("FromAToB")
 	mirroredBlobAccessFindMissingSynchronizationsFromBToA = mirroredBlobAccessFindMissingSynchronizations.WithLabelValues("FromBToA")
+
+	mirroredBlobAccessHedgedReads = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "mirrored_blob_access_hedged_reads_total",
+			Help:      "Number of Get() operations for which a hedged request was sent to the other backend",
+		},
+		[]string{"winner"})
+	mirroredBlobAccessHedgedReadsWinnerInitial = mirroredBlobAccessHedgedReads.WithLabelValues("Initial")
+	mirroredBlobAccessHedgedReadsWinnerHedged  = mirroredBlobAccessHedgedReads.WithLabelValues("Hedged")
+	mirroredBlobAccessHedgedReadsWinnerNone    = mirroredBlobAccessHedgedReads.WithLabelValues("None")
+)
+
+// HedgingPolicy controls whether Get() operations against
+// MirroredBlobAccess are hedged. When hedging is enabled, a Get()
+// operation is first sent to one of the backends. If that backend has
+// not returned the object after Delay has passed, the same request is
+// sent to the other backend as well. The first response to arrive is
+// returned, while the other request is canceled.
+//
+// As hedged requests need to be able to discard a partially received
+// response, objects are loaded into memory. Objects whose digest
+// indicates a size larger than MaximumSizeBytes are never hedged. For
+// storage types other than the Content Addressable Storage (CAS), the
+// size in the digest is that of the key, as opposed to the object that
+// is stored. If the object returned by a backend turns out to be
+// larger than MaximumSizeBytes, the request is retried without
+// hedging.
+//
+// The zero value disables hedging.
+type HedgingPolicy struct {
+	Clock            clock.Clock
+	Delay            time.Duration
+	MaximumSizeBytes int
+}
+
+// ReadRepairPolicy controls which backends are written to when an
+// object is only present in one of the backends, or when an object is
+// uploaded through Put().
+//
+// There is no policy that repairs objects up to a quorum of backends.
+// With only two backends, a quorum consists of both of them, making
+// such a policy identical to ReadRepairAllMissing.
+type ReadRepairPolicy int
+
+const (
+	// ReadRepairAllMissing causes objects to be replicated to any
+	// backend from which they are missing. Put() writes objects
+	// into both backends.
+	ReadRepairAllMissing ReadRepairPolicy = iota
+	// ReadRepairBackendAOnly causes objects to only be written into
+	// backend A, both when replicating and calling Put(). Objects
+	// that are missing from backend B are read from backend A
+	// without being replicated. This limits the amount of writes
+	// performed against backend B, at the cost of reads of these
+	// objects always being served by backend A.
+	ReadRepairBackendAOnly
+	// ReadRepairBackendBOnly is identical to ReadRepairBackendAOnly,
+	// except that the roles of the backends are swapped.
+	ReadRepairBackendBOnly
 )
 
 type mirroredBlobAccess struct {
@@ -40,6 +103,8 @@ type mirroredBlobAccess struct {
 	backendB       blobstore.BlobAccess
 	replicatorAToB replication.BlobReplicator
 	replicatorBToA replication.BlobReplicator
+	hedgingPolicy  HedgingPolicy
+	putToA, putToB bool
 	round          atomic.Uint32
 }
 
@@ -48,35 +113,75 @@ type mirroredBlobAccess struct {
 // inconsistencies between the two storage backends are detected (i.e.,
 // a blob is only present in one of the backends), the blob is
 // replicated.
-func NewMirroredBlobAccess(backendA, backendB blobstore.BlobAccess, replicatorAToB, replicatorBToA replication.BlobReplicator) blobstore.BlobAccess {
+//
+// Get() operations may optionally be hedged across both backends, as
+// described by HedgingPolicy. The backends to which objects are
+// replicated are determined by ReadRepairPolicy.
+func NewMirroredBlobAccess(backendA, backendB blobstore.BlobAccess, replicatorAToB, replicatorBToA replication.BlobReplicator, hedgingPolicy HedgingPolicy, readRepairPolicy ReadRepairPolicy) blobstore.BlobAccess {
 	mirroredBlobAccessPrometheusMetrics.Do(func() {
 		prometheus.MustRegister(mirroredBlobAccessFindMissingSynchronizations)
+		prometheus.MustRegister(mirroredBlobAccessHedgedReads)
 	})
 
+	putToA, putToB := true, true
+	switch readRepairPolicy {
+	case ReadRepairBackendAOnly:
+		// Never write objects into backend B. Objects that
+		// are missing from backend B are merely read from
+		// backend A.
+		replicatorAToB = replication.NewNoopBlobReplicator(backendA)
+		putToB = false
+	case ReadRepairBackendBOnly:
+		replicatorBToA = replication.NewNoopBlobReplicator(backendB)
+		putToA = false
+	}
 	return &mirroredBlobAccess{
 		backendA:       backendA,
 		backendB:       backendB,
 		replicatorAToB: replicatorAToB,
 		replicatorBToA: replicatorBToA,
+		hedgingPolicy:  hedgingPolicy,
+		putToA:         putToA,
+		putToB:         putToB,
 	}
 }
 
-func (ba *mirroredBlobAccess) getBlobReplicatorSelector() (blobstore.BlobAccess, replication.BlobReplicatorSelector) {
+// mirroredBackendOrder contains the order in which backends are
+// consulted by a single Get() or GetFromComposite() operation.
+type mirroredBackendOrder struct {
+	firstBackend, secondBackend         blobstore.BlobAccess
+	firstBackendName, secondBackendName string
+	replicator                          replication.BlobReplicator
+}
+
+func (ba *mirroredBlobAccess) getBackendOrder() mirroredBackendOrder {
 	// Alternate requests between storage backends.
-	var firstBackend blobstore.BlobAccess
-	var firstBackendName, secondBackendName string
-	var replicator replication.BlobReplicator
-	if ba.round.Add(1)%2 == 1 {
-		firstBackend = ba.backendA
-		firstBackendName, secondBackendName = "Backend A", "Backend B"
-		replicator = ba.replicatorBToA
-	} else {
-		firstBackend = ba.backendB
-		firstBackendName, secondBackendName = "Backend B", "Backend A"
-		replicator = ba.replicatorAToB
+	return ba.newBackendOrder(ba.round.Add(1)%2 == 1)
+}
+
+func (ba *mirroredBlobAccess) newBackendOrder(backendAFirst bool) mirroredBackendOrder {
+	if backendAFirst {
+		return mirroredBackendOrder{
+			firstBackend:      ba.backendA,
+			secondBackend:     ba.backendB,
+			firstBackendName:  "Backend A",
+			secondBackendName: "Backend B",
+			replicator:        ba.replicatorBToA,
+		}
+	}
+	return mirroredBackendOrder{
+		firstBackend:      ba.backendB,
+		secondBackend:     ba.backendA,
+		firstBackendName:  "Backend B",
+		secondBackendName: "Backend A",
+		replicator:        ba.replicatorAToB,
 	}
+}
 
-	return firstBackend, func(observedErr error) (replication.BlobReplicator, error) {
+func (o *mirroredBackendOrder) getBlobReplicatorSelector() replication.BlobReplicatorSelector {
+	firstBackendName, secondBackendName := o.firstBackendName, o.secondBackendName
+	replicator := o.replicator
+	return func(observedErr error) (replication.BlobReplicator, error) {
 		// A fatal error occurred. Prepend the name of the
 		// backend that triggered the error.
 		if status.Code(observedErr) != codes.NotFound {
@@ -102,16 +207,141 @@ func (ba *mirroredBlobAccess) getBlobReplicatorSelector() (blobstore.BlobAccess,
 }
 
 func (ba *mirroredBlobAccess) Get(ctx context.Context, digest digest.Digest) buffer.Buffer {
-	firstBackend, successiveBackends := ba.getBlobReplicatorSelector()
-	return replication.GetWithBlobReplicator(ctx, digest, firstBackend, successiveBackends)
+	order := ba.getBackendOrder()
+	if ba.hedgingPolicy.Delay > 0 && digest.GetSizeBytes() <= int64(ba.hedgingPolicy.MaximumSizeBytes) {
+		return ba.getHedged(ctx, digest, &order)
+	}
+	return ba.getUnhedged(ctx, digest, &order)
+}
+
+func (ba *mirroredBlobAccess) getUnhedged(ctx context.Context, digest digest.Digest, order *mirroredBackendOrder) buffer.Buffer {
+	return replication.GetWithBlobReplicator(ctx, digest, order.firstBackend, order.getBlobReplicatorSelector())
+}
+
+// hedgedReadResult contains the outcome of a single Get() operation
+// that is performed as part of a hedged read.
+type hedgedReadResult struct {
+	data     []byte
+	err      error
+	hedged   bool
+	tooLarge bool
+}
+
+func (ba *mirroredBlobAccess) getHedged(ctx context.Context, digest digest.Digest, order *mirroredBackendOrder) buffer.Buffer {
+	// Both requests get their own context, so that the request
+	// that loses the race can be canceled.
+	results := make(chan hedgedReadResult, 2)
+	read := func(ctx context.Context, backend blobstore.BlobAccess, hedged bool) {
+		b := backend.Get(ctx, digest)
+		if sizeBytes, err := b.GetSizeBytes(); err == nil && sizeBytes > int64(ba.hedgingPolicy.MaximumSizeBytes) {
+			// The object is too large to be loaded into
+			// memory. This may happen for storage types
+			// other than the CAS, where the digest is that
+			// of the key.
+			b.Discard()
+			results <- hedgedReadResult{hedged: hedged, tooLarge: true}
+			return
+		}
+		data, err := b.ToByteSlice(ba.hedgingPolicy.MaximumSizeBytes)
+		results <- hedgedReadResult{data: data, err: err, hedged: hedged}
+	}
+	firstCtx, firstCancel := context.WithCancel(ctx)
+	defer firstCancel()
+	go read(firstCtx, order.firstBackend, false)
+
+	timer, timerChannel := ba.hedgingPolicy.Clock.NewTimer(ba.hedgingPolicy.Delay)
+	select {
+	case result := <-results:
+		// The first backend responded in time. Process the
+		// response as if hedging was disabled, meaning that
+		// objects absent in the first backend are replicated.
+		timer.Stop()
+		if result.tooLarge {
+			return ba.getUnhedged(ctx, digest, order)
+		}
+		if result.err == nil {
+			return buffer.NewValidatedBufferFromByteSlice(result.data)
+		}
+		replicator, err := order.getBlobReplicatorSelector()(result.err)
+		if err != nil {
+			return buffer.NewBufferFromError(err)
+		}
+		return replicator.ReplicateSingle(ctx, digest)
+	case <-timerChannel:
+	}
+
+	// The first backend is slow. Send the same request to the
+	// second backend and return whichever response arrives first.
+	// No replication is performed, as the first backend being slow
+	// is no indication that the object is absent.
+	secondCtx, secondCancel := context.WithCancel(ctx)
+	defer secondCancel()
+	go read(secondCtx, order.secondBackend, true)
+
+	var errs [2]error
+	for i := 0; i < 2; i++ {
+		result := <-results
+		if result.tooLarge {
+			// The object exists, but cannot be hedged.
+			// Cancel both requests and retry without
+			// hedging, starting with the backend that
+			// responded.
+			firstCancel()
+			secondCancel()
+			if result.hedged {
+				retryOrder := ba.newBackendOrder(order.firstBackend != ba.backendA)
+				return ba.getUnhedged(ctx, digest, &retryOrder)
+			}
+			return ba.getUnhedged(ctx, digest, order)
+		}
+		if result.err == nil {
+			if result.hedged {
+				mirroredBlobAccessHedgedReadsWinnerHedged.Inc()
+			} else {
+				mirroredBlobAccessHedgedReadsWinnerInitial.Inc()
+			}
+			return buffer.NewValidatedBufferFromByteSlice(result.data)
+		}
+		if result.hedged {
+			errs[1] = result.err
+		} else {
+			errs[0] = result.err
+		}
+	}
+	mirroredBlobAccessHedgedReadsWinnerNone.Inc()
+
+	// Both backends failed. Prefer returning errors other than
+	// NotFound, prefixed with the name of the backend.
+	if status.Code(errs[0]) != codes.NotFound {
+		return buffer.NewBufferFromError(util.StatusWrap(errs[0], order.firstBackendName))
+	}
+	if status.Code(errs[1]) != codes.NotFound {
+		return buffer.NewBufferFromError(util.StatusWrap(errs[1], order.secondBackendName))
+	}
+	return buffer.NewBufferFromError(errs[0])
 }
 
 func (ba *mirroredBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
-	firstBackend, successiveBackends := ba.getBlobReplicatorSelector()
-	return replication.GetFromCompositeWithBlobReplicator(ctx, parentDigest, childDigest, slicer, firstBackend, successiveBackends)
+	order := ba.getBackendOrder()
+	return replication.GetFromCompositeWithBlobReplicator(ctx, parentDigest, childDigest, slicer, order.firstBackend, order.getBlobReplicatorSelector())
 }
 
 func (ba *mirroredBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	// Only store the object in the backend to which objects are
+	// replicated, if the read repair policy requires it.
+	if !ba.putToB {
+		if err := ba.backendA.Put(ctx, digest, b); err != nil {
+			return util.StatusWrap(err, "Backend A")
+		}
+		return nil
+	}
+	if !ba.putToA {
+		if err := ba.backendB.Put(ctx, digest, b); err != nil {
+			return util.StatusWrap(err, "Backend B")
+		}
+		return nil
+	}
+
 	// Store object in both storage backends.
 	b1, b2 := b.CloneStream()
 	group, groupCtx := errgroup.WithContext(ctx)
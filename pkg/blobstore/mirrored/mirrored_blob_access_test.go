@@ -1,15 +1,21 @@
 package mirrored_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/mirrored"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/grpc/codes"
@@ -36,7 +42,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 			backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world"))),
 		)
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 		for i := 0; i < 3; i++ {
 			data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 			require.NoError(t, err)
@@ -51,7 +57,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Blob not found"), err)
 	})
@@ -62,7 +68,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		require.NoError(t, err)
 		require.Equal(t, []byte("Hello world"), data)
@@ -73,7 +79,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 
 		// In case of fatal errors, the name of the backend
 		// should be prepended.
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend A: Server on fire"), err)
 	})
@@ -82,7 +88,7 @@ func TestMirroredBlobAccessGet(t *testing.T) {
 		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Internal, "Server on fire")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
 		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend B: Server on fire"), err)
 	})
@@ -106,7 +112,7 @@ func TestMirroredBlobAccessGetFromComposite(t *testing.T) {
 		backendA.EXPECT().GetFromComposite(ctx, parentDigest, childDigest, slicer).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
 		replicatorBToA.EXPECT().ReplicateComposite(ctx, parentDigest, childDigest, slicer).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
 
-		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 		data, err := blobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer).ToByteSlice(100)
 		require.NoError(t, err)
 		require.Equal(t, []byte("Hello world"), data)
@@ -121,7 +127,7 @@ func TestMirroredBlobAccessPut(t *testing.T) {
 	replicatorAToB := mock.NewMockBlobReplicator(ctrl)
 	replicatorBToA := mock.NewMockBlobReplicator(ctrl)
 	blobDigest := digest.MustNewDigest("default", remoteexecution.DigestFunction_SHA256, "64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", 11)
-	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 
 	t.Run("Success", func(t *testing.T) {
 		backendA.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
@@ -177,6 +183,36 @@ func TestMirroredBlobAccessPut(t *testing.T) {
 			status.Error(codes.Internal, "Backend B: Server on fire"),
 			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello world"))))
 	})
+
+	t.Run("BackendTimeout", func(t *testing.T) {
+		// If a per-backend timeout is configured, a backend
+		// that hangs should cause the operation to fail, as
+		// opposed to blocking indefinitely.
+		blobAccess := mirrored.NewMirroredBlobAccess(
+			backendA,
+			blobstore.NewTimeoutBlobAccess(backendB, clock.SystemClock, 10*time.Millisecond),
+			replicatorAToB,
+			replicatorBToA,
+			mirrored.HedgingPolicy{},
+			mirrored.ReadRepairAllMissing)
+
+		backendA.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		backendB.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				<-ctx.Done()
+				return util.StatusFromContext(ctx)
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.DeadlineExceeded, "Backend B: Backend did not complete the operation within 10ms"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello world"))))
+	})
 }
 
 func TestMirroredBlobAccessFindMissing(t *testing.T) {
@@ -195,7 +231,7 @@ func TestMirroredBlobAccessFindMissing(t *testing.T) {
 	onlyOnB := digestB.ToSingletonSet()
 	missingFromA := digest.NewSetBuilder().Add(digestNone).Add(digestB).Build()
 	missingFromB := digest.NewSetBuilder().Add(digestNone).Add(digestA).Build()
-	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA)
+	blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, mirrored.HedgingPolicy{}, mirrored.ReadRepairAllMissing)
 
 	t.Run("Success", func(t *testing.T) {
 		// Listings of both backends should be requested.
@@ -274,3 +310,315 @@ func TestMirroredBlobAccessFindMissing(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend B returned inconsistent results while synchronizing: Object 522b44d647b6989f60302ef755c277e508d5bcc38f05e139906ebdb03a5b19f2 not found"), err)
 	})
 }
+
+func TestMirroredBlobAccessGetHedging(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	backendA := mock.NewMockBlobAccess(ctrl)
+	backendB := mock.NewMockBlobAccess(ctrl)
+	replicatorAToB := mock.NewMockBlobReplicator(ctrl)
+	replicatorBToA := mock.NewMockBlobReplicator(ctrl)
+	hedgingClock := mock.NewMockClock(ctrl)
+	blobDigest := digest.MustNewDigest("default", remoteexecution.DigestFunction_SHA256, "64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", 11)
+	hedgingPolicy := mirrored.HedgingPolicy{
+		Clock:            hedgingClock,
+		Delay:            100 * time.Millisecond,
+		MaximumSizeBytes: 100,
+	}
+
+	t.Run("FirstBackendFast", func(t *testing.T) {
+		// If the first backend responds before the delay has
+		// passed, no request should be sent to the second
+		// backend.
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		timer := mock.NewMockTimer(ctrl)
+		hedgingClock.EXPECT().NewTimer(100*time.Millisecond).Return(timer, make(chan time.Time))
+		timer.EXPECT().Stop().Return(true)
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, hedgingPolicy, mirrored.ReadRepairAllMissing)
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("FirstBackendNotFound", func(t *testing.T) {
+		// If the first backend reports the object as absent
+		// before the delay has passed, the object should be
+		// replicated, just like when hedging is disabled.
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found")))
+		timer := mock.NewMockTimer(ctrl)
+		hedgingClock.EXPECT().NewTimer(100*time.Millisecond).Return(timer, make(chan time.Time))
+		timer.EXPECT().Stop().Return(true)
+		replicatorBToA.EXPECT().ReplicateSingle(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, hedgingPolicy, mirrored.ReadRepairAllMissing)
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("FirstBackendSlow", func(t *testing.T) {
+		// If the first backend does not respond before the
+		// delay has passed, the request should also be sent to
+		// the second backend. Once the second backend responds,
+		// the request to the first backend should be canceled.
+		// No replication should take place.
+		firstBackendCanceled := make(chan struct{})
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest) buffer.Buffer {
+				<-ctx.Done()
+				close(firstBackendCanceled)
+				return buffer.NewBufferFromError(util.StatusFromContext(ctx))
+			})
+		timerChannel := make(chan time.Time, 1)
+		hedgingClock.EXPECT().NewTimer(100 * time.Millisecond).DoAndReturn(
+			func(d time.Duration) (clock.Timer, <-chan time.Time) {
+				timerChannel <- time.Unix(1000, 0)
+				return mock.NewMockTimer(ctrl), timerChannel
+			})
+		backendB.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, hedgingPolicy, mirrored.ReadRepairAllMissing)
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+		<-firstBackendCanceled
+	})
+
+	t.Run("BothBackendsFail", func(t *testing.T) {
+		// If both backends fail, errors other than NotFound
+		// should take precedence. As the first backend only
+		// responds after the delay, no replication should be
+		// performed.
+		secondBackendCalled := make(chan struct{})
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest) buffer.Buffer {
+				<-secondBackendCalled
+				return buffer.NewBufferFromError(status.Error(codes.NotFound, "Blob not found"))
+			})
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1000, 0)
+		hedgingClock.EXPECT().NewTimer(100*time.Millisecond).Return(mock.NewMockTimer(ctrl), timerChannel)
+		backendB.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest) buffer.Buffer {
+				close(secondBackendCalled)
+				return buffer.NewBufferFromError(status.Error(codes.Internal, "Server on fire"))
+			})
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, hedgingPolicy, mirrored.ReadRepairAllMissing)
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend B: Server on fire"), err)
+	})
+
+	t.Run("LargeObject", func(t *testing.T) {
+		// Objects larger than the maximum size should not be
+		// hedged.
+		largeDigest := digest.MustNewDigest("default", remoteexecution.DigestFunction_SHA256, "3e25960a79dbc69b674cd4ec67a72c62a7a7ea2dc8c2d7d3c9c0b1c0e4c0b4e0", 1000)
+		backendA.EXPECT().Get(ctx, largeDigest).Return(buffer.NewBufferFromError(status.Error(codes.Internal, "Server on fire")))
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, hedgingPolicy, mirrored.ReadRepairAllMissing)
+		_, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(2000)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Backend A: Server on fire"), err)
+	})
+
+	t.Run("LargeActionResult", func(t *testing.T) {
+		// For the Action Cache, the digest describes the key,
+		// not the entry that is stored. Entries larger than
+		// the maximum size should be read without hedging.
+		largeActionResult := &remoteexecution.ActionResult{
+			StdoutRaw: bytes.Repeat([]byte("x"), 200),
+		}
+		gomock.InOrder(
+			backendA.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewProtoBufferFromProto(largeActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest)))),
+			backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(largeActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest)))))
+		timer := mock.NewMockTimer(ctrl)
+		hedgingClock.EXPECT().NewTimer(100*time.Millisecond).Return(timer, make(chan time.Time))
+		timer.EXPECT().Stop().Return(true)
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, hedgingPolicy, mirrored.ReadRepairAllMissing)
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, largeActionResult, m)
+	})
+
+	t.Run("LargeActionResultFirstBackendSlow", func(t *testing.T) {
+		// The same holds if the entry is returned by the
+		// second backend after hedging. The retry should be
+		// sent to the second backend, as the first backend is
+		// slow.
+		largeActionResult := &remoteexecution.ActionResult{
+			StdoutRaw: bytes.Repeat([]byte("x"), 200),
+		}
+		firstBackendCanceled := make(chan struct{})
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest) buffer.Buffer {
+				<-ctx.Done()
+				close(firstBackendCanceled)
+				return buffer.NewBufferFromError(util.StatusFromContext(ctx))
+			})
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1000, 0)
+		hedgingClock.EXPECT().NewTimer(100*time.Millisecond).Return(mock.NewMockTimer(ctrl), timerChannel)
+		gomock.InOrder(
+			backendB.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewProtoBufferFromProto(largeActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest)))),
+			backendB.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(largeActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest)))))
+
+		blobAccess := mirrored.NewMirroredBlobAccess(backendA, backendB, replicatorAToB, replicatorBToA, hedgingPolicy, mirrored.ReadRepairAllMissing)
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, largeActionResult, m)
+		<-firstBackendCanceled
+	})
+}
+
+func TestMirroredBlobAccessReadRepairPolicy(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobDigest := digest.MustNewDigest("default", remoteexecution.DigestFunction_SHA256, "64ec88ca00b268e5ba1a35678a1b5316d212f4f366b2477232534a8aeca37f3c", 11)
+
+	// Use actual replicators, so that it can be observed to which
+	// backends repair Put() calls are sent.
+	newBlobAccess := func(readRepairPolicy mirrored.ReadRepairPolicy) (blobstore.BlobAccess, *mock.MockBlobAccess, *mock.MockBlobAccess) {
+		backendA := mock.NewMockBlobAccess(ctrl)
+		backendB := mock.NewMockBlobAccess(ctrl)
+		blobAccess := mirrored.NewMirroredBlobAccess(
+			backendA,
+			backendB,
+			replication.NewLocalBlobReplicator(backendA, backendB),
+			replication.NewLocalBlobReplicator(backendB, backendA),
+			mirrored.HedgingPolicy{},
+			readRepairPolicy)
+		return blobAccess, backendA, backendB
+	}
+	expectPut := func(backend *mock.MockBlobAccess) {
+		backend.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello world"), data)
+				return nil
+			})
+	}
+
+	t.Run("AllMissingFindMissing", func(t *testing.T) {
+		// Objects missing from either backend should be repaired.
+		blobAccess, backendA, backendB := newBlobAccess(mirrored.ReadRepairAllMissing)
+		backendA.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		expectPut(backendB)
+
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+
+	t.Run("AllMissingGet", func(t *testing.T) {
+		// The first Get() call is sent to backend A. The second
+		// one is sent to backend B, which causes the object to
+		// be replicated from backend A.
+		blobAccess, backendA, backendB := newBlobAccess(mirrored.ReadRepairAllMissing)
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+
+		backendB.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		expectPut(backendB)
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("BackendAOnlyFindMissingRepairsA", func(t *testing.T) {
+		// Objects missing from backend A should still be
+		// repaired.
+		blobAccess, backendA, backendB := newBlobAccess(mirrored.ReadRepairBackendAOnly)
+		backendA.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		backendB.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		expectPut(backendA)
+
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+
+	t.Run("BackendAOnlyFindMissingSkipsB", func(t *testing.T) {
+		// Objects missing from backend B should not be
+		// repaired. As they are present in backend A, they
+		// should not be reported as missing.
+		blobAccess, backendA, backendB := newBlobAccess(mirrored.ReadRepairBackendAOnly)
+		backendA.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+
+	t.Run("BackendAOnlyGet", func(t *testing.T) {
+		// Get() calls against backend B that fail with
+		// NOT_FOUND should be retried against backend A,
+		// without writing the object into backend B.
+		blobAccess, backendA, backendB := newBlobAccess(mirrored.ReadRepairBackendAOnly)
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+
+		backendB.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("BackendAOnlyPut", func(t *testing.T) {
+		// Objects should only be written into backend A.
+		blobAccess, backendA, _ := newBlobAccess(mirrored.ReadRepairBackendAOnly)
+		expectPut(backendA)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello world"))))
+	})
+
+	t.Run("BackendBOnlyFindMissing", func(t *testing.T) {
+		// Objects missing from backend B should be repaired,
+		// while objects missing from backend A should not.
+		blobAccess, backendA, backendB := newBlobAccess(mirrored.ReadRepairBackendBOnly)
+		backendA.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+		backendA.EXPECT().Get(gomock.Any(), blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		expectPut(backendB)
+
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+
+		backendA.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(blobDigest.ToSingletonSet(), nil)
+		backendB.EXPECT().FindMissing(gomock.Any(), blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+
+		missing, err = blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+
+	t.Run("BackendBOnlyGet", func(t *testing.T) {
+		// Get() calls against backend A that fail with
+		// NOT_FOUND should be retried against backend B,
+		// without writing the object into backend A.
+		blobAccess, backendA, backendB := newBlobAccess(mirrored.ReadRepairBackendBOnly)
+		backendA.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		backendB.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello world")))
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello world"), data)
+	})
+
+	t.Run("BackendBOnlyPut", func(t *testing.T) {
+		// Objects should only be written into backend B.
+		blobAccess, _, backendB := newBlobAccess(mirrored.ReadRepairBackendBOnly)
+		expectPut(backendB)
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello world"))))
+	})
+}
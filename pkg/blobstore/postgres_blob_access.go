@@ -0,0 +1,209 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// PostgresBlob contains the properties of a blob stored in a
+// PostgreSQL table, as returned by PostgresQueries.GetBlob().
+type PostgresBlob struct {
+	// Contents of the blob, if stored inline in a bytea column.
+	Data []byte
+	// Object identifier of the large object holding the contents
+	// of the blob, if stored using the large-object API. Zero if
+	// the contents of the blob are stored inline.
+	LargeObject uint32
+	// Size of the blob in bytes.
+	SizeBytes int64
+}
+
+// PostgresQueries is the query layer used by PostgresBlobAccess to
+// access a PostgreSQL table containing blobs. Each row in the table has
+// a reference time, which is updated every time the blob is accessed.
+// Blobs whose reference time lies before a given minimum reference time
+// are considered to be expired.
+type PostgresQueries interface {
+	// GetBlob returns the properties of the blob stored under a
+	// given key, and updates its reference time. NotFound is
+	// returned if the blob does not exist or has expired.
+	GetBlob(ctx context.Context, key string, minimumReferenceTime, now time.Time) (PostgresBlob, error)
+	// ReadLargeObject reads data from a large object at a given
+	// offset, using the same semantics as io.ReaderAt.
+	ReadLargeObject(ctx context.Context, largeObject uint32, p []byte, offset int64) (int, error)
+	// PutInlineBlob stores a blob under a given key inline in a
+	// bytea column. If a blob is already stored under the same key
+	// using the large-object API, only its reference time is
+	// updated.
+	PutInlineBlob(ctx context.Context, key string, data []byte, now time.Time) error
+	// PutLargeBlob stores a blob under a given key using the
+	// large-object API. The contents of the blob are read from a
+	// reader and written into the large object in chunks. If a blob
+	// is already stored under the same key, only its reference time
+	// is updated.
+	PutLargeBlob(ctx context.Context, key string, sizeBytes int64, r io.Reader, now time.Time) error
+	// TouchBlobs updates the reference time of the blobs stored
+	// under a set of keys. It returns the keys of the blobs that
+	// exist and have not expired.
+	TouchBlobs(ctx context.Context, keys []string, minimumReferenceTime, now time.Time) ([]string, error)
+}
+
+type postgresBlobAccess struct {
+	capabilities.Provider
+	queries                   PostgresQueries
+	readBufferFactory         ReadBufferFactory
+	digestKeyFormat           digest.KeyFormat
+	clock                     clock.Clock
+	expiration                time.Duration
+	largeObjectThresholdBytes int64
+	findMissingBatchSize      int
+}
+
+// NewPostgresBlobAccess creates a BlobAccess that stores blobs in a
+// PostgreSQL table, keyed by digest. Small blobs are stored inline in a
+// bytea column. Blobs larger than a given threshold are stored using
+// PostgreSQL's large-object API, so that they can be read in chunks.
+//
+// If a non-zero expiration is provided, blobs that have not been
+// accessed for the duration of the expiration are considered to be
+// absent. Removing such blobs from the table is left to the operator.
+//
+// Calls to FindMissing() are translated to one query per batch of at
+// most findMissingBatchSize digests.
+func NewPostgresBlobAccess(capabilitiesProvider capabilities.Provider, queries PostgresQueries, readBufferFactory ReadBufferFactory, digestKeyFormat digest.KeyFormat, clock clock.Clock, expiration time.Duration, largeObjectThresholdBytes int64, findMissingBatchSize int) BlobAccess {
+	return &postgresBlobAccess{
+		Provider:                  capabilitiesProvider,
+		queries:                   queries,
+		readBufferFactory:         readBufferFactory,
+		digestKeyFormat:           digestKeyFormat,
+		clock:                     clock,
+		expiration:                expiration,
+		largeObjectThresholdBytes: largeObjectThresholdBytes,
+		findMissingBatchSize:      findMissingBatchSize,
+	}
+}
+
+// getReferenceTimes returns the current time, and the minimum
+// reference time blobs need to have to not be considered expired.
+func (ba *postgresBlobAccess) getReferenceTimes() (minimumReferenceTime, now time.Time) {
+	now = ba.clock.Now()
+	if ba.expiration > 0 {
+		minimumReferenceTime = now.Add(-ba.expiration)
+	}
+	return
+}
+
+func (ba *postgresBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	minimumReferenceTime, now := ba.getReferenceTimes()
+	blob, err := ba.queries.GetBlob(ctx, blobDigest.GetKey(ba.digestKeyFormat), minimumReferenceTime, now)
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	if blob.LargeObject == 0 {
+		return ba.readBufferFactory.NewBufferFromByteSlice(
+			blobDigest,
+			blob.Data,
+			buffer.Irreparable(blobDigest))
+	}
+	return ba.readBufferFactory.NewBufferFromReaderAt(
+		blobDigest,
+		&postgresLargeObjectReader{
+			ctx:         ctx,
+			queries:     ba.queries,
+			largeObject: blob.LargeObject,
+		},
+		blob.SizeBytes,
+		buffer.Irreparable(blobDigest))
+}
+
+func (ba *postgresBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.Get(ctx, parentDigest), childDigest)
+	return b
+}
+
+func (ba *postgresBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	sizeBytes, err := b.GetSizeBytes()
+	if err != nil {
+		b.Discard()
+		return err
+	}
+	_, now := ba.getReferenceTimes()
+	key := blobDigest.GetKey(ba.digestKeyFormat)
+	if ba.largeObjectThresholdBytes > 0 && sizeBytes > ba.largeObjectThresholdBytes {
+		// Stream the contents of large blobs into the
+		// database, so that they don't need to be loaded into
+		// memory in their entirety.
+		r := b.ToReader()
+		defer r.Close()
+		return ba.queries.PutLargeBlob(ctx, key, sizeBytes, r, now)
+	}
+	data, err := b.ToByteSlice(int(sizeBytes))
+	if err != nil {
+		return err
+	}
+	return ba.queries.PutInlineBlob(ctx, key, data, now)
+}
+
+func (ba *postgresBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	minimumReferenceTime, now := ba.getReferenceTimes()
+	missing := digest.NewSetBuilder()
+	items := digests.Items()
+	for len(items) > 0 {
+		batchSize := min(len(items), ba.findMissingBatchSize)
+		batch := items[:batchSize]
+		items = items[batchSize:]
+
+		// Convert all digests to keys, removing the ones that
+		// are reported to exist.
+		digestsByKey := make(map[string]digest.Digest, len(batch))
+		keys := make([]string, 0, len(batch))
+		for _, blobDigest := range batch {
+			key := blobDigest.GetKey(ba.digestKeyFormat)
+			if _, ok := digestsByKey[key]; !ok {
+				digestsByKey[key] = blobDigest
+				keys = append(keys, key)
+			}
+		}
+		existingKeys, err := ba.queries.TouchBlobs(ctx, keys, minimumReferenceTime, now)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrap(err, "Failed to touch blobs")
+		}
+		for _, key := range existingKeys {
+			delete(digestsByKey, key)
+		}
+		for _, blobDigest := range digestsByKey {
+			missing.Add(blobDigest)
+		}
+	}
+	return missing.Build(), nil
+}
+
+// postgresLargeObjectReader provides random access to the contents of
+// a blob that is stored using PostgreSQL's large-object API.
+type postgresLargeObjectReader struct {
+	ctx         context.Context
+	queries     PostgresQueries
+	largeObject uint32
+}
+
+var _ buffer.ReadAtCloser = (*postgresLargeObjectReader)(nil)
+
+func (r *postgresLargeObjectReader) ReadAt(p []byte, off int64) (int, error) {
+	n, err := r.queries.ReadLargeObject(r.ctx, r.largeObject, p, off)
+	if err != nil && err != io.EOF {
+		return n, util.StatusWrapf(err, "Failed to read large object %d", r.largeObject)
+	}
+	return n, err
+}
+
+func (r *postgresLargeObjectReader) Close() error {
+	return nil
+}
@@ -0,0 +1,175 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostgresBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	capabilitiesProvider := mock.NewMockCapabilitiesProvider(ctrl)
+	queries := mock.NewMockPostgresQueries(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewPostgresBlobAccess(
+		capabilitiesProvider,
+		queries,
+		blobstore.CASReadBufferFactory,
+		digest.KeyWithoutInstance,
+		clock,
+		time.Hour,
+		/* largeObjectThresholdBytes = */ 10,
+		/* findMissingBatchSize = */ 2)
+
+	helloDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	helloKey := "3-8b1a9953c4611296a827abf8c47804d7-5"
+	largeDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "6cd3556deb0da54bca060b4c39479839", 13)
+	largeKey := "3-6cd3556deb0da54bca060b4c39479839-13"
+
+	t.Run("GetNotFound", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().GetBlob(ctx, helloKey, time.Unix(6400, 0), time.Unix(10000, 0)).
+			Return(blobstore.PostgresBlob{}, status.Error(codes.NotFound, "Blob not found"))
+
+		_, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Blob not found"), err)
+	})
+
+	t.Run("GetInline", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().GetBlob(ctx, helloKey, time.Unix(6400, 0), time.Unix(10000, 0)).
+			Return(blobstore.PostgresBlob{Data: []byte("Hello"), SizeBytes: 5}, nil)
+
+		data, err := blobAccess.Get(ctx, helloDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetLargeObject", func(t *testing.T) {
+		// Blobs stored as large objects should be read through
+		// separate queries.
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().GetBlob(ctx, largeKey, time.Unix(6400, 0), time.Unix(10000, 0)).
+			Return(blobstore.PostgresBlob{LargeObject: 12345, SizeBytes: 13}, nil)
+		queries.EXPECT().ReadLargeObject(ctx, uint32(12345), gomock.Any(), int64(0)).
+			DoAndReturn(func(ctx context.Context, largeObject uint32, p []byte, offset int64) (int, error) {
+				return copy(p, "Hello, world!"), io.EOF
+			})
+
+		data, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello, world!"), data)
+	})
+
+	t.Run("GetLargeObjectFailure", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().GetBlob(ctx, largeKey, time.Unix(6400, 0), time.Unix(10000, 0)).
+			Return(blobstore.PostgresBlob{LargeObject: 12345, SizeBytes: 13}, nil)
+		queries.EXPECT().ReadLargeObject(ctx, uint32(12345), gomock.Any(), int64(0)).
+			Return(0, status.Error(codes.Unavailable, "Connection refused"))
+
+		_, err := blobAccess.Get(ctx, largeDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Failed to read large object 12345: Connection refused"), err)
+	})
+
+	t.Run("PutInline", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().PutInlineBlob(ctx, helloKey, []byte("Hello"), time.Unix(10000, 0))
+
+		require.NoError(t, blobAccess.Put(ctx, helloDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("PutLargeObjectSuccess", func(t *testing.T) {
+		// Blobs exceeding the threshold should be streamed into
+		// the database using the large-object API.
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().PutLargeBlob(ctx, largeKey, int64(13), gomock.Any(), time.Unix(10000, 0)).
+			DoAndReturn(func(ctx context.Context, key string, sizeBytes int64, r io.Reader, now time.Time) error {
+				data, err := io.ReadAll(r)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello, world!"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, largeDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello, world!"))))
+	})
+
+	t.Run("PutLargeObjectDataCorruption", func(t *testing.T) {
+		// Data integrity errors should be propagated through the
+		// reader, so that the transaction is rolled back.
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().PutLargeBlob(ctx, largeKey, int64(13), gomock.Any(), time.Unix(10000, 0)).
+			DoAndReturn(func(ctx context.Context, key string, sizeBytes int64, r io.Reader, now time.Time) error {
+				_, err := io.ReadAll(r)
+				return err
+			})
+
+		err := blobAccess.Put(ctx, largeDigest, buffer.NewCASBufferFromReader(largeDigest, io.NopCloser(bytes.NewBufferString("Hello, World!")), buffer.UserProvided))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("PutLargeObjectFailure", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().PutLargeBlob(ctx, largeKey, int64(13), gomock.Any(), time.Unix(10000, 0)).
+			Return(status.Error(codes.Unavailable, "Connection refused"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Connection refused"),
+			blobAccess.Put(ctx, largeDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello, world!"))))
+	})
+
+	t.Run("FindMissingBatching", func(t *testing.T) {
+		// Existence queries should be sent in batches no larger
+		// than the configured batch size.
+		digest1 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000001", 1)
+		digest2 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000002", 2)
+		digest3 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000003", 3)
+
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().TouchBlobs(
+			ctx,
+			[]string{
+				"3-00000000000000000000000000000001-1",
+				"3-00000000000000000000000000000002-2",
+			},
+			time.Unix(6400, 0),
+			time.Unix(10000, 0),
+		).Return([]string{"3-00000000000000000000000000000002-2"}, nil)
+		queries.EXPECT().TouchBlobs(
+			ctx,
+			[]string{"3-00000000000000000000000000000003-3"},
+			time.Unix(6400, 0),
+			time.Unix(10000, 0),
+		).Return(nil, nil)
+
+		missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(digest1).Add(digest2).Add(digest3).Build())
+		require.NoError(t, err)
+		require.Equal(t, digest.NewSetBuilder().Add(digest1).Add(digest3).Build(), missing)
+	})
+
+	t.Run("FindMissingFailure", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(10000, 0))
+		queries.EXPECT().TouchBlobs(ctx, []string{helloKey}, time.Unix(6400, 0), time.Unix(10000, 0)).
+			Return(nil, status.Error(codes.Unavailable, "Connection refused"))
+
+		_, err := blobAccess.FindMissing(ctx, helloDigest.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Failed to touch blobs: Connection refused"), err)
+	})
+}
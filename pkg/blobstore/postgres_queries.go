@@ -0,0 +1,192 @@
+package blobstore
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/lib/pq"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type sqlPostgresQueries struct {
+	db *sql.DB
+
+	getBlobQuery         string
+	readLargeObjectQuery string
+	putInlineBlobQuery   string
+	createQuery          string
+	openQuery            string
+	writeQuery           string
+	closeQuery           string
+	putLargeBlobQuery    string
+	unlinkQuery          string
+	touchBlobsQuery      string
+}
+
+// postgresLargeObjectWriteChunkSizeBytes is the maximum amount of data
+// that is written into a large object using a single query.
+const postgresLargeObjectWriteChunkSizeBytes = 1 << 20
+
+// postgresLargeObjectModeWrite corresponds to INV_WRITE, which needs
+// to be provided to lo_open() to open a large object for writing.
+const postgresLargeObjectModeWrite = 0x20000
+
+// NewSQLPostgresQueries creates an implementation of PostgresQueries
+// that accesses a PostgreSQL table through the database/sql package.
+// The table is expected to have the following schema:
+//
+//	CREATE TABLE ${table_name} (
+//	    key text PRIMARY KEY,
+//	    data bytea,
+//	    large_object oid,
+//	    size_bytes bigint NOT NULL,
+//	    reference_time timestamp with time zone NOT NULL
+//	);
+func NewSQLPostgresQueries(db *sql.DB, tableName string) PostgresQueries {
+	table := pq.QuoteIdentifier(tableName)
+	return &sqlPostgresQueries{
+		db: db,
+
+		getBlobQuery: "UPDATE " + table + " SET reference_time = $3 " +
+			"WHERE key = $1 AND reference_time >= $2 " +
+			"RETURNING data, COALESCE(large_object, 0), size_bytes",
+		readLargeObjectQuery: "SELECT lo_get($1, $2, $3)",
+		// Existing rows that refer to a large object are never
+		// rewritten, as that would require removing the large
+		// object while it may still be read by other clients.
+		putInlineBlobQuery: "INSERT INTO " + table + " (key, data, large_object, size_bytes, reference_time) " +
+			"VALUES ($1, $2, NULL, $3, $4) " +
+			"ON CONFLICT (key) DO UPDATE SET " +
+			"data = CASE WHEN " + table + ".large_object IS NULL THEN EXCLUDED.data ELSE " + table + ".data END, " +
+			"size_bytes = CASE WHEN " + table + ".large_object IS NULL THEN EXCLUDED.size_bytes ELSE " + table + ".size_bytes END, " +
+			"reference_time = EXCLUDED.reference_time",
+		createQuery: "SELECT lo_create(0)",
+		openQuery:   "SELECT lo_open($1, $2)",
+		writeQuery:  "SELECT lowrite($1, $2)",
+		closeQuery:  "SELECT lo_close($1)",
+		putLargeBlobQuery: "INSERT INTO " + table + " (key, data, large_object, size_bytes, reference_time) " +
+			"VALUES ($1, NULL, $2, $3, $4) " +
+			"ON CONFLICT (key) DO UPDATE SET reference_time = EXCLUDED.reference_time " +
+			"RETURNING COALESCE(large_object, 0)",
+		unlinkQuery: "SELECT lo_unlink($1)",
+		touchBlobsQuery: "UPDATE " + table + " SET reference_time = $3 " +
+			"WHERE key = ANY($1) AND reference_time >= $2 " +
+			"RETURNING key",
+	}
+}
+
+func (q *sqlPostgresQueries) GetBlob(ctx context.Context, key string, minimumReferenceTime, now time.Time) (PostgresBlob, error) {
+	var blob PostgresBlob
+	var largeObject int64
+	if err := q.db.QueryRowContext(ctx, q.getBlobQuery, key, minimumReferenceTime, now).Scan(&blob.Data, &largeObject, &blob.SizeBytes); err != nil {
+		if err == sql.ErrNoRows {
+			return PostgresBlob{}, status.Error(codes.NotFound, "Blob not found")
+		}
+		return PostgresBlob{}, util.StatusWrapWithCode(err, codes.Unavailable, "Failed to get blob")
+	}
+	blob.LargeObject = uint32(largeObject)
+	return blob, nil
+}
+
+func (q *sqlPostgresQueries) ReadLargeObject(ctx context.Context, largeObject uint32, p []byte, offset int64) (int, error) {
+	if offset < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "Negative read offset: %d", offset)
+	}
+	var data []byte
+	if err := q.db.QueryRowContext(ctx, q.readLargeObjectQuery, int64(largeObject), offset, len(p)).Scan(&data); err != nil {
+		return 0, util.StatusWrapWithCode(err, codes.Unavailable, "Failed to read large object")
+	}
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (q *sqlPostgresQueries) PutInlineBlob(ctx context.Context, key string, data []byte, now time.Time) error {
+	if _, err := q.db.ExecContext(ctx, q.putInlineBlobQuery, key, data, len(data), now); err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to store blob")
+	}
+	return nil
+}
+
+func (q *sqlPostgresQueries) PutLargeBlob(ctx context.Context, key string, sizeBytes int64, r io.Reader, now time.Time) error {
+	// Large objects are transactional. Performing all operations
+	// within a single transaction ensures that the large object is
+	// removed if storing the blob fails.
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to start transaction")
+	}
+	defer tx.Rollback()
+
+	var largeObject int64
+	if err := tx.QueryRowContext(ctx, q.createQuery).Scan(&largeObject); err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to create large object")
+	}
+	var fd int64
+	if err := tx.QueryRowContext(ctx, q.openQuery, largeObject, postgresLargeObjectModeWrite).Scan(&fd); err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to open large object")
+	}
+	chunk := make([]byte, min(sizeBytes, postgresLargeObjectWriteChunkSizeBytes))
+	for {
+		n, err := io.ReadFull(r, chunk)
+		if n > 0 {
+			if _, err := tx.ExecContext(ctx, q.writeQuery, fd, chunk[:n]); err != nil {
+				return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to write large object")
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	if _, err := tx.ExecContext(ctx, q.closeQuery, fd); err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to close large object")
+	}
+
+	// If a blob with the same key was stored concurrently, the
+	// existing row is retained. The large object created above is
+	// then no longer needed. As it has not been made visible to
+	// other transactions, it can be removed safely.
+	var storedLargeObject int64
+	if err := tx.QueryRowContext(ctx, q.putLargeBlobQuery, key, largeObject, sizeBytes, now).Scan(&storedLargeObject); err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to store blob")
+	}
+	if storedLargeObject != largeObject {
+		if _, err := tx.ExecContext(ctx, q.unlinkQuery, largeObject); err != nil {
+			return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to remove redundant large object")
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return util.StatusWrapWithCode(err, codes.Unavailable, "Failed to commit transaction")
+	}
+	return nil
+}
+
+func (q *sqlPostgresQueries) TouchBlobs(ctx context.Context, keys []string, minimumReferenceTime, now time.Time) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, q.touchBlobsQuery, pq.Array(keys), minimumReferenceTime, now)
+	if err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Unavailable, "Failed to query blobs")
+	}
+	defer rows.Close()
+
+	var existingKeys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.Unavailable, "Failed to scan blob key")
+		}
+		existingKeys = append(existingKeys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.StatusWrapWithCode(err, codes.Unavailable, "Failed to iterate blob keys")
+	}
+	return existingKeys, nil
+}
@@ -0,0 +1,148 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestSQLPostgresQueries runs against an actual PostgreSQL database.
+// It is only run if the BB_STORAGE_TEST_POSTGRES_CONNECTION_STRING
+// environment variable is set.
+func TestSQLPostgresQueries(t *testing.T) {
+	connectionString, ok := os.LookupEnv("BB_STORAGE_TEST_POSTGRES_CONNECTION_STRING")
+	if !ok {
+		t.Skip("BB_STORAGE_TEST_POSTGRES_CONNECTION_STRING not set")
+	}
+	ctx := context.Background()
+
+	connector, err := pq.NewConnector(connectionString)
+	require.NoError(t, err)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	tableName := fmt.Sprintf("bb_storage_test_%d", time.Now().UnixNano())
+	_, err = db.ExecContext(ctx, "CREATE TABLE "+pq.QuoteIdentifier(tableName)+` (
+		key text PRIMARY KEY,
+		data bytea,
+		large_object oid,
+		size_bytes bigint NOT NULL,
+		reference_time timestamp with time zone NOT NULL
+	)`)
+	require.NoError(t, err)
+	defer func() {
+		_, err := db.ExecContext(ctx, "SELECT lo_unlink(large_object) FROM "+pq.QuoteIdentifier(tableName)+" WHERE large_object IS NOT NULL")
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, "DROP TABLE "+pq.QuoteIdentifier(tableName))
+		require.NoError(t, err)
+	}()
+
+	queries := blobstore.NewSQLPostgresQueries(db, tableName)
+
+	t.Run("Inline", func(t *testing.T) {
+		require.NoError(t, queries.PutInlineBlob(ctx, "inline", []byte("Hello"), time.Unix(1000, 0)))
+
+		blob, err := queries.GetBlob(ctx, "inline", time.Unix(900, 0), time.Unix(1100, 0))
+		require.NoError(t, err)
+		require.Equal(t, blobstore.PostgresBlob{Data: []byte("Hello"), SizeBytes: 5}, blob)
+	})
+
+	t.Run("LargeObject", func(t *testing.T) {
+		require.NoError(t, queries.PutLargeBlob(ctx, "large", 13, bytes.NewBufferString("Hello, world!"), time.Unix(1000, 0)))
+
+		blob, err := queries.GetBlob(ctx, "large", time.Unix(900, 0), time.Unix(1100, 0))
+		require.NoError(t, err)
+		require.NotZero(t, blob.LargeObject)
+		require.Nil(t, blob.Data)
+		require.Equal(t, int64(13), blob.SizeBytes)
+
+		var p [8]byte
+		n, err := queries.ReadLargeObject(ctx, blob.LargeObject, p[:], 7)
+		require.Equal(t, io.EOF, err)
+		require.Equal(t, []byte("world!"), p[:n])
+
+		// Writing the blob again should neither replace nor
+		// remove the existing large object, as it may still be
+		// read by other clients. Only the reference time should
+		// be updated.
+		require.NoError(t, queries.PutLargeBlob(ctx, "large", 13, bytes.NewBufferString("Hello, world!"), time.Unix(2000, 0)))
+		require.NoError(t, queries.PutInlineBlob(ctx, "large", []byte("Hello"), time.Unix(3000, 0)))
+
+		blob2, err := queries.GetBlob(ctx, "large", time.Unix(2900, 0), time.Unix(3100, 0))
+		require.NoError(t, err)
+		require.Equal(t, blob, blob2)
+		n, err = queries.ReadLargeObject(ctx, blob.LargeObject, p[:], 0)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello, w"), p[:n])
+	})
+
+	t.Run("LargeObjectConcurrent", func(t *testing.T) {
+		// Concurrent writes of the same blob should not leave
+		// behind any orphaned large objects.
+		var largeObjectsBefore int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM pg_largeobject_metadata").Scan(&largeObjectsBefore))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				require.NoError(t, queries.PutLargeBlob(ctx, "concurrent", 13, bytes.NewBufferString("Hello, world!"), time.Unix(1000, 0)))
+			}()
+		}
+		wg.Wait()
+
+		var largeObjectsAfter int
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT COUNT(*) FROM pg_largeobject_metadata").Scan(&largeObjectsAfter))
+		require.Equal(t, largeObjectsBefore+1, largeObjectsAfter)
+	})
+
+	t.Run("LargeObjectReadFailure", func(t *testing.T) {
+		// If reading the blob's contents fails, the transaction
+		// should be rolled back, meaning that no blob is stored.
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Checksum mismatch"),
+			queries.PutLargeBlob(ctx, "failure", 13, io.MultiReader(bytes.NewBufferString("Hello"), iotest.ErrReader(status.Error(codes.Internal, "Checksum mismatch"))), time.Unix(1000, 0)))
+
+		_, err := queries.GetBlob(ctx, "failure", time.Unix(900, 0), time.Unix(1100, 0))
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Blob not found"), err)
+	})
+
+	t.Run("Expiration", func(t *testing.T) {
+		require.NoError(t, queries.PutInlineBlob(ctx, "expiring", []byte("Hello"), time.Unix(1000, 0)))
+
+		_, err := queries.GetBlob(ctx, "expiring", time.Unix(1001, 0), time.Unix(2000, 0))
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Blob not found"), err)
+	})
+
+	t.Run("TouchBlobs", func(t *testing.T) {
+		require.NoError(t, queries.PutInlineBlob(ctx, "touch1", []byte("Hello"), time.Unix(1000, 0)))
+		require.NoError(t, queries.PutInlineBlob(ctx, "touch2", []byte("Hello"), time.Unix(3000, 0)))
+
+		existingKeys, err := queries.TouchBlobs(ctx, []string{"touch1", "touch2", "touch3"}, time.Unix(2000, 0), time.Unix(4000, 0))
+		require.NoError(t, err)
+		require.Equal(t, []string{"touch2"}, existingKeys)
+
+		// The reference time of the blob should have been
+		// updated.
+		existingKeys, err = queries.TouchBlobs(ctx, []string{"touch2"}, time.Unix(3500, 0), time.Unix(4000, 0))
+		require.NoError(t, err)
+		require.Equal(t, []string{"touch2"}, existingKeys)
+	})
+}
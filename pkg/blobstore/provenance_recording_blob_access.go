@@ -0,0 +1,77 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/provenance"
+)
+
+type provenanceRecordingBlobAccess struct {
+	BlobAccess
+	backendName string
+}
+
+// NewProvenanceRecordingBlobAccess creates a decorator for BlobAccess
+// that reports its name to the gRPC backend provenance interceptors
+// when reads are served by the backend successfully. By placing this
+// decorator around the individual backends of a mirrored, sharded or
+// tiered storage setup, it becomes possible to determine which backend
+// ultimately served the data returned to a client.
+//
+// Requests that are not processed by the backend provenance
+// interceptors are forwarded to the backend without any additional
+// overhead.
+func NewProvenanceRecordingBlobAccess(base BlobAccess, backendName string) BlobAccess {
+	return &provenanceRecordingBlobAccess{
+		BlobAccess:  base,
+		backendName: backendName,
+	}
+}
+
+func (ba *provenanceRecordingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	backendProvenance := provenance.BackendProvenanceFromContext(ctx)
+	if backendProvenance == nil {
+		return ba.BlobAccess.Get(ctx, blobDigest)
+	}
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, blobDigest),
+		&provenanceRecordingErrorHandler{
+			backendName:       ba.backendName,
+			backendProvenance: backendProvenance,
+		})
+}
+
+func (ba *provenanceRecordingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	backendProvenance := provenance.BackendProvenanceFromContext(ctx)
+	if backendProvenance == nil {
+		return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+	}
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+		&provenanceRecordingErrorHandler{
+			backendName:       ba.backendName,
+			backendProvenance: backendProvenance,
+		})
+}
+
+type provenanceRecordingErrorHandler struct {
+	backendName       string
+	backendProvenance *provenance.BackendProvenance
+	failed            bool
+}
+
+func (eh *provenanceRecordingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	// Reads that fail are not recorded, as the data is likely
+	// going to be served by another backend instead.
+	eh.failed = true
+	return nil, err
+}
+
+func (eh *provenanceRecordingErrorHandler) Done() {
+	if !eh.failed {
+		eh.backendProvenance.AddBackend(eh.backendName)
+	}
+}
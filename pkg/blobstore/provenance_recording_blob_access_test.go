@@ -0,0 +1,101 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/provenance"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestProvenanceRecordingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Place decorators around both backends of a failover setup, so
+	// that it can be observed which of the two served a read.
+	primaryBlobAccess := mock.NewMockBlobAccess(ctrl)
+	secondaryBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewFailoverBlobAccess(
+		blobstore.NewProvenanceRecordingBlobAccess(primaryBlobAccess, "primary"),
+		blobstore.NewProvenanceRecordingBlobAccess(secondaryBlobAccess, "secondary"),
+		clock.SystemClock,
+		10,
+		time.Minute,
+		"provenance_recording_blob_access_test")
+
+	blobDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+	t.Run("Disabled", func(t *testing.T) {
+		// If the backend provenance interceptors are not enabled,
+		// reads should be forwarded without recording anything.
+		primaryBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("ServedByPrimary", func(t *testing.T) {
+		ctxWithBackendProvenance, backendProvenance := provenance.NewContextWithBackendProvenance(ctx)
+		primaryBlobAccess.EXPECT().Get(ctxWithBackendProvenance, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctxWithBackendProvenance, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.Equal(t, []string{"primary"}, backendProvenance.GetBackends())
+	})
+
+	t.Run("ServedBySecondary", func(t *testing.T) {
+		// If the primary backend fails, only the secondary
+		// backend that actually served the data should be
+		// recorded.
+		ctxWithBackendProvenance, backendProvenance := provenance.NewContextWithBackendProvenance(ctx)
+		primaryBlobAccess.EXPECT().Get(ctxWithBackendProvenance, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		secondaryBlobAccess.EXPECT().Get(ctxWithBackendProvenance, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctxWithBackendProvenance, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.Equal(t, []string{"secondary"}, backendProvenance.GetBackends())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		// Reads that fail on all backends should not cause any
+		// backends to be recorded.
+		ctxWithBackendProvenance, backendProvenance := provenance.NewContextWithBackendProvenance(ctx)
+		primaryBlobAccess.EXPECT().Get(ctxWithBackendProvenance, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctxWithBackendProvenance, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Primary: Object not found"), err)
+		require.Empty(t, backendProvenance.GetBackends())
+	})
+
+	t.Run("Accumulation", func(t *testing.T) {
+		// Multiple reads performed as part of the same RPC
+		// should report each backend only once.
+		ctxWithBackendProvenance, backendProvenance := provenance.NewContextWithBackendProvenance(ctx)
+		primaryBlobAccess.EXPECT().Get(ctxWithBackendProvenance, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))).Times(2)
+		primaryBlobAccess.EXPECT().Get(ctxWithBackendProvenance, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		secondaryBlobAccess.EXPECT().Get(ctxWithBackendProvenance, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		for i := 0; i < 3; i++ {
+			data, err := blobAccess.Get(ctxWithBackendProvenance, blobDigest).ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("Hello"), data)
+		}
+		require.Equal(t, []string{"primary", "secondary"}, backendProvenance.GetBackends())
+	})
+}
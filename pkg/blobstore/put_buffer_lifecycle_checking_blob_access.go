@@ -0,0 +1,79 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	putBufferLifecycleCheckingBlobAccessPrometheusMetrics sync.Once
+
+	putBufferLifecycleCheckingBlobAccessViolations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "put_buffer_lifecycle_checking_blob_access_violations_total",
+			Help:      "Number of Put() operations where the backend did not release the buffer exactly once",
+		},
+		[]string{"storage_type", "violation"})
+)
+
+type putBufferLifecycleCheckingBlobAccess struct {
+	BlobAccess
+	panicOnViolation                bool
+	notReleasedViolations           prometheus.Counter
+	releasedMultipleTimesViolations prometheus.Counter
+}
+
+// NewPutBufferLifecycleCheckingBlobAccess creates a decorator for
+// BlobAccess that validates that the backend releases buffers provided
+// to Put() exactly once, either by consuming or discarding them.
+// Buffers that are not released may leak resources (e.g., network
+// connections), while buffers that are released multiple times may
+// cause data corruption.
+//
+// Buffers must be released before Put() returns. Violations are counted
+// in a Prometheus metric. If panicOnViolation is set, violations cause
+// a panic instead. This is intended to be used in tests.
+func NewPutBufferLifecycleCheckingBlobAccess(base BlobAccess, panicOnViolation bool, storageType string) BlobAccess {
+	putBufferLifecycleCheckingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(putBufferLifecycleCheckingBlobAccessViolations)
+	})
+
+	return &putBufferLifecycleCheckingBlobAccess{
+		BlobAccess:                      base,
+		panicOnViolation:                panicOnViolation,
+		notReleasedViolations:           putBufferLifecycleCheckingBlobAccessViolations.WithLabelValues(storageType, "NotReleased"),
+		releasedMultipleTimesViolations: putBufferLifecycleCheckingBlobAccessViolations.WithLabelValues(storageType, "ReleasedMultipleTimes"),
+	}
+}
+
+func (ba *putBufferLifecycleCheckingBlobAccess) reportViolation(counter prometheus.Counter, message string) {
+	if ba.panicOnViolation {
+		panic(message)
+	}
+	counter.Inc()
+}
+
+func (ba *putBufferLifecycleCheckingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	var releaseCount atomic.Int32
+	err := ba.BlobAccess.Put(ctx, blobDigest, buffer.WithReleaseHandler(b, func() {
+		if releaseCount.Add(1) == 2 {
+			ba.reportViolation(
+				ba.releasedMultipleTimesViolations,
+				fmt.Sprintf("Buffer for object with digest %#v was released multiple times", blobDigest.String()))
+		}
+	}))
+	if releaseCount.Load() == 0 {
+		ba.reportViolation(
+			ba.notReleasedViolations,
+			fmt.Sprintf("Buffer for object with digest %#v was not released by Put()", blobDigest.String()))
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPutBufferLifecycleCheckingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewPutBufferLifecycleCheckingBlobAccess(baseBlobAccess, true, "cas")
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("Consumed", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("DiscardedWithError", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.Unavailable, "Server not reachable")
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Server not reachable"),
+			blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("ConsumedAfterAttachingTask", func(t *testing.T) {
+		// Attaching a task to a buffer does not release it.
+		// Only consuming the resulting buffer does.
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				_, err := b.WithTask(func() error { return nil }).ToByteSlice(100)
+				return err
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("NotReleased", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).Return(nil)
+
+		require.PanicsWithValue(
+			t,
+			"Buffer for object with digest \"3-8b1a9953c4611296a827abf8c47804d7-5-example\" was not released by Put()",
+			func() {
+				blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+			})
+	})
+
+	t.Run("ReleasedMultipleTimes", func(t *testing.T) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				b.Discard()
+				return nil
+			})
+
+		require.PanicsWithValue(
+			t,
+			"Buffer for object with digest \"3-8b1a9953c4611296a827abf8c47804d7-5-example\" was released multiple times",
+			func() {
+				blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+			})
+	})
+
+	t.Run("CountedViolation", func(t *testing.T) {
+		// When not panicking, violations are only counted.
+		// The error of the backend should be propagated.
+		countingBlobAccess := blobstore.NewPutBufferLifecycleCheckingBlobAccess(baseBlobAccess, false, "cas")
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).Return(status.Error(codes.Internal, "Disk on fire"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Disk on fire"),
+			countingBlobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
@@ -0,0 +1,58 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type putDeduplicatingBlobAccess struct {
+	BlobAccess
+	calls callDeduplicator[string, struct{}]
+}
+
+// NewPutDeduplicatingBlobAccess creates a decorator for the Content
+// Addressable Storage (CAS) that merges concurrent Put() requests for
+// the same digest. This reduces the load on the backend when many
+// clients upload the same large object simultaneously, for example
+// when workers upload shared dependencies.
+//
+// The first caller writes the object into the backend. Other callers
+// wait for this write to complete. If it succeeds, they read their own
+// copy of the data to completion without storing it. This makes sure
+// that data that does not match the digest is still detected, as CAS
+// buffers validate their contents while being read. If the write
+// fails, callers that were waiting for it to complete retry the write
+// themselves. This ensures that the cancelation of one client's
+// request does not cause requests of other clients to fail.
+//
+// This decorator may only be used for the CAS, as it assumes that all
+// writes for a given digest store identical contents.
+func NewPutDeduplicatingBlobAccess(base BlobAccess) BlobAccess {
+	return &putDeduplicatingBlobAccess{
+		BlobAccess: base,
+		calls:      newCallDeduplicator[string, struct{}](),
+	}
+}
+
+func (ba *putDeduplicatingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	_, executed, err := ba.calls.call(
+		ctx,
+		blobDigest.GetKey(digest.KeyWithInstance),
+		alwaysRetry,
+		func() (struct{}, error) {
+			return struct{}{}, ba.BlobAccess.Put(ctx, blobDigest, b)
+		})
+	if executed {
+		return err
+	}
+	if err != nil {
+		b.Discard()
+		return err
+	}
+	// Another caller wrote the object into the backend. Only
+	// validate our own copy of the data.
+	return b.IntoWriter(io.Discard)
+}
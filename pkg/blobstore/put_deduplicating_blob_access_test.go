@@ -0,0 +1,112 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPutDeduplicatingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewPutDeduplicatingBlobAccess(baseBlobAccess)
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	put := func(data []byte) func(ctx context.Context) error {
+		return func(ctx context.Context) error {
+			return blobAccess.Put(ctx, blobDigest, buffer.NewCASBufferFromByteSlice(blobDigest, data, buffer.UserProvided))
+		}
+	}
+	putHello := func() error { return put([]byte("Hello"))(ctx) }
+	expectBlockingPut := func(err error) blockingCall {
+		call := newBlockingCall()
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				call.block()
+				data, readErr := b.ToByteSlice(100)
+				require.NoError(t, readErr)
+				require.Equal(t, []byte("Hello"), data)
+				return err
+			})
+		return call
+	}
+
+	t.Run("IdenticalUploadsCoalesced", func(t *testing.T) {
+		// Two concurrent uploads of the same object should
+		// only result in a single call against the backend.
+		call := expectBlockingPut(nil)
+		results1 := callAsync(putHello)
+		<-call.started
+
+		results2 := attachAsync(newWaitingContext(ctx), put([]byte("Hello")))
+		close(call.release)
+
+		require.NoError(t, <-results1)
+		require.NoError(t, <-results2)
+	})
+
+	t.Run("MismatchingUploadDetected", func(t *testing.T) {
+		// If the data provided by a caller that attached to an
+		// existing upload doesn't match the digest, it should
+		// still be rejected.
+		call := expectBlockingPut(nil)
+		results1 := callAsync(putHello)
+		<-call.started
+
+		results2 := attachAsync(newWaitingContext(ctx), put([]byte("Jello")))
+		close(call.release)
+
+		require.NoError(t, <-results1)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Buffer has checksum bedad9eef4de4b391cc5aeb8ddbe6387, while 8b1a9953c4611296a827abf8c47804d7 was expected"), <-results2)
+	})
+
+	t.Run("FailureRetried", func(t *testing.T) {
+		// If the call against the backend fails, callers that
+		// were waiting for it should not observe the error, but
+		// retry the call with their own copy of the data.
+		call := expectBlockingPut(status.Error(codes.Canceled, "Request canceled"))
+		results1 := callAsync(putHello)
+		<-call.started
+
+		ctx2 := newWaitingContext(ctx)
+		baseBlobAccess.EXPECT().Put(ctx2, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+		results2 := attachAsync(ctx2, put([]byte("Hello")))
+		close(call.release)
+
+		testutil.RequireEqualStatus(t, status.Error(codes.Canceled, "Request canceled"), <-results1)
+		require.NoError(t, <-results2)
+	})
+
+	t.Run("Sequential", func(t *testing.T) {
+		// Uploads are only coalesced while they are in flight.
+		// Subsequent uploads should be forwarded again.
+		for i := 0; i < 2; i++ {
+			baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+					b.Discard()
+					return nil
+				})
+			require.NoError(t, putHello())
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type putRateLimitingBucket struct {
+	tokens     float64
+	lastUpdate time.Time
+}
+
+type putRateLimitingBlobAccess struct {
+	BlobAccess
+	clock              clock.Clock
+	putsPerSecond      float64
+	burst              float64
+	maximumPrincipals  int
+	marshalOptions     proto.MarshalOptions
+	lock               sync.Mutex
+	bucketsByPrincipal map[string]*putRateLimitingBucket
+	evictionSet        eviction.Set[string]
+}
+
+// NewPutRateLimitingBlobAccess creates a decorator for BlobAccess that
+// limits the rate at which Put() operations may be performed. Limits
+// are applied to each principal separately, where principals are
+// identified by the authentication metadata that is attached to the
+// request. This prevents a single client, for example one whose
+// credentials have been compromised, from flooding the storage backend
+// with writes.
+//
+// Rate limiting is implemented using a token bucket algorithm, where
+// each principal may perform up to burst operations in quick
+// succession, and putsPerSecond operations on average. Requests that
+// exceed the limit fail with code RESOURCE_EXHAUSTED. Reads are not
+// subject to rate limiting.
+//
+// State is only tracked for up to maximumPrincipals principals. When
+// exceeded, the state of principals is discarded according to the
+// provided eviction set.
+func NewPutRateLimitingBlobAccess(base BlobAccess, clock clock.Clock, evictionSet eviction.Set[string], maximumPrincipals int, putsPerSecond float64, burst int) BlobAccess {
+	return &putRateLimitingBlobAccess{
+		BlobAccess:         base,
+		clock:              clock,
+		putsPerSecond:      putsPerSecond,
+		burst:              float64(burst),
+		maximumPrincipals:  maximumPrincipals,
+		marshalOptions:     proto.MarshalOptions{Deterministic: true},
+		bucketsByPrincipal: map[string]*putRateLimitingBucket{},
+		evictionSet:        evictionSet,
+	}
+}
+
+// takeToken attempts to consume a single token from the bucket
+// belonging to a principal, returning whether the operation is
+// permitted to proceed.
+func (ba *putRateLimitingBlobAccess) takeToken(principal string) bool {
+	now := ba.clock.Now()
+
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	bucket, ok := ba.bucketsByPrincipal[principal]
+	if ok {
+		ba.evictionSet.Touch(principal)
+
+		// Replenish tokens for the time that has passed
+		// since the last operation.
+		if elapsed := now.Sub(bucket.lastUpdate); elapsed > 0 {
+			bucket.tokens += elapsed.Seconds() * ba.putsPerSecond
+			if bucket.tokens > ba.burst {
+				bucket.tokens = ba.burst
+			}
+		}
+		bucket.lastUpdate = now
+	} else {
+		// Principal is not known yet. Create a full bucket,
+		// while making space for it if needed.
+		for len(ba.bucketsByPrincipal) >= ba.maximumPrincipals && len(ba.bucketsByPrincipal) > 0 {
+			delete(ba.bucketsByPrincipal, ba.evictionSet.Peek())
+			ba.evictionSet.Remove()
+		}
+		ba.evictionSet.Insert(principal)
+		bucket = &putRateLimitingBucket{
+			tokens:     ba.burst,
+			lastUpdate: now,
+		}
+		ba.bucketsByPrincipal[principal] = bucket
+	}
+
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+func (ba *putRateLimitingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	principal, err := ba.marshalOptions.Marshal(auth.AuthenticationMetadataFromContext(ctx).GetFullProto())
+	if err != nil {
+		b.Discard()
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal authentication metadata")
+	}
+	if !ba.takeToken(string(principal)) {
+		b.Discard()
+		return status.Errorf(codes.ResourceExhausted, "Rate limit of %g Put() operations per second exceeded", ba.putsPerSecond)
+	}
+	return ba.BlobAccess.Put(ctx, blobDigest, b)
+}
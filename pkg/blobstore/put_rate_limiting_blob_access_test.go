@@ -0,0 +1,106 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/auth"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPutRateLimitingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewPutRateLimitingBlobAccess(
+		baseBlobAccess,
+		clock,
+		eviction.NewLRUSet[string](),
+		10,
+		0.5,
+		2)
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	ctxAlice := auth.NewContextWithAuthenticationMetadata(ctx, auth.MustNewAuthenticationMetadataFromProto(&auth_pb.AuthenticationMetadata{
+		Public: structpb.NewStringValue("alice"),
+	}))
+	ctxBob := auth.NewContextWithAuthenticationMetadata(ctx, auth.MustNewAuthenticationMetadataFromProto(&auth_pb.AuthenticationMetadata{
+		Public: structpb.NewStringValue("bob"),
+	}))
+
+	t.Run("Get", func(t *testing.T) {
+		// Reads should not be subject to rate limiting.
+		for i := 0; i < 5; i++ {
+			baseBlobAccess.EXPECT().Get(ctxAlice, blobDigest).
+				Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+			data, err := blobAccess.Get(ctxAlice, blobDigest).ToByteSlice(100)
+			require.NoError(t, err)
+			require.Equal(t, []byte("Hello"), data)
+		}
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		// The first two writes should be permitted, as they fit
+		// within the burst.
+		for i := 0; i < 2; i++ {
+			clock.EXPECT().Now().Return(time.Unix(1000, 0))
+			baseBlobAccess.EXPECT().Put(ctxAlice, blobDigest, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+					b.Discard()
+					return nil
+				})
+
+			require.NoError(t, blobAccess.Put(ctxAlice, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		}
+
+		// Subsequent writes should be throttled until a token
+		// has been replenished.
+		clock.EXPECT().Now().Return(time.Unix(1001, 0))
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Rate limit of 0.5 Put() operations per second exceeded"),
+			blobAccess.Put(ctxAlice, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		// Other principals have their own bucket, meaning they
+		// should not be affected.
+		clock.EXPECT().Now().Return(time.Unix(1001, 0))
+		baseBlobAccess.EXPECT().Put(ctxBob, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		require.NoError(t, blobAccess.Put(ctxBob, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		// After two seconds, a single token has been replenished.
+		clock.EXPECT().Now().Return(time.Unix(1002, 0))
+		baseBlobAccess.EXPECT().Put(ctxAlice, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		require.NoError(t, blobAccess.Put(ctxAlice, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+		clock.EXPECT().Now().Return(time.Unix(1002, 0))
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.ResourceExhausted, "Rate limit of 0.5 Put() operations per second exceeded"),
+			blobAccess.Put(ctxAlice, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+}
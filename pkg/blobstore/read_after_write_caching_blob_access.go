@@ -0,0 +1,114 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+)
+
+type readAfterWriteCacheEntry struct {
+	data           []byte
+	expirationTime time.Time
+}
+
+type readAfterWriteCachingBlobAccess struct {
+	BlobAccess
+	clock                   clock.Clock
+	maximumCacheSize        int
+	maximumCacheDuration    time.Duration
+	maximumMessageSizeBytes int
+
+	lock          sync.Mutex
+	cachedObjects map[string]readAfterWriteCacheEntry
+	evictionSet   eviction.Set[string]
+}
+
+// NewReadAfterWriteCachingBlobAccess creates a decorator for BlobAccess
+// that retains a copy of objects that were written recently. Calls to
+// Get() for these objects are served from this copy, as opposed to
+// being forwarded to the backend.
+//
+// This decorator can be used to provide read-after-write consistency
+// for the Action Cache (AC) in case the backend does not provide it.
+// For example, a worker may store an ActionResult in one replica and
+// have it be read back from another replica shortly after, before
+// replication has completed. By keeping entries only for a short
+// amount of time, the window is bridged until the backend has become
+// consistent.
+func NewReadAfterWriteCachingBlobAccess(base BlobAccess, clock clock.Clock, evictionSet eviction.Set[string], maximumCacheSize int, maximumCacheDuration time.Duration, maximumMessageSizeBytes int) BlobAccess {
+	return &readAfterWriteCachingBlobAccess{
+		BlobAccess:              base,
+		clock:                   clock,
+		maximumCacheSize:        maximumCacheSize,
+		maximumCacheDuration:    maximumCacheDuration,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+
+		cachedObjects: map[string]readAfterWriteCacheEntry{},
+		evictionSet:   evictionSet,
+	}
+}
+
+func (ba *readAfterWriteCachingBlobAccess) getCachedObject(key string) ([]byte, bool) {
+	now := ba.clock.Now()
+
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	entry, ok := ba.cachedObjects[key]
+	if !ok || !now.Before(entry.expirationTime) {
+		return nil, false
+	}
+	ba.evictionSet.Touch(key)
+	return entry.data, true
+}
+
+func (ba *readAfterWriteCachingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if data, ok := ba.getCachedObject(blobDigest.GetKey(digest.KeyWithInstance)); ok {
+		return buffer.NewValidatedBufferFromByteSlice(data)
+	}
+	return ba.BlobAccess.Get(ctx, blobDigest)
+}
+
+func (ba *readAfterWriteCachingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.Get(ctx, parentDigest), childDigest)
+	return b
+}
+
+func (ba *readAfterWriteCachingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	data, err := b.ToByteSlice(ba.maximumMessageSizeBytes)
+	if err != nil {
+		return err
+	}
+	if err := ba.BlobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)); err != nil {
+		return err
+	}
+
+	// Only retain the object after it has been written, so that
+	// failed writes are not observable.
+	key := blobDigest.GetKey(digest.KeyWithInstance)
+	expirationTime := ba.clock.Now().Add(ba.maximumCacheDuration)
+
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	if _, ok := ba.cachedObjects[key]; ok {
+		ba.evictionSet.Touch(key)
+	} else {
+		for len(ba.cachedObjects) >= ba.maximumCacheSize && len(ba.cachedObjects) > 0 {
+			delete(ba.cachedObjects, ba.evictionSet.Peek())
+			ba.evictionSet.Remove()
+		}
+		ba.evictionSet.Insert(key)
+	}
+	ba.cachedObjects[key] = readAfterWriteCacheEntry{
+		data:           data,
+		expirationTime: expirationTime,
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestReadAfterWriteCachingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewReadAfterWriteCachingBlobAccess(
+		baseBlobAccess,
+		clock,
+		eviction.NewLRUSet[string](),
+		2,
+		10*time.Second,
+		1000)
+
+	actionResult := &remoteexecution.ActionResult{
+		StdoutRaw: []byte("Hello"),
+	}
+	blobDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 123)
+
+	t.Run("PutFailure", func(t *testing.T) {
+		// Objects should not be cached if writing them to the
+		// backend fails.
+		otherDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "78ae647dc5544d227130a0682a51e30bc7777fbb6d8a8f17007463a3ecd1d524", 123)
+		baseBlobAccess.EXPECT().Put(ctx, otherDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.Unavailable, "Server offline")
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Server offline"),
+			blobAccess.Put(ctx, otherDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		baseBlobAccess.EXPECT().Get(ctx, otherDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, otherDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("ReadAfterWrite", func(t *testing.T) {
+		// Successfully write an object to the backend.
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				m, err := b.ToProto(&remoteexecution.ActionResult{}, 1000)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, actionResult, m)
+				return nil
+			})
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(actionResult, buffer.UserProvided)))
+
+		// Reading the object back shortly after should not
+		// cause the backend to be contacted, as it may not have
+		// become consistent yet.
+		clock.EXPECT().Now().Return(time.Unix(1009, 0))
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, actionResult, m)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		// Once the maximum cache duration has passed, reads
+		// should go to the backend again.
+		clock.EXPECT().Now().Return(time.Unix(1010, 0))
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+}
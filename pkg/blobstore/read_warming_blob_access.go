@@ -0,0 +1,105 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	readWarmingBlobAccessPrometheusMetrics sync.Once
+
+	readWarmingBlobAccessOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "read_warming_blob_access_operations_total",
+			Help:      "Number of objects that ReadWarmingBlobAccess attempted to write into the secondary backend",
+		},
+		[]string{"outcome"})
+	readWarmingBlobAccessOperationsDropped   = readWarmingBlobAccessOperations.WithLabelValues("Dropped")
+	readWarmingBlobAccessOperationsFailed    = readWarmingBlobAccessOperations.WithLabelValues("Failed")
+	readWarmingBlobAccessOperationsSucceeded = readWarmingBlobAccessOperations.WithLabelValues("Succeeded")
+	readWarmingBlobAccessOperationsTooLarge  = readWarmingBlobAccessOperations.WithLabelValues("TooLarge")
+)
+
+type readWarmingBlobAccess struct {
+	BlobAccess
+	secondary        BlobAccess
+	slots            chan struct{}
+	maximumSizeBytes int
+}
+
+// NewReadWarmingBlobAccess creates a decorator for BlobAccess that
+// serves all requests from a primary backend. When objects are read
+// from the primary backend, a copy of them is written into a secondary
+// backend in the background. This can be used to warm up a cache,
+// without ever serving data from it.
+//
+// Writes against the secondary backend are best effort. At most
+// queueSize objects are held in memory while waiting to be written.
+// Any objects read beyond that are not copied. Objects larger than
+// maximumSizeBytes are not copied either, so that the amount of memory
+// used is bounded. Failures to write into the secondary backend are not
+// propagated to the caller.
+func NewReadWarmingBlobAccess(primary, secondary BlobAccess, queueSize, maximumSizeBytes int) BlobAccess {
+	readWarmingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(readWarmingBlobAccessOperations)
+	})
+
+	return &readWarmingBlobAccess{
+		BlobAccess:       primary,
+		secondary:        secondary,
+		slots:            make(chan struct{}, queueSize),
+		maximumSizeBytes: maximumSizeBytes,
+	}
+}
+
+func (ba *readWarmingBlobAccess) warm(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) buffer.Buffer {
+	if blobDigest.GetSizeBytes() > int64(ba.maximumSizeBytes) {
+		readWarmingBlobAccessOperationsTooLarge.Inc()
+		return b
+	}
+	select {
+	case ba.slots <- struct{}{}:
+	default:
+		readWarmingBlobAccessOperationsDropped.Inc()
+		return b
+	}
+
+	// Let the caller and the secondary backend consume the data
+	// independently. The copy destined for the secondary backend
+	// is loaded into memory immediately, so that a slow secondary
+	// backend does not slow down the caller.
+	b1, b2 := b.CloneStream()
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-ba.slots }()
+
+		data, err := b2.ToByteSlice(ba.maximumSizeBytes)
+		if err != nil {
+			// Don't copy objects that could not be read.
+			// The caller will observe the same error.
+			readWarmingBlobAccessOperationsFailed.Inc()
+			return
+		}
+		if err := ba.secondary.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)); err != nil {
+			readWarmingBlobAccessOperationsFailed.Inc()
+			return
+		}
+		readWarmingBlobAccessOperationsSucceeded.Inc()
+	}()
+	return b1
+}
+
+func (ba *readWarmingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	return ba.warm(ctx, blobDigest, ba.BlobAccess.Get(ctx, blobDigest))
+}
+
+func (ba *readWarmingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	return ba.warm(ctx, childDigest, ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer))
+}
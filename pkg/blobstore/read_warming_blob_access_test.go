@@ -0,0 +1,127 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestReadWarmingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	primary := mock.NewMockBlobAccess(ctrl)
+	secondary := mock.NewMockBlobAccess(ctrl)
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	// Use a separate instance for every test, as the queue slot
+	// of a completed write is released asynchronously.
+	t.Run("Success", func(t *testing.T) {
+		blobAccess := blobstore.NewReadWarmingBlobAccess(primary, secondary, 1, 100)
+		// Data should be returned from the primary backend,
+		// while a copy is written into the secondary backend.
+		primary.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		done := make(chan struct{})
+		secondary.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				close(done)
+				return nil
+			})
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		<-done
+	})
+
+	t.Run("SecondaryFailure", func(t *testing.T) {
+		blobAccess := blobstore.NewReadWarmingBlobAccess(primary, secondary, 1, 100)
+		// Failures of the secondary backend should not be
+		// visible to the caller.
+		primary.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		done := make(chan struct{})
+		secondary.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				close(done)
+				return status.Error(codes.Unavailable, "Server offline")
+			})
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		<-done
+	})
+
+	t.Run("PrimaryFailure", func(t *testing.T) {
+		blobAccess := blobstore.NewReadWarmingBlobAccess(primary, secondary, 1, 100)
+		// Objects that cannot be read from the primary backend
+		// should not be written into the secondary backend.
+		primary.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		blobAccess := blobstore.NewReadWarmingBlobAccess(primary, secondary, 1, 4)
+		// Objects exceeding the maximum size should not be
+		// written into the secondary backend, as they would
+		// need to be held in memory.
+		primary.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("QueueOverflow", func(t *testing.T) {
+		blobAccess := blobstore.NewReadWarmingBlobAccess(primary, secondary, 1, 100)
+		// While the secondary backend is still processing the
+		// first object, the second object should not be
+		// copied, as the queue is full.
+		primary.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))).
+			Times(2)
+		started := make(chan struct{})
+		unblock := make(chan struct{})
+		done := make(chan struct{})
+		secondary.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				close(started)
+				<-unblock
+				close(done)
+				return nil
+			})
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		<-started
+
+		data, err = blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+
+		close(unblock)
+		<-done
+	})
+}
@@ -114,10 +114,6 @@ func (ba *referenceExpandingBlobAccess) Get(ctx context.Context, blobDigest dige
 			return buffer.NewBufferFromError(util.StatusWrap(errToStatus(err), "Google Cloud Storage request failed"))
 		}
 	case *icas.Reference_ContentAddressableStorage_:
-		if reference.OffsetBytes != 0 || reference.SizeBytes != 0 {
-			return buffer.NewBufferFromError(status.Error(codes.Unimplemented, "Partial reads are not supported by the Content Addressable Storage backend"))
-		}
-
 		instanceNameStr := medium.ContentAddressableStorage.InstanceName
 		instanceName, err := digest.NewInstanceName(instanceNameStr)
 		if err != nil {
@@ -133,8 +129,20 @@ func (ba *referenceExpandingBlobAccess) Get(ctx context.Context, blobDigest dige
 			return buffer.NewBufferFromError(util.StatusWrapWithCode(err, codes.Internal, "Invalid digest"))
 		}
 
+		// Validate the range of the object that needs to be read.
+		objectSizeBytes := referenceDigest.GetSizeBytes()
+		offsetBytes := reference.OffsetBytes
+		if offsetBytes < 0 || offsetBytes > objectSizeBytes {
+			return buffer.NewBufferFromError(status.Errorf(codes.Internal, "Offset %d lies outside the referenced object of %d bytes", offsetBytes, objectSizeBytes))
+		}
+		sizeBytes := reference.SizeBytes
+		if sizeBytes < 0 || sizeBytes > objectSizeBytes-offsetBytes {
+			return buffer.NewBufferFromError(status.Errorf(codes.Internal, "Size %d at offset %d exceeds the referenced object of %d bytes", sizeBytes, offsetBytes, objectSizeBytes))
+		}
+		partialRead := offsetBytes != 0 || sizeBytes != 0
+
 		b := ba.contentAddressableStorage.Get(ctx, referenceDigest)
-		if reference.Decompressor == remoteexecution.Compressor_IDENTITY {
+		if !partialRead && reference.Decompressor == remoteexecution.Compressor_IDENTITY {
 			// Optimize the fast path: if no transformations are
 			// being performed and the digests are identical, we
 			// can pass through the underlying buffer directly.
@@ -144,6 +152,18 @@ func (ba *referenceExpandingBlobAccess) Get(ctx context.Context, blobDigest dige
 			}
 		}
 		r = b.ToReader()
+		if partialRead {
+			if sizeBytes == 0 {
+				sizeBytes = objectSizeBytes - offsetBytes
+			}
+			r = struct {
+				io.Reader
+				io.Closer
+			}{
+				Reader: io.LimitReader(&offsetReader{r: r, offsetBytes: offsetBytes}, sizeBytes),
+				Closer: r,
+			}
+		}
 	default:
 		return buffer.NewBufferFromError(status.Error(codes.Unimplemented, "Reference uses an unsupported medium"))
 	}
@@ -237,6 +257,28 @@ func (r statusReturningReadCloser) Close() error {
 	return errToStatus(r.r.Close())
 }
 
+// offsetReader is a decorator for io.Reader that discards a leading
+// amount of data upon the first call to Read(). This is used to read
+// part of an object stored in the Content Addressable Storage.
+type offsetReader struct {
+	r           io.Reader
+	offsetBytes int64
+}
+
+func (r *offsetReader) Read(p []byte) (int, error) {
+	if r.offsetBytes > 0 {
+		n, err := io.CopyN(io.Discard, r.r, r.offsetBytes)
+		r.offsetBytes -= n
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+	}
+	return r.r.Read(p)
+}
+
 // zstdReader is a decorator for zstd.Decoder that ensures both the
 // decoder and the underlying stream are closed upon completion.
 type zstdReader struct {
@@ -477,6 +477,63 @@ func TestReferenceExpandingBlobAccessGet(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"), data)
 	})
+
+	t.Run("CASSuccessOffsetAndSize", func(t *testing.T) {
+		// The reference may point to a part of an object stored
+		// in the CAS, such as a file contained in a ZIP archive.
+		worldDigest := digest.MustNewDigest("foo", remoteexecution.DigestFunction_MD5, "7d793037a0760186574b0282f2f435e7", 5)
+		indirectContentAddressableStorage.EXPECT().Get(ctx, worldDigest).Return(
+			buffer.NewProtoBufferFromProto(
+				&icas.Reference{
+					Medium: &icas.Reference_ContentAddressableStorage_{
+						ContentAddressableStorage: &icas.Reference_ContentAddressableStorage{
+							InstanceName:   "instance/name",
+							DigestFunction: remoteexecution.DigestFunction_SHA256,
+							BlobDigest: &remoteexecution.Digest{
+								Hash:      "315f5bdb76d078c43b8ac0064e4a0164612b1fce77c869345bfc94c75894edd3",
+								SizeBytes: 13,
+							},
+						},
+					},
+					OffsetBytes: 7,
+					SizeBytes:   5,
+				},
+				buffer.BackendProvided(buffer.Irreparable(worldDigest))))
+		contentAddressableStorage.EXPECT().Get(
+			ctx,
+			digest.MustNewDigest("instance/name", remoteexecution.DigestFunction_SHA256, "315f5bdb76d078c43b8ac0064e4a0164612b1fce77c869345bfc94c75894edd3", 13),
+		).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello, world!")))
+
+		data, err := blobAccess.Get(ctx, worldDigest).ToByteSlice(10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("world"), data)
+	})
+
+	t.Run("CASOffsetOutOfBounds", func(t *testing.T) {
+		// Ranges exceeding the size of the referenced object
+		// should be rejected without contacting the CAS.
+		worldDigest := digest.MustNewDigest("foo", remoteexecution.DigestFunction_MD5, "7d793037a0760186574b0282f2f435e7", 5)
+		indirectContentAddressableStorage.EXPECT().Get(ctx, worldDigest).Return(
+			buffer.NewProtoBufferFromProto(
+				&icas.Reference{
+					Medium: &icas.Reference_ContentAddressableStorage_{
+						ContentAddressableStorage: &icas.Reference_ContentAddressableStorage{
+							InstanceName:   "instance/name",
+							DigestFunction: remoteexecution.DigestFunction_SHA256,
+							BlobDigest: &remoteexecution.Digest{
+								Hash:      "315f5bdb76d078c43b8ac0064e4a0164612b1fce77c869345bfc94c75894edd3",
+								SizeBytes: 13,
+							},
+						},
+					},
+					OffsetBytes: 10,
+					SizeBytes:   5,
+				},
+				buffer.BackendProvided(buffer.Irreparable(worldDigest))))
+
+		_, err := blobAccess.Get(ctx, worldDigest).ToByteSlice(10)
+		testutil.RequireEqualStatus(t, status.Error(codes.Internal, "Size 5 at offset 10 exceeds the referenced object of 13 bytes"), err)
+	})
 }
 
 func TestReferenceExpandingBlobAccessPut(t *testing.T) {
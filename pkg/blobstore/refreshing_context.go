@@ -0,0 +1,25 @@
+package blobstore
+
+import (
+	"context"
+)
+
+type refreshingDisabledKey struct{}
+
+// NewContextWithRefreshingDisabled returns a Context that instructs
+// storage backends not to refresh objects while processing FindMissing()
+// requests. This permits callers to observe whether objects are present
+// without affecting how long they are retained, which is needed when
+// measuring retention.
+//
+// This option is only honored by storage backends that run within the
+// same process (e.g., 'local'). It is not forwarded over gRPC.
+func NewContextWithRefreshingDisabled(ctx context.Context) context.Context {
+	return context.WithValue(ctx, refreshingDisabledKey{}, true)
+}
+
+// IsRefreshingDisabled returns whether refreshing of objects has been
+// disabled through NewContextWithRefreshingDisabled().
+func IsRefreshingDisabled(ctx context.Context) bool {
+	return ctx.Value(refreshingDisabledKey{}) != nil
+}
@@ -0,0 +1,74 @@
+package replication
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type asynchronousBlobReplicator struct {
+	source      blobstore.BlobAccess
+	base        BlobReplicator
+	errorLogger util.ErrorLogger
+	slots       chan struct{}
+}
+
+// NewAsynchronousBlobReplicator creates a decorator for BlobReplicator
+// that performs replication in the background, on a best-effort basis.
+// Calls return immediately, without waiting for replication to
+// complete. Reads of individual objects are served from the source
+// directly.
+//
+// The number of replication requests that may be in flight at any
+// given time is bounded. Requests that exceed this limit are
+// discarded. Failures are reported through an ErrorLogger, as there is
+// no caller to which they can be returned.
+func NewAsynchronousBlobReplicator(source blobstore.BlobAccess, base BlobReplicator, errorLogger util.ErrorLogger, maximumConcurrency int) BlobReplicator {
+	return &asynchronousBlobReplicator{
+		source:      source,
+		base:        base,
+		errorLogger: errorLogger,
+		slots:       make(chan struct{}, maximumConcurrency),
+	}
+}
+
+func (br *asynchronousBlobReplicator) replicateInBackground(ctx context.Context, digests digest.Set) {
+	select {
+	case br.slots <- struct{}{}:
+	default:
+		br.errorLogger.Log(status.Errorf(codes.ResourceExhausted, "Discarding replication of %d objects, as too many replication requests are in flight", digests.Length()))
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer func() { <-br.slots }()
+		if err := br.base.ReplicateMultiple(ctx, digests); err != nil {
+			br.errorLogger.Log(util.StatusWrap(err, "Asynchronous replication failed"))
+		}
+	}()
+}
+
+func (br *asynchronousBlobReplicator) ReplicateSingle(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	br.replicateInBackground(ctx, blobDigest.ToSingletonSet())
+	return br.source.Get(ctx, blobDigest)
+}
+
+func (br *asynchronousBlobReplicator) ReplicateComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	br.replicateInBackground(ctx, parentDigest.ToSingletonSet())
+	return br.source.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (br *asynchronousBlobReplicator) ReplicateMultiple(ctx context.Context, digests digest.Set) error {
+	if !digests.Empty() {
+		br.replicateInBackground(ctx, digests)
+	}
+	return nil
+}
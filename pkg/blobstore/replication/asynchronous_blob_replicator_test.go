@@ -0,0 +1,89 @@
+package replication_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAsynchronousBlobReplicator(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("ReplicateSingle", func(t *testing.T) {
+		source := mock.NewMockBlobAccess(ctrl)
+		baseReplicator := mock.NewMockBlobReplicator(ctrl)
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		blobReplicator := replication.NewAsynchronousBlobReplicator(source, baseReplicator, errorLogger, 1)
+
+		// Data should be returned from the source, while the
+		// object gets replicated in the background.
+		finished := make(chan struct{})
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), blobDigest.ToSingletonSet()).
+			DoAndReturn(func(ctx context.Context, digests digest.Set) error {
+				close(finished)
+				return nil
+			})
+		source.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobReplicator.ReplicateSingle(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		<-finished
+	})
+
+	t.Run("ReplicationFailure", func(t *testing.T) {
+		source := mock.NewMockBlobAccess(ctrl)
+		baseReplicator := mock.NewMockBlobReplicator(ctrl)
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		blobReplicator := replication.NewAsynchronousBlobReplicator(source, baseReplicator, errorLogger, 1)
+
+		// Failures cannot be returned to the caller, so they
+		// should be logged instead.
+		logged := make(chan struct{})
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), blobDigest.ToSingletonSet()).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Asynchronous replication failed: Server offline"))).
+			Do(func(err error) { close(logged) })
+
+		require.NoError(t, blobReplicator.ReplicateMultiple(ctx, blobDigest.ToSingletonSet()))
+		<-logged
+	})
+
+	t.Run("TooManyInFlight", func(t *testing.T) {
+		source := mock.NewMockBlobAccess(ctrl)
+		baseReplicator := mock.NewMockBlobReplicator(ctrl)
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		blobReplicator := replication.NewAsynchronousBlobReplicator(source, baseReplicator, errorLogger, 1)
+
+		// Once the maximum number of requests is in flight,
+		// additional requests should be discarded.
+		started := make(chan struct{})
+		release := make(chan struct{})
+		baseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), blobDigest.ToSingletonSet()).
+			DoAndReturn(func(ctx context.Context, digests digest.Set) error {
+				close(started)
+				<-release
+				return nil
+			})
+		require.NoError(t, blobReplicator.ReplicateMultiple(ctx, blobDigest.ToSingletonSet()))
+		<-started
+
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.ResourceExhausted, "Discarding replication of 1 objects, as too many replication requests are in flight")))
+		require.NoError(t, blobReplicator.ReplicateMultiple(ctx, blobDigest.ToSingletonSet()))
+		close(release)
+	})
+}
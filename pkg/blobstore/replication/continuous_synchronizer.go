@@ -0,0 +1,173 @@
+package replication
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	continuousSynchronizerPrometheusMetrics sync.Once
+
+	continuousSynchronizerScans = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "continuous_synchronizer_scans_total",
+			Help:      "Number of scans of the source performed by the continuous synchronizer.",
+		},
+		[]string{"result"})
+	continuousSynchronizerScansSucceeded = continuousSynchronizerScans.WithLabelValues("Succeeded")
+	continuousSynchronizerScansFailed    = continuousSynchronizerScans.WithLabelValues("Failed")
+
+	continuousSynchronizerBlobsScanned = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "continuous_synchronizer_blobs_scanned_total",
+			Help:      "Number of blobs whose existence in the sink was checked by the continuous synchronizer.",
+		})
+	continuousSynchronizerBlobsReplicated = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "continuous_synchronizer_blobs_replicated_total",
+			Help:      "Number of blobs that were absent in the sink and replicated by the continuous synchronizer.",
+		})
+)
+
+// ContinuousSynchronizer can be used to ensure that all objects
+// enumerated by a DigestLister are present in a sink. Objects that are
+// absent are copied using a BlobReplicator. Unlike the other types in
+// this package, which only replicate objects on demand, it can be used
+// to keep a sink in sync with a source in the background.
+type ContinuousSynchronizer struct {
+	lister             DigestLister
+	sink               blobstore.BlobAccess
+	replicator         BlobReplicator
+	clock              clock.Clock
+	errorLogger        util.ErrorLogger
+	batchSize          int
+	maximumConcurrency int
+	scanInterval       time.Duration
+}
+
+// NewContinuousSynchronizer creates a ContinuousSynchronizer. Digests
+// are checked for existence in the sink in batches of batchSize.
+// Calls to FindMissing() on the sink and ReplicateMultiple() on the
+// replicator are performed for at most maximumConcurrency batches at a
+// time.
+func NewContinuousSynchronizer(lister DigestLister, sink blobstore.BlobAccess, replicator BlobReplicator, clock clock.Clock, errorLogger util.ErrorLogger, batchSize, maximumConcurrency int, scanInterval time.Duration) *ContinuousSynchronizer {
+	continuousSynchronizerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(continuousSynchronizerScans)
+		prometheus.MustRegister(continuousSynchronizerBlobsScanned)
+		prometheus.MustRegister(continuousSynchronizerBlobsReplicated)
+	})
+
+	return &ContinuousSynchronizer{
+		lister:             lister,
+		sink:               sink,
+		replicator:         replicator,
+		clock:              clock,
+		errorLogger:        errorLogger,
+		batchSize:          batchSize,
+		maximumConcurrency: maximumConcurrency,
+		scanInterval:       scanInterval,
+	}
+}
+
+func (cs *ContinuousSynchronizer) synchronizeBatch(ctx context.Context, digests digest.Set) error {
+	missing, err := cs.sink.FindMissing(ctx, digests)
+	if err != nil {
+		return util.StatusWrap(err, "Failed to check for missing blobs in sink")
+	}
+	continuousSynchronizerBlobsScanned.Add(float64(digests.Length()))
+	if missing.Empty() {
+		return nil
+	}
+	if err := cs.replicator.ReplicateMultiple(ctx, missing); err != nil {
+		return util.StatusWrap(err, "Failed to replicate missing blobs")
+	}
+	continuousSynchronizerBlobsReplicated.Add(float64(missing.Length()))
+	return nil
+}
+
+// PerformScan enumerates all digests once, and replicates the ones
+// that are absent in the sink.
+func (cs *ContinuousSynchronizer) PerformScan(ctx context.Context) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(cs.maximumConcurrency)
+
+	batch := digest.NewSetBuilder()
+	flushBatch := func() {
+		digests := batch.Build()
+		batch = digest.NewSetBuilder()
+		group.Go(func() error {
+			// Don't process any further batches if
+			// processing of an earlier batch failed.
+			if err := util.StatusFromContext(groupCtx); err != nil {
+				return err
+			}
+			return cs.synchronizeBatch(groupCtx, digests)
+		})
+	}
+	listErr := cs.lister.ListDigests(groupCtx, func(blobDigest digest.Digest) error {
+		if err := util.StatusFromContext(groupCtx); err != nil {
+			return err
+		}
+		batch.Add(blobDigest)
+		if batch.Length() >= cs.batchSize {
+			flushBatch()
+		}
+		return nil
+	})
+	if listErr == nil && batch.Length() > 0 {
+		flushBatch()
+	}
+
+	// Prefer reporting errors that caused the context to be
+	// canceled over those of the lister, as the latter may merely
+	// be a consequence of the former.
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	if listErr != nil {
+		return util.StatusWrap(listErr, "Failed to list digests")
+	}
+	return nil
+}
+
+// Run scans the source repeatedly. Scans are separated by the
+// configured scan interval. Failures are logged, after which the next
+// scan is attempted. This function only returns once the provided
+// context is canceled, making it suitable for use with program.Group.
+func (cs *ContinuousSynchronizer) Run(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+	for {
+		if err := cs.PerformScan(ctx); err == nil {
+			continuousSynchronizerScansSucceeded.Inc()
+		} else {
+			continuousSynchronizerScansFailed.Inc()
+			if ctx.Err() != nil {
+				return nil
+			}
+			cs.errorLogger.Log(util.StatusWrap(err, "Continuous synchronization failed"))
+		}
+
+		t, tC := cs.clock.NewTimer(cs.scanInterval)
+		select {
+		case <-tC:
+		case <-ctx.Done():
+			t.Stop()
+			return nil
+		}
+	}
+}
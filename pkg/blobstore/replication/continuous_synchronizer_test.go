@@ -0,0 +1,129 @@
+package replication_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestContinuousSynchronizerPerformScan(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	lister := mock.NewMockDigestLister(ctrl)
+	sink := mock.NewMockBlobAccess(ctrl)
+	replicator := mock.NewMockBlobReplicator(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	synchronizer := replication.NewContinuousSynchronizer(lister, sink, replicator, clock, errorLogger, 2, 1, time.Minute)
+
+	digest1 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "5b54c0a045f179bcbbbc9abcb8b5cd4c", 2)
+	digest3 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "21f843aefbfb88627ec2cad9e8f1f49a", 1)
+	listDigests := func(ctx context.Context, digestFunc func(digest.Digest) error) error {
+		for _, blobDigest := range []digest.Digest{digest1, digest2, digest3} {
+			if err := digestFunc(blobDigest); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("ListFailure", func(t *testing.T) {
+		lister.EXPECT().ListDigests(gomock.Any(), gomock.Any()).
+			Return(status.Error(codes.NotFound, "File not found"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.NotFound, "Failed to list digests: File not found"),
+			synchronizer.PerformScan(ctx))
+	})
+
+	t.Run("ReplicationFailure", func(t *testing.T) {
+		// Failures replicating a batch should cause enumeration
+		// to stop.
+		lister.EXPECT().ListDigests(gomock.Any(), gomock.Any()).DoAndReturn(listDigests)
+		sink.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest1).Add(digest2).Build()).
+			Return(digest2.ToSingletonSet(), nil)
+		replicator.EXPECT().ReplicateMultiple(gomock.Any(), digest2.ToSingletonSet()).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Failed to replicate missing blobs: Server offline"),
+			synchronizer.PerformScan(ctx))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// Digests should be processed in batches, where only
+		// missing blobs are replicated.
+		lister.EXPECT().ListDigests(gomock.Any(), gomock.Any()).DoAndReturn(listDigests)
+		sink.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest1).Add(digest2).Build()).
+			Return(digest2.ToSingletonSet(), nil)
+		replicator.EXPECT().ReplicateMultiple(gomock.Any(), digest2.ToSingletonSet())
+		sink.EXPECT().FindMissing(gomock.Any(), digest3.ToSingletonSet()).
+			Return(digest.EmptySet, nil)
+
+		require.NoError(t, synchronizer.PerformScan(ctx))
+	})
+}
+
+func TestContinuousSynchronizerRun(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	lister := mock.NewMockDigestLister(ctrl)
+	sink := mock.NewMockBlobAccess(ctrl)
+	replicator := mock.NewMockBlobReplicator(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	synchronizer := replication.NewContinuousSynchronizer(lister, sink, replicator, clock, errorLogger, 10, 2, time.Minute)
+
+	digest1 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "5b54c0a045f179bcbbbc9abcb8b5cd4c", 2)
+
+	// During the first scan, the source only contains a single
+	// blob, which is missing in the sink.
+	lister.EXPECT().ListDigests(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, digestFunc func(digest.Digest) error) error {
+			return digestFunc(digest1)
+		})
+	sink.EXPECT().FindMissing(gomock.Any(), digest1.ToSingletonSet()).Return(digest1.ToSingletonSet(), nil)
+	replicator.EXPECT().ReplicateMultiple(gomock.Any(), digest1.ToSingletonSet())
+	timer1 := mock.NewMockTimer(ctrl)
+	timerChannel1 := make(chan time.Time, 1)
+	timerChannel1 <- time.Unix(1060, 0)
+	clock.EXPECT().NewTimer(time.Minute).Return(timer1, timerChannel1)
+
+	// During the second scan, a blob has been added to the source.
+	// Only that blob should be replicated. A failure to do so
+	// should be logged, after which the next scan is scheduled.
+	lister.EXPECT().ListDigests(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, digestFunc func(digest.Digest) error) error {
+			require.NoError(t, digestFunc(digest1))
+			return digestFunc(digest2)
+		})
+	sink.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest1).Add(digest2).Build()).
+		Return(digest2.ToSingletonSet(), nil)
+	replicator.EXPECT().ReplicateMultiple(gomock.Any(), digest2.ToSingletonSet()).
+		Return(status.Error(codes.Internal, "Disk on fire"))
+	errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Internal, "Continuous synchronization failed: Failed to replicate missing blobs: Disk on fire")))
+
+	// Terminate once the third scan is scheduled.
+	ctxWithCancel, cancel := context.WithCancel(ctx)
+	timer2 := mock.NewMockTimer(ctrl)
+	clock.EXPECT().NewTimer(time.Minute).Do(func(d time.Duration) { cancel() }).Return(timer2, nil)
+	timer2.EXPECT().Stop()
+
+	require.NoError(t, synchronizer.Run(ctxWithCancel, nil, nil))
+}
@@ -0,0 +1,62 @@
+package replication
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// DemultiplexedBlobReplicatorGetter is a callback that is provided to
+// instances of DemultiplexingBlobReplicator to resolve instance names
+// to the BlobReplicator to which requests need to be forwarded.
+type DemultiplexedBlobReplicatorGetter func(i digest.InstanceName) (BlobReplicator, error)
+
+type demultiplexingBlobReplicator struct {
+	getReplicator DemultiplexedBlobReplicatorGetter
+}
+
+// NewDemultiplexingBlobReplicator creates a BlobReplicator that
+// forwards requests to one of multiple BlobReplicators, based on the
+// instance name of the objects being replicated. This can be used to
+// apply different replication strategies to different instance names.
+// For example, objects belonging to one instance name may be
+// replicated synchronously, while others are replicated
+// asynchronously.
+func NewDemultiplexingBlobReplicator(getReplicator DemultiplexedBlobReplicatorGetter) BlobReplicator {
+	return &demultiplexingBlobReplicator{
+		getReplicator: getReplicator,
+	}
+}
+
+func (br *demultiplexingBlobReplicator) ReplicateSingle(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	replicator, err := br.getReplicator(blobDigest.GetInstanceName())
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return replicator.ReplicateSingle(ctx, blobDigest)
+}
+
+func (br *demultiplexingBlobReplicator) ReplicateComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	replicator, err := br.getReplicator(parentDigest.GetInstanceName())
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return replicator.ReplicateComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (br *demultiplexingBlobReplicator) ReplicateMultiple(ctx context.Context, digests digest.Set) error {
+	for _, instanceNameDigests := range digests.PartitionByInstanceName() {
+		instanceName := instanceNameDigests.Items()[0].GetInstanceName()
+		replicator, err := br.getReplicator(instanceName)
+		if err != nil {
+			return err
+		}
+		if err := replicator.ReplicateMultiple(ctx, instanceNameDigests); err != nil {
+			return util.StatusWrapf(err, "Instance name %#v", instanceName.String())
+		}
+	}
+	return nil
+}
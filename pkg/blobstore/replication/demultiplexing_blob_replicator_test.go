@@ -0,0 +1,99 @@
+package replication_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestDemultiplexingBlobReplicator(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Let objects in instance name "release" be replicated
+	// synchronously, while objects in instance name "ci" are
+	// replicated asynchronously.
+	synchronousReplicator := mock.NewMockBlobReplicator(ctrl)
+	source := mock.NewMockBlobAccess(ctrl)
+	asynchronousBaseReplicator := mock.NewMockBlobReplicator(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	asynchronousReplicator := replication.NewAsynchronousBlobReplicator(source, asynchronousBaseReplicator, errorLogger, 10)
+
+	replicatorsTrie := digest.NewInstanceNameTrie()
+	replicators := []replication.BlobReplicator{synchronousReplicator, asynchronousReplicator}
+	replicatorsTrie.Set(digest.MustNewInstanceName("release"), 0)
+	replicatorsTrie.Set(digest.MustNewInstanceName("ci"), 1)
+	blobReplicator := replication.NewDemultiplexingBlobReplicator(func(i digest.InstanceName) (replication.BlobReplicator, error) {
+		idx := replicatorsTrie.GetLongestPrefix(i)
+		if idx < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown instance name: %#v", i.String())
+		}
+		return replicators[idx], nil
+	})
+
+	releaseDigest := digest.MustNewDigest("release", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	ciDigest := digest.MustNewDigest("ci/linux", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("UnknownInstanceName", func(t *testing.T) {
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Unknown instance name: \"other\""),
+			blobReplicator.ReplicateMultiple(ctx, digest.MustNewDigest("other", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5).ToSingletonSet()))
+	})
+
+	t.Run("Synchronous", func(t *testing.T) {
+		// Replication of objects in the "release" instance name
+		// should complete before the call returns. Errors
+		// should be propagated.
+		synchronousReplicator.EXPECT().ReplicateMultiple(ctx, releaseDigest.ToSingletonSet()).
+			Return(status.Error(codes.Unavailable, "Server offline"))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Instance name \"release\": Server offline"),
+			blobReplicator.ReplicateMultiple(ctx, releaseDigest.ToSingletonSet()))
+	})
+
+	t.Run("Asynchronous", func(t *testing.T) {
+		// Calls for objects in the "ci" instance name should
+		// return immediately, even if replication is still in
+		// progress.
+		started := make(chan struct{})
+		release := make(chan struct{})
+		asynchronousBaseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), ciDigest.ToSingletonSet()).
+			DoAndReturn(func(ctx context.Context, digests digest.Set) error {
+				close(started)
+				<-release
+				return nil
+			})
+
+		require.NoError(t, blobReplicator.ReplicateMultiple(ctx, ciDigest.ToSingletonSet()))
+		<-started
+		close(release)
+	})
+
+	t.Run("Mixed", func(t *testing.T) {
+		// Requests containing objects from multiple instance
+		// names should be split up.
+		finished := make(chan struct{})
+		synchronousReplicator.EXPECT().ReplicateMultiple(ctx, releaseDigest.ToSingletonSet())
+		asynchronousBaseReplicator.EXPECT().ReplicateMultiple(gomock.Any(), ciDigest.ToSingletonSet()).
+			DoAndReturn(func(ctx context.Context, digests digest.Set) error {
+				close(finished)
+				return nil
+			})
+
+		require.NoError(t, blobReplicator.ReplicateMultiple(ctx, digest.NewSetBuilder().Add(releaseDigest).Add(ciDigest).Build()))
+		<-finished
+	})
+}
@@ -0,0 +1,68 @@
+package replication
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"strings"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+// DigestLister is used by ContinuousSynchronizer to enumerate the
+// digests of objects that should be present in the sink.
+type DigestLister interface {
+	// ListDigests invokes a callback for every digest that is
+	// enumerated. Enumeration stops if the callback returns an
+	// error.
+	ListDigests(ctx context.Context, digestFunc func(digest.Digest) error) error
+}
+
+type fileDigestLister struct {
+	path string
+}
+
+// NewFileDigestLister creates a DigestLister that reads digests from a
+// text file. Every line in the file contains a single digest, using the
+// same format as the resource names used by ByteStream.Read() (e.g.,
+// "instance/blobs/sha256/<hash>/<size>"). Empty lines are ignored.
+//
+// The file is reopened every time the digests are enumerated, meaning
+// that changes to the file are picked up by the next enumeration
+// without restarting the process. The file is kept open while
+// enumerating. Replacing the file atomically (i.e., writing a new file
+// and renaming it over the old one) ensures that an enumeration in
+// progress continues to observe the old contents.
+func NewFileDigestLister(path string) DigestLister {
+	return &fileDigestLister{
+		path: path,
+	}
+}
+
+func (dl *fileDigestLister) ListDigests(ctx context.Context, digestFunc func(digest.Digest) error) error {
+	f, err := os.Open(dl.path)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to open %#v", dl.path)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		blobDigest, _, err := digest.NewDigestFromByteStreamReadPath(line)
+		if err != nil {
+			return util.StatusWrapf(err, "Invalid digest on line %d", lineNumber)
+		}
+		if err := digestFunc(blobDigest); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return util.StatusWrapf(err, "Failed to read %#v", dl.path)
+	}
+	return nil
+}
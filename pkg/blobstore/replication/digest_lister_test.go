@@ -0,0 +1,80 @@
+package replication_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFileDigestLister(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "digests")
+	lister := replication.NewFileDigestLister(path)
+
+	t.Run("NonExistent", func(t *testing.T) {
+		testutil.RequirePrefixedStatus(
+			t,
+			status.Errorf(codes.Unknown, "Failed to open %#v: ", path),
+			lister.ListDigests(ctx, func(blobDigest digest.Digest) error {
+				t.Fatal("Callback should not be invoked")
+				return nil
+			}))
+	})
+
+	t.Run("InvalidDigest", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("hello\n"), 0o644))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.InvalidArgument, "Invalid digest on line 1: Invalid resource naming scheme"),
+			lister.ListDigests(ctx, func(blobDigest digest.Digest) error {
+				t.Fatal("Callback should not be invoked")
+				return nil
+			}))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(
+			"instance/blobs/8b1a9953c4611296a827abf8c47804d7/5\n"+
+				"\n"+
+				"blobs/185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5\n"), 0o644))
+
+		var digests []digest.Digest
+		require.NoError(t, lister.ListDigests(ctx, func(blobDigest digest.Digest) error {
+			digests = append(digests, blobDigest)
+			return nil
+		}))
+		require.Equal(t, []digest.Digest{
+			digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+			digest.MustNewDigest("", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5),
+		}, digests)
+	})
+
+	t.Run("Reread", func(t *testing.T) {
+		// Every enumeration should reopen the file, so that
+		// changes to it are picked up. Replace the file
+		// atomically, as is recommended.
+		newPath := path + ".new"
+		require.NoError(t, os.WriteFile(newPath, []byte("instance/blobs/8b1a9953c4611296a827abf8c47804d7/5\n"), 0o644))
+		require.NoError(t, os.Rename(newPath, path))
+
+		var digests []digest.Digest
+		require.NoError(t, lister.ListDigests(ctx, func(blobDigest digest.Digest) error {
+			digests = append(digests, blobDigest)
+			return nil
+		}))
+		require.Equal(t, []digest.Digest{
+			digest.MustNewDigest("instance", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+		}, digests)
+	})
+}
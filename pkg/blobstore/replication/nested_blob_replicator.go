@@ -11,12 +11,13 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/util"
 
+	"golang.org/x/sync/semaphore"
 	"google.golang.org/protobuf/encoding/protowire"
 )
 
 type blobToReplicate struct {
 	digest       digest.Digest
-	expanderFunc func(ctx context.Context, b buffer.Buffer) error
+	expanderFunc expanderFunc
 }
 
 // NestedBlobReplicator is a helper type for BlobReplicator that can be
@@ -27,6 +28,8 @@ type NestedBlobReplicator struct {
 	replicator              BlobReplicator
 	digestKeyFormat         digest.KeyFormat
 	maximumMessageSizeBytes int
+	maximumInFlightBytes    int64
+	inFlightBytes           *semaphore.Weighted
 
 	lock             sync.Mutex
 	blobsSeen        map[string]struct{}
@@ -37,28 +40,106 @@ type NestedBlobReplicator struct {
 
 // NewNestedBlobReplicator creates a new NestedBlobReplicator that does
 // not have any objects to be replicated queued.
-func NewNestedBlobReplicator(replicator BlobReplicator, digestKeyFormat digest.KeyFormat, maximumMessageSizeBytes int) *NestedBlobReplicator {
-	return &NestedBlobReplicator{
+//
+// If maximumInFlightBytes is positive, it limits the total size of the
+// objects that are being replicated at any given time. This bounds the
+// amount of memory used when many large objects are replicated in
+// parallel. Objects larger than this limit are replicated without any
+// other objects being in flight.
+func NewNestedBlobReplicator(replicator BlobReplicator, digestKeyFormat digest.KeyFormat, maximumMessageSizeBytes int, maximumInFlightBytes int64) *NestedBlobReplicator {
+	nr := &NestedBlobReplicator{
 		replicator:              replicator,
 		digestKeyFormat:         digestKeyFormat,
 		maximumMessageSizeBytes: maximumMessageSizeBytes,
 
 		blobsSeen: map[string]struct{}{},
 	}
+	if maximumInFlightBytes > 0 {
+		nr.maximumInFlightBytes = maximumInFlightBytes
+		nr.inFlightBytes = semaphore.NewWeighted(maximumInFlightBytes)
+	}
+	return nr
+}
+
+// expanderFunc is called to parse the contents of an object that is
+// being replicated. It enqueues any nested objects that need to be
+// expanded as well, and returns the digests of any other objects that
+// are referenced by it.
+type expanderFunc func(ctx context.Context, b buffer.Buffer) (digest.Set, error)
+
+// acquireInFlightBytes reserves space for objects of a given total size
+// in the budget of bytes that may be in flight. The amount of space
+// that was reserved is returned, so that it may be released afterwards.
+func (nr *NestedBlobReplicator) acquireInFlightBytes(ctx context.Context, sizeBytes int64) (int64, error) {
+	if nr.inFlightBytes == nil {
+		return 0, nil
+	}
+	sizeBytes = min(sizeBytes, nr.maximumInFlightBytes)
+	if err := nr.inFlightBytes.Acquire(ctx, sizeBytes); err != nil {
+		return 0, util.StatusFromContext(ctx)
+	}
+	return sizeBytes, nil
+}
+
+func (nr *NestedBlobReplicator) releaseInFlightBytes(sizeBytes int64) {
+	if sizeBytes > 0 {
+		nr.inFlightBytes.Release(sizeBytes)
+	}
 }
 
-func (nr *NestedBlobReplicator) enqueue(blobDigest digest.Digest, expanderFunc func(ctx context.Context, b buffer.Buffer) error) {
+// replicateMultiple replicates a set of objects that don't need to be
+// expanded. If the number of bytes in flight is limited, the set is
+// split up into batches that each fit within this limit.
+func (nr *NestedBlobReplicator) replicateMultiple(ctx context.Context, digests digest.Set) error {
+	if nr.inFlightBytes == nil {
+		return nr.replicator.ReplicateMultiple(ctx, digests)
+	}
+
+	remaining := digests.Items()
+	for len(remaining) > 0 {
+		batch := digest.NewSetBuilder()
+		var batchSizeBytes int64
+		n := 0
+		for n < len(remaining) && (n == 0 || batchSizeBytes+remaining[n].GetSizeBytes() <= nr.maximumInFlightBytes) {
+			batch.Add(remaining[n])
+			batchSizeBytes += remaining[n].GetSizeBytes()
+			n++
+		}
+		remaining = remaining[n:]
+
+		acquiredBytes, err := nr.acquireInFlightBytes(ctx, batchSizeBytes)
+		if err != nil {
+			return err
+		}
+		err = nr.replicator.ReplicateMultiple(ctx, batch.Build())
+		nr.releaseInFlightBytes(acquiredBytes)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (nr *NestedBlobReplicator) enqueue(blobDigest digest.Digest, newExpanderFunc func(blobDigest digest.Digest) expanderFunc) {
+	nr.enqueueMultiple([]digest.Digest{blobDigest}, newExpanderFunc)
+}
+
+// enqueueMultiple enqueues a list of objects to be replicated, while
+// only acquiring the lock once.
+func (nr *NestedBlobReplicator) enqueueMultiple(blobDigests []digest.Digest, newExpanderFunc func(blobDigest digest.Digest) expanderFunc) {
 	nr.lock.Lock()
 	defer nr.lock.Unlock()
 
-	key := blobDigest.GetKey(nr.digestKeyFormat)
-	if _, ok := nr.blobsSeen[key]; !ok {
-		nr.blobsSeen[key] = struct{}{}
-		nr.blobsToReplicate = append(nr.blobsToReplicate, blobToReplicate{
-			digest:       blobDigest,
-			expanderFunc: expanderFunc,
-		})
-		nr.maybeWakeUpLocked()
+	for _, blobDigest := range blobDigests {
+		key := blobDigest.GetKey(nr.digestKeyFormat)
+		if _, ok := nr.blobsSeen[key]; !ok {
+			nr.blobsSeen[key] = struct{}{}
+			nr.blobsToReplicate = append(nr.blobsToReplicate, blobToReplicate{
+				digest:       blobDigest,
+				expanderFunc: newExpanderFunc(blobDigest),
+			})
+			nr.maybeWakeUpLocked()
+		}
 	}
 }
 
@@ -72,47 +153,66 @@ func (nr *NestedBlobReplicator) maybeWakeUpLocked() {
 // EnqueueAction enqueues an REv2 Action to be replicated. The
 // referenced input root and Command message will be replicated as well.
 func (nr *NestedBlobReplicator) EnqueueAction(actionDigest digest.Digest) {
+	nr.enqueue(actionDigest, nr.newActionExpander)
+}
+
+// EnqueueActions enqueues a list of REv2 Actions to be replicated. This
+// is equivalent to calling EnqueueAction() for each of them, except
+// that the lock is only acquired once.
+func (nr *NestedBlobReplicator) EnqueueActions(actionDigests []digest.Digest) {
+	nr.enqueueMultiple(actionDigests, nr.newActionExpander)
+}
+
+func (nr *NestedBlobReplicator) newActionExpander(actionDigest digest.Digest) expanderFunc {
 	digestFunction := actionDigest.GetDigestFunction()
-	nr.enqueue(actionDigest, func(ctx context.Context, b buffer.Buffer) error {
+	return func(ctx context.Context, b buffer.Buffer) (digest.Set, error) {
 		actionMessage, err := b.ToProto(&remoteexecution.Action{}, nr.maximumMessageSizeBytes)
 		if err != nil {
-			return err
+			return digest.EmptySet, err
 		}
 		action := actionMessage.(*remoteexecution.Action)
 
 		inputRootDigest, err := digestFunction.NewDigestFromProto(action.InputRootDigest)
 		if err != nil {
-			return util.StatusWrap(err, "Invalid input root digest")
+			return digest.EmptySet, util.StatusWrap(err, "Invalid input root digest")
 		}
 		nr.EnqueueDirectory(inputRootDigest)
 
 		commandDigest, err := digestFunction.NewDigestFromProto(action.CommandDigest)
 		if err != nil {
-			return util.StatusWrap(err, "Invalid command digest")
+			return digest.EmptySet, util.StatusWrap(err, "Invalid command digest")
 		}
-		if err := nr.replicator.ReplicateMultiple(ctx, commandDigest.ToSingletonSet()); err != nil {
-			return util.StatusWrap(err, "Failed to replicate command")
-		}
-		return nil
-	})
+		return commandDigest.ToSingletonSet(), nil
+	}
 }
 
 // EnqueueDirectory enqueues an REv2 Directory to be replicated. Any
 // referenced file or child Directory message will be replicated as
 // well, recursively.
 func (nr *NestedBlobReplicator) EnqueueDirectory(directoryDigest digest.Digest) {
+	nr.enqueue(directoryDigest, nr.newDirectoryExpander)
+}
+
+// EnqueueDirectories enqueues a list of REv2 Directories to be
+// replicated. This is equivalent to calling EnqueueDirectory() for
+// each of them, except that the lock is only acquired once.
+func (nr *NestedBlobReplicator) EnqueueDirectories(directoryDigests []digest.Digest) {
+	nr.enqueueMultiple(directoryDigests, nr.newDirectoryExpander)
+}
+
+func (nr *NestedBlobReplicator) newDirectoryExpander(directoryDigest digest.Digest) expanderFunc {
 	digestFunction := directoryDigest.GetDigestFunction()
-	nr.enqueue(directoryDigest, func(ctx context.Context, b buffer.Buffer) error {
+	return func(ctx context.Context, b buffer.Buffer) (digest.Set, error) {
 		directoryMessage, err := b.ToProto(&remoteexecution.Directory{}, nr.maximumMessageSizeBytes)
 		if err != nil {
-			return err
+			return digest.EmptySet, err
 		}
 		directory := directoryMessage.(*remoteexecution.Directory)
 
 		for i, childDirectory := range directory.Directories {
 			childDigest, err := digestFunction.NewDigestFromProto(childDirectory.Digest)
 			if err != nil {
-				return util.StatusWrapf(err, "Invalid digest for directory at index %d", i)
+				return digest.EmptySet, util.StatusWrapf(err, "Invalid digest for directory at index %d", i)
 			}
 			nr.EnqueueDirectory(childDigest)
 		}
@@ -121,22 +221,30 @@ func (nr *NestedBlobReplicator) EnqueueDirectory(directoryDigest digest.Digest)
 		for i, childFile := range directory.Files {
 			childFileDigest, err := digestFunction.NewDigestFromProto(childFile.Digest)
 			if err != nil {
-				return util.StatusWrapf(err, "Invalid digest for file at index %d", i)
+				return digest.EmptySet, util.StatusWrapf(err, "Invalid digest for file at index %d", i)
 			}
 			childFileDigests.Add(childFileDigest)
 		}
-		if err := nr.replicator.ReplicateMultiple(ctx, childFileDigests.Build()); err != nil {
-			return util.StatusWrap(err, "Failed to replicate files")
-		}
-		return nil
-	})
+		return childFileDigests.Build(), nil
+	}
 }
 
 // EnqueueTree enqueues an REv2 Tree to be replicated. Any referenced
 // file will be replicated as well.
 func (nr *NestedBlobReplicator) EnqueueTree(treeDigest digest.Digest) {
+	nr.enqueue(treeDigest, nr.newTreeExpander)
+}
+
+// EnqueueTrees enqueues a list of REv2 Trees to be replicated. This is
+// equivalent to calling EnqueueTree() for each of them, except that
+// the lock is only acquired once.
+func (nr *NestedBlobReplicator) EnqueueTrees(treeDigests []digest.Digest) {
+	nr.enqueueMultiple(treeDigests, nr.newTreeExpander)
+}
+
+func (nr *NestedBlobReplicator) newTreeExpander(treeDigest digest.Digest) expanderFunc {
 	digestFunction := treeDigest.GetDigestFunction()
-	nr.enqueue(treeDigest, func(ctx context.Context, b buffer.Buffer) error {
+	return func(ctx context.Context, b buffer.Buffer) (digest.Set, error) {
 		r := b.ToReader()
 		defer r.Close()
 
@@ -168,16 +276,12 @@ func (nr *NestedBlobReplicator) EnqueueTree(treeDigest digest.Digest) {
 			// reading the Tree until completion, and prefer
 			// read errors over any errors generated above.
 			if _, copyErr := io.Copy(io.Discard, r); copyErr != nil {
-				return copyErr
+				return digest.EmptySet, copyErr
 			}
-			return err
-		}
-
-		if err := nr.replicator.ReplicateMultiple(ctx, childFileDigests.Build()); err != nil {
-			return util.StatusWrap(err, "Failed to replicate files")
+			return digest.EmptySet, err
 		}
-		return nil
-	})
+		return childFileDigests.Build(), nil
+	}
 }
 
 // Replicate objects that are enqueued. This method will continue to run
@@ -214,10 +318,7 @@ func (nr *NestedBlobReplicator) Replicate(ctx context.Context) error {
 		// Replicate a single object.
 		nr.blobsReplicating++
 		nr.lock.Unlock()
-		err := blobToReplicate.expanderFunc(
-			ctx,
-			nr.replicator.ReplicateSingle(ctx, blobToReplicate.digest),
-		)
+		err := nr.replicateNested(ctx, blobToReplicate)
 		nr.lock.Lock()
 		nr.blobsReplicating--
 
@@ -234,3 +335,29 @@ func (nr *NestedBlobReplicator) Replicate(ctx context.Context) error {
 		}
 	}
 }
+
+// replicateNested replicates a single object that needs to be expanded,
+// followed by any objects referenced by it that don't need expansion.
+//
+// Space in the budget of bytes in flight is only held for the object
+// itself while it's being expanded. It is released before replicating
+// the objects referenced by it, as goroutines holding on to it while
+// waiting for more space could otherwise cause a deadlock.
+func (nr *NestedBlobReplicator) replicateNested(ctx context.Context, blobToReplicate blobToReplicate) error {
+	acquiredBytes, err := nr.acquireInFlightBytes(ctx, blobToReplicate.digest.GetSizeBytes())
+	if err != nil {
+		return err
+	}
+	referencedDigests, err := blobToReplicate.expanderFunc(
+		ctx,
+		nr.replicator.ReplicateSingle(ctx, blobToReplicate.digest),
+	)
+	nr.releaseInFlightBytes(acquiredBytes)
+	if err != nil {
+		return err
+	}
+	if err := nr.replicateMultiple(ctx, referencedDigests); err != nil {
+		return util.StatusWrap(err, "Failed to replicate referenced objects")
+	}
+	return nil
+}
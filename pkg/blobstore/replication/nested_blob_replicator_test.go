@@ -2,7 +2,10 @@ package replication_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
@@ -18,7 +21,7 @@ func TestNestedBlobReplicator(t *testing.T) {
 	ctrl, ctx := gomock.WithContext(context.Background(), t)
 
 	replicator := mock.NewMockBlobReplicator(ctrl)
-	nestedReplicator := replication.NewNestedBlobReplicator(replicator, digest.KeyWithoutInstance, 10000)
+	nestedReplicator := replication.NewNestedBlobReplicator(replicator, digest.KeyWithoutInstance, 10000, 0)
 
 	t.Run("Nothing", func(t *testing.T) {
 		// Replication returns immediately if nothing is enqueued.
@@ -154,4 +157,103 @@ func TestNestedBlobReplicator(t *testing.T) {
 
 		require.NoError(t, nestedReplicator.Replicate(ctx))
 	})
+	t.Run("ManyTrees", func(t *testing.T) {
+		// Large numbers of objects may be enqueued at once.
+		// Every object should only be replicated once, even if
+		// it is enqueued multiple times.
+		treeDigests := make([]digest.Digest, 0, 2000)
+		for i := 0; i < 2000; i++ {
+			treeDigests = append(treeDigests, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", i%1000), 5))
+		}
+		nestedReplicator.EnqueueTrees(treeDigests)
+
+		var lock sync.Mutex
+		replicated := map[digest.Digest]int{}
+		replicator.EXPECT().ReplicateSingle(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+				lock.Lock()
+				replicated[blobDigest]++
+				lock.Unlock()
+				return buffer.NewProtoBufferFromProto(&remoteexecution.Tree{}, buffer.UserProvided)
+			}).Times(1000)
+
+		require.NoError(t, nestedReplicator.Replicate(ctx))
+		require.Len(t, replicated, 1000)
+	})
+}
+
+func TestNestedBlobReplicatorMaximumInFlightBytes(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	replicator := mock.NewMockBlobReplicator(ctrl)
+	nestedReplicator := replication.NewNestedBlobReplicator(replicator, digest.KeyWithoutInstance, 10000, 1000)
+
+	// Create a number of directories, each containing a mix of
+	// large and small files. One of the directories contains a
+	// file that exceeds the limit on its own.
+	expectedFiles := digest.NewSetBuilder()
+	for i := 0; i < 20; i++ {
+		directoryDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", i), 50)
+		var files []*remoteexecution.FileNode
+		for j, sizeBytes := range []int64{900, 100, 100, 300} {
+			if i == 7 && j == 0 {
+				sizeBytes = 5000
+			}
+			fileDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%016x%016x", i+1, j), sizeBytes)
+			files = append(files, &remoteexecution.FileNode{
+				Name:   fmt.Sprintf("file%d", j),
+				Digest: fileDigest.GetProto(),
+			})
+			expectedFiles.Add(fileDigest)
+		}
+		replicator.EXPECT().ReplicateSingle(gomock.Any(), directoryDigest).
+			Return(buffer.NewProtoBufferFromProto(&remoteexecution.Directory{Files: files}, buffer.UserProvided))
+		nestedReplicator.EnqueueDirectory(directoryDigest)
+	}
+
+	// Keep track of the number of bytes in flight. Objects larger
+	// than the limit should be replicated on their own, and count
+	// against the limit as if they were as large as the limit.
+	var lock sync.Mutex
+	var inFlightBytes, maximumObservedInFlightBytes int64
+	replicatedFiles := digest.NewSetBuilder()
+	replicator.EXPECT().ReplicateMultiple(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, digests digest.Set) error {
+			var sizeBytes int64
+			for _, blobDigest := range digests.Items() {
+				sizeBytes += blobDigest.GetSizeBytes()
+			}
+			if sizeBytes > 1000 {
+				require.Equal(t, 1, digests.Length())
+				sizeBytes = 1000
+			}
+
+			lock.Lock()
+			inFlightBytes += sizeBytes
+			maximumObservedInFlightBytes = max(maximumObservedInFlightBytes, inFlightBytes)
+			for _, blobDigest := range digests.Items() {
+				replicatedFiles.Add(blobDigest)
+			}
+			lock.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			lock.Lock()
+			inFlightBytes -= sizeBytes
+			lock.Unlock()
+			return nil
+		}).AnyTimes()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, nestedReplicator.Replicate(ctx))
+		}()
+	}
+	wg.Wait()
+
+	require.LessOrEqual(t, maximumObservedInFlightBytes, int64(1000))
+	require.Equal(t, expectedFiles.Build(), replicatedFiles.Build())
 }
@@ -0,0 +1,48 @@
+package replication
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReplicateConcurrently replicates a list of objects individually,
+// using up to a given number of goroutines, which must be positive.
+// Unlike calling ReplicateMultiple() against all objects at once,
+// failures to replicate individual objects do not prevent other
+// objects from being replicated. Every failure is reported through the provided
+// ErrorLogger, after which an error is returned that summarizes the
+// number of failures.
+func ReplicateConcurrently(ctx context.Context, replicator BlobReplicator, digests []digest.Digest, concurrency int, errorLogger util.ErrorLogger) error {
+	digestsChannel := make(chan digest.Digest)
+	var failures atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for blobDigest := range digestsChannel {
+				if err := replicator.ReplicateMultiple(ctx, blobDigest.ToSingletonSet()); err != nil {
+					failures.Add(1)
+					errorLogger.Log(util.StatusWrapf(err, "Failed to replicate blob with digest %#v", blobDigest.String()))
+				}
+			}
+		}()
+	}
+	for _, blobDigest := range digests {
+		digestsChannel <- blobDigest
+	}
+	close(digestsChannel)
+	wg.Wait()
+
+	if n := failures.Load(); n > 0 {
+		return status.Errorf(codes.Internal, "Failed to replicate %d out of %d blobs", n, len(digests))
+	}
+	return nil
+}
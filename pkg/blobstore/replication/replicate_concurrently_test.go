@@ -0,0 +1,79 @@
+package replication_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestReplicateConcurrently(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	digests := make([]digest.Digest, 0, 20)
+	for i := 0; i < 20; i++ {
+		digests = append(digests, digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", i), int64(i)))
+	}
+
+	t.Run("Parallel", func(t *testing.T) {
+		// Let the first four calls block until all of them have
+		// been started. This would deadlock if replication was
+		// performed sequentially.
+		replicator := mock.NewMockBlobReplicator(ctrl)
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		var lock sync.Mutex
+		started := 0
+		allStarted := make(chan struct{})
+		replicated := map[digest.Digest]bool{}
+		replicator.EXPECT().ReplicateMultiple(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digests digest.Set) error {
+				lock.Lock()
+				blobDigest, _ := digests.First()
+				replicated[blobDigest] = true
+				started++
+				if started == 4 {
+					close(allStarted)
+				}
+				lock.Unlock()
+				<-allStarted
+				return nil
+			}).Times(20)
+
+		require.NoError(t, replication.ReplicateConcurrently(ctx, replicator, digests, 4, errorLogger))
+		require.Len(t, replicated, 20)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		// A failure to replicate a single blob should be
+		// reported, but not prevent other blobs from being
+		// replicated.
+		replicator := mock.NewMockBlobReplicator(ctrl)
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		for i, blobDigest := range digests {
+			if i == 7 {
+				replicator.EXPECT().ReplicateMultiple(ctx, blobDigest.ToSingletonSet()).
+					Return(status.Error(codes.Unavailable, "Server not reachable"))
+			} else {
+				replicator.EXPECT().ReplicateMultiple(ctx, blobDigest.ToSingletonSet())
+			}
+		}
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to replicate blob with digest \"3-00000000000000000000000000000007-7-example\": Server not reachable")))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Internal, "Failed to replicate 1 out of 20 blobs"),
+			replication.ReplicateConcurrently(ctx, replicator, digests, 4, errorLogger))
+	})
+}
@@ -23,7 +23,7 @@ func NewReplicatorServer(replicator BlobReplicator) replicator_pb.ReplicatorServ
 }
 
 func (rs replicatorServer) ReplicateBlobs(ctx context.Context, request *replicator_pb.ReplicateBlobsRequest) (*emptypb.Empty, error) {
-	instanceName, err := digest.NewInstanceName(request.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(request.InstanceName)
 	if err != nil {
 		return nil, util.StatusWrapf(err, "Invalid instance name %#v", request.InstanceName)
 	}
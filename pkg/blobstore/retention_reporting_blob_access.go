@@ -0,0 +1,178 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	retentionReportingBlobAccessPrometheusMetrics sync.Once
+
+	retentionReportingBlobAccessOldestRetainedBlobAgeSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "retention_reporting_blob_access_oldest_retained_blob_age_seconds",
+			Help:      "Age of the oldest sampled object that is still present in the backend, in seconds",
+		},
+		[]string{"storage_type"})
+)
+
+type retentionSample struct {
+	digest    digest.Digest
+	timestamp time.Time
+}
+
+// RetentionReportingBlobAccess is a decorator for BlobAccess that
+// estimates the retention window achieved by a backend, meaning the age
+// of the oldest object that is still present.
+//
+// It does this by sampling objects written through Put(), recording the
+// time at which they were written. It periodically calls FindMissing()
+// against the backend to determine which of the samples are still
+// present. The age of the oldest sample that is still present is
+// exposed as a Prometheus metric.
+//
+// Calls to FindMissing() are performed with refreshing disabled, so
+// that probing does not cause samples to be retained for longer than
+// other objects. Backends that do not honor this (e.g., remote backends
+// accessed over gRPC) may still refresh the samples, in which case the
+// reported age should be considered an upper bound.
+type RetentionReportingBlobAccess struct {
+	BlobAccess
+	clock          clock.Clock
+	sampleInterval time.Duration
+	maximumSamples int
+
+	lock              sync.Mutex
+	samples           []retentionSample
+	lastSampleTime    time.Time
+	oldestRetainedAge prometheus.Gauge
+}
+
+// NewRetentionReportingBlobAccess creates a RetentionReportingBlobAccess
+// that samples at most one object written through Put() per
+// sampleInterval. At most maximumSamples samples are retained, meaning
+// that the largest age that can be reported is roughly the product of
+// both values.
+func NewRetentionReportingBlobAccess(base BlobAccess, clock clock.Clock, sampleInterval time.Duration, maximumSamples int, storageType string) *RetentionReportingBlobAccess {
+	retentionReportingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(retentionReportingBlobAccessOldestRetainedBlobAgeSeconds)
+	})
+
+	return &RetentionReportingBlobAccess{
+		BlobAccess:        base,
+		clock:             clock,
+		sampleInterval:    sampleInterval,
+		maximumSamples:    maximumSamples,
+		oldestRetainedAge: retentionReportingBlobAccessOldestRetainedBlobAgeSeconds.WithLabelValues(storageType),
+	}
+}
+
+// Put an object in the backend, recording the time at which it was
+// written if sufficient time has passed since the last sample was
+// taken.
+func (ba *RetentionReportingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	if err := ba.BlobAccess.Put(ctx, blobDigest, b); err != nil {
+		return err
+	}
+
+	now := ba.clock.Now()
+	ba.lock.Lock()
+	if !now.Before(ba.lastSampleTime.Add(ba.sampleInterval)) {
+		ba.lastSampleTime = now
+		ba.samples = append(ba.samples, retentionSample{
+			digest:    blobDigest,
+			timestamp: now,
+		})
+		if len(ba.samples) > ba.maximumSamples {
+			ba.samples = ba.samples[len(ba.samples)-ba.maximumSamples:]
+		}
+	}
+	ba.lock.Unlock()
+	return nil
+}
+
+// GetOldestRetainedBlobAge returns the age of the oldest sampled object
+// that is still present in the backend. Samples that are older than it
+// are discarded, as these objects have been evicted. If none of the
+// samples are present, false is returned.
+func (ba *RetentionReportingBlobAccess) GetOldestRetainedBlobAge(ctx context.Context) (time.Duration, bool, error) {
+	ba.lock.Lock()
+	samples := ba.samples
+	ba.lock.Unlock()
+	if len(samples) == 0 {
+		return 0, false, nil
+	}
+
+	digests := digest.NewSetBuilder()
+	for _, sample := range samples {
+		digests.Add(sample.digest)
+	}
+	missing, err := ba.BlobAccess.FindMissing(NewContextWithRefreshingDisabled(ctx), digests.Build())
+	if err != nil {
+		return 0, false, util.StatusWrap(err, "Failed to determine which samples are present")
+	}
+	missingDigests := make(map[digest.Digest]struct{}, missing.Length())
+	for _, blobDigest := range missing.Items() {
+		missingDigests[blobDigest] = struct{}{}
+	}
+
+	// Samples are stored in chronological order, meaning the first
+	// one that is present is the oldest.
+	oldestPresent := len(samples)
+	for i, sample := range samples {
+		if _, ok := missingDigests[sample.digest]; !ok {
+			oldestPresent = i
+			break
+		}
+	}
+
+	// Discard samples that have been evicted. Samples may have been
+	// added or removed in the meantime, so compare by timestamp.
+	var cutoff time.Time
+	if oldestPresent < len(samples) {
+		cutoff = samples[oldestPresent].timestamp
+	} else {
+		cutoff = samples[len(samples)-1].timestamp.Add(time.Nanosecond)
+	}
+	ba.lock.Lock()
+	firstRetained := 0
+	for firstRetained < len(ba.samples) && ba.samples[firstRetained].timestamp.Before(cutoff) {
+		firstRetained++
+	}
+	ba.samples = ba.samples[firstRetained:]
+	ba.lock.Unlock()
+
+	if oldestPresent == len(samples) {
+		return 0, false, nil
+	}
+	return ba.clock.Now().Sub(samples[oldestPresent].timestamp), true, nil
+}
+
+// Run the loop that periodically computes the age of the oldest
+// object that is still present, and exposes it as a Prometheus metric.
+func (ba *RetentionReportingBlobAccess) Run(ctx context.Context, reportInterval time.Duration, errorLogger util.ErrorLogger) {
+	for {
+		if age, ok, err := ba.GetOldestRetainedBlobAge(ctx); err != nil {
+			errorLogger.Log(err)
+		} else if ok {
+			ba.oldestRetainedAge.Set(age.Seconds())
+		}
+
+		timer, timerChannel := ba.clock.NewTimer(reportInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timerChannel:
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package blobstore_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/local"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRetentionReportingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewRetentionReportingBlobAccess(baseBlobAccess, clock, time.Minute, 3, "cas")
+
+	digest1 := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000001", 1)
+	digest2 := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000002", 1)
+	digest3 := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000003", 1)
+	digest4 := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000004", 1)
+	digest5 := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "00000000000000000000000000000005", 1)
+
+	put := func(blobDigest digest.Digest, now time.Time) {
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		clock.EXPECT().Now().Return(now)
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("x"))))
+	}
+
+	t.Run("NoSamples", func(t *testing.T) {
+		_, ok, err := blobAccess.GetOldestRetainedBlobAge(ctx)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+
+	// Only one object per minute should be sampled. As at most three
+	// samples are retained, the sample for digest1 gets discarded.
+	put(digest1, time.Unix(1000, 0))
+	put(digest2, time.Unix(1030, 0))
+	put(digest3, time.Unix(1060, 0))
+	put(digest4, time.Unix(1120, 0))
+	put(digest5, time.Unix(1180, 0))
+
+	t.Run("FindMissingFailure", func(t *testing.T) {
+		baseBlobAccess.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest3).Add(digest4).Add(digest5).Build()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+
+		_, _, err := blobAccess.GetOldestRetainedBlobAge(ctx)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Failed to determine which samples are present: Server offline"), err)
+	})
+
+	t.Run("AllPresent", func(t *testing.T) {
+		baseBlobAccess.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest3).Add(digest4).Add(digest5).Build()).
+			Return(digest.EmptySet, nil)
+		clock.EXPECT().Now().Return(time.Unix(1200, 0))
+
+		age, ok, err := blobAccess.GetOldestRetainedBlobAge(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, 140*time.Second, age)
+	})
+
+	t.Run("OldestEvicted", func(t *testing.T) {
+		// Once the oldest sample is evicted, the age should be
+		// based on the next sample. The evicted sample should no
+		// longer be checked.
+		baseBlobAccess.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest3).Add(digest4).Add(digest5).Build()).
+			Return(digest3.ToSingletonSet(), nil)
+		clock.EXPECT().Now().Return(time.Unix(1300, 0))
+
+		age, ok, err := blobAccess.GetOldestRetainedBlobAge(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, 180*time.Second, age)
+
+		baseBlobAccess.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest4).Add(digest5).Build()).
+			Return(digest.EmptySet, nil)
+		clock.EXPECT().Now().Return(time.Unix(1320, 0))
+
+		age, ok, err = blobAccess.GetOldestRetainedBlobAge(ctx)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, 200*time.Second, age)
+	})
+
+	t.Run("AllEvicted", func(t *testing.T) {
+		baseBlobAccess.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(digest4).Add(digest5).Build()).
+			Return(digest.NewSetBuilder().Add(digest4).Add(digest5).Build(), nil)
+
+		_, ok, err := blobAccess.GetOldestRetainedBlobAge(ctx)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		_, ok, err = blobAccess.GetOldestRetainedBlobAge(ctx)
+		require.NoError(t, err)
+		require.False(t, ok)
+	})
+}
+
+func TestRetentionReportingBlobAccessRefreshingBackend(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create a small local storage backend, which refreshes objects
+	// that are stored in old blocks when FindMissing() is called.
+	blockList := local.NewVolatileBlockList(local.NewInMemoryBlockAllocator(1024))
+	locationBlobMap := local.NewOldCurrentNewLocationBlobMap(
+		blockList,
+		local.NewImmutableBlockListGrowthPolicy(
+			/* currentBlocksCount = */ 1,
+			/* newBlocksCount = */ 1),
+		util.DefaultErrorLogger,
+		"cas",
+		/* blockSizeBytes = */ 1024,
+		/* oldBlocksCount = */ 1,
+		/* newBlocksCount = */ 1,
+		/* initialBlocksCount = */ 0,
+		/* readRefreshCurrentBlocksCount = */ 0)
+	locationRecordArray := local.NewInMemoryLocationRecordArray(1021, locationBlobMap)
+	keyLocationMap := local.NewHashingKeyLocationMap(
+		locationRecordArray,
+		1021,
+		/* hashInitialization = */ 0x2f1d6a9c83e4b705,
+		/* maximumGetAttempts = */ 16,
+		/* maximumPutAttempts = */ 64,
+		"cas")
+	baseBlobAccess := local.NewFlatBlobAccess(keyLocationMap, locationBlobMap, digest.KeyWithoutInstance, &sync.RWMutex{}, "cas", nil, nil)
+
+	clock := mock.NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).AnyTimes()
+	blobAccess := blobstore.NewRetentionReportingBlobAccess(baseBlobAccess, clock, time.Hour, 10, "retention_reporting_blob_access_test")
+
+	// Only the first object written is sampled. Keep on writing
+	// objects, while checking whether the sample is still present.
+	// Because checking for presence may not refresh the sample, it
+	// should eventually get evicted.
+	for i := 0; i < 1000; i++ {
+		data := []byte(fmt.Sprintf("%0100d", i))
+		hash := sha256.Sum256(data)
+		blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, hex.EncodeToString(hash[:]), int64(len(data)))
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)))
+
+		_, ok, err := blobAccess.GetOldestRetainedBlobAge(ctx)
+		require.NoError(t, err)
+		if !ok {
+			require.Greater(t, i, 0)
+			return
+		}
+	}
+	t.Fatal("Sampled object was never evicted, meaning it got refreshed")
+}
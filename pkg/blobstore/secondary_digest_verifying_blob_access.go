@@ -0,0 +1,134 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type expectedSecondaryDigestKey struct {
+	primaryDigest digest.Digest
+}
+
+// NewContextWithExpectedSecondaryDigest attaches a digest to a Context
+// that SecondaryDigestVerifyingBlobAccess uses to validate the contents
+// of the object with digest primaryDigest when returned by Get(). The
+// expected digest may use a different digest function than the one
+// used to address the object. Reads of other objects performed using
+// the same Context are not affected.
+func NewContextWithExpectedSecondaryDigest(ctx context.Context, primaryDigest, expectedDigest digest.Digest) context.Context {
+	return context.WithValue(ctx, expectedSecondaryDigestKey{primaryDigest: primaryDigest}, expectedDigest)
+}
+
+type secondaryDigestVerifyingBlobAccess struct {
+	BlobAccess
+	metadataHeader string
+}
+
+// NewSecondaryDigestVerifyingBlobAccess creates a decorator for
+// BlobAccess that is capable of validating the contents of objects
+// against a second digest that is computed using another digest
+// function. This can be used to perform integrity audits while
+// migrating from one digest function to another. For example, objects
+// stored under their SHA-256 digest can be checked against SHA-512
+// digests that were recorded elsewhere.
+//
+// Validation is only performed for calls to Get() for which a digest
+// is provided through NewContextWithExpectedSecondaryDigest(). If
+// metadataHeader is non-empty, clients may also provide digests
+// through gRPC request metadata. Every value of the header has the
+// format "${primaryHash}/${primarySize}=[${digestFunction}/]${hash}/${size}",
+// meaning that only the object with the given primary digest is
+// validated. This ensures that requests that read multiple objects
+// (e.g., BatchReadBlobs(), or decorators that read objects referenced
+// by the requested one) don't apply the expected digest to unrelated
+// objects. Upon mismatch, reading the object fails with code
+// DATA_LOSS.
+func NewSecondaryDigestVerifyingBlobAccess(base BlobAccess, metadataHeader string) BlobAccess {
+	return &secondaryDigestVerifyingBlobAccess{
+		BlobAccess:     base,
+		metadataHeader: metadataHeader,
+	}
+}
+
+// getExpectedDigest returns the secondary digest against which the
+// contents of the object with digest primaryDigest should be
+// validated, if any.
+func (ba *secondaryDigestVerifyingBlobAccess) getExpectedDigest(ctx context.Context, primaryDigest digest.Digest) (digest.Digest, bool, error) {
+	if expectedDigest, ok := ctx.Value(expectedSecondaryDigestKey{primaryDigest: primaryDigest}).(digest.Digest); ok {
+		return expectedDigest, true, nil
+	}
+	if ba.metadataHeader == "" {
+		return digest.BadDigest, false, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(ba.metadataHeader) {
+		primary, secondary, ok := strings.Cut(value, "=")
+		if !ok {
+			return digest.BadDigest, false, status.Errorf(codes.InvalidArgument, "Secondary digest %#v does not contain a primary digest", value)
+		}
+		parsedPrimaryDigest, _, err := digest.NewDigestFromByteStreamReadPath("blobs/" + primary)
+		if err != nil {
+			return digest.BadDigest, false, util.StatusWrapf(err, "Invalid primary digest %#v", primary)
+		}
+		if parsedPrimaryDigest.GetHashString() != primaryDigest.GetHashString() || parsedPrimaryDigest.GetSizeBytes() != primaryDigest.GetSizeBytes() {
+			continue
+		}
+		expectedDigest, _, err := digest.NewDigestFromByteStreamReadPath("blobs/" + secondary)
+		if err != nil {
+			return digest.BadDigest, false, util.StatusWrapf(err, "Invalid secondary digest %#v", secondary)
+		}
+		return expectedDigest, true, nil
+	}
+	return digest.BadDigest, false, nil
+}
+
+func (ba *secondaryDigestVerifyingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	expectedDigest, ok, err := ba.getExpectedDigest(ctx, blobDigest)
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	b := ba.BlobAccess.Get(ctx, blobDigest)
+	if !ok {
+		return b
+	}
+	if _, err := b.GetSizeBytes(); err != nil {
+		return b
+	}
+	return buffer.NewCASBufferFromReader(
+		blobDigest,
+		&secondaryDigestVerifyingReader{
+			ReadCloser:     b.ToReader(),
+			expectedDigest: expectedDigest,
+			generator:      expectedDigest.GetDigestFunction().NewGenerator(expectedDigest.GetSizeBytes()),
+		},
+		buffer.BackendProvided(buffer.Irreparable(blobDigest)))
+}
+
+// secondaryDigestVerifyingReader is a decorator for io.ReadCloser that
+// computes a digest over all of the data that is read, and compares it
+// against an expected value upon reaching the end of the stream.
+type secondaryDigestVerifyingReader struct {
+	io.ReadCloser
+	expectedDigest digest.Digest
+	generator      *digest.Generator
+}
+
+func (r *secondaryDigestVerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.generator.Write(p[:n])
+	if err == io.EOF {
+		if observedDigest := r.generator.Sum(); observedDigest != r.expectedDigest {
+			return 0, status.Errorf(codes.DataLoss, "Blob has secondary digest %#v, while %#v was expected", observedDigest.String(), r.expectedDigest.String())
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,138 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSecondaryDigestVerifyingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewSecondaryDigestVerifyingBlobAccess(baseBlobAccess, "build.buildbarn.secondary-digest")
+
+	blobDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+	t.Run("NoExpectedDigest", func(t *testing.T) {
+		// Without an expected secondary digest, the buffer
+		// should be returned as is.
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("BackendFailure", func(t *testing.T) {
+		ctxWithDigest := blobstore.NewContextWithExpectedSecondaryDigest(
+			ctx,
+			blobDigest,
+			digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA512, "3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315", 5))
+		baseBlobAccess.EXPECT().Get(ctxWithDigest, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+
+		_, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("Match", func(t *testing.T) {
+		ctxWithDigest := blobstore.NewContextWithExpectedSecondaryDigest(
+			ctx,
+			blobDigest,
+			digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA512, "3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315", 5))
+		baseBlobAccess.EXPECT().Get(ctxWithDigest, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		// The expected digest corresponds to "Hellp", which
+		// should cause the read to fail with DATA_LOSS.
+		ctxWithDigest := blobstore.NewContextWithExpectedSecondaryDigest(
+			ctx,
+			blobDigest,
+			digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA512, "db78b5afdd9868cbedbb62b8a5aad72a78f5bf6f67e6b0d5a0ffb2bc18ba7382ae45238ea1d184ebadd4345a2a3304b76e9c94c09aac2414ae502c43b0aa5f35", 5))
+		baseBlobAccess.EXPECT().Get(ctxWithDigest, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		_, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.DataLoss, "Blob has secondary digest \"6-3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315-5-instance\", while \"6-db78b5afdd9868cbedbb62b8a5aad72a78f5bf6f67e6b0d5a0ffb2bc18ba7382ae45238ea1d184ebadd4345a2a3304b76e9c94c09aac2414ae502c43b0aa5f35-5-instance\" was expected"), err)
+	})
+
+	t.Run("MetadataMatch", func(t *testing.T) {
+		// Clients may also provide the expected digest through
+		// gRPC request metadata.
+		ctxWithDigest := metadata.NewIncomingContext(ctx, metadata.Pairs("build.buildbarn.secondary-digest", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5=3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315/5"))
+		baseBlobAccess.EXPECT().Get(ctxWithDigest, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("MetadataMismatch", func(t *testing.T) {
+		ctxWithDigest := metadata.NewIncomingContext(ctx, metadata.Pairs("build.buildbarn.secondary-digest", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5=db78b5afdd9868cbedbb62b8a5aad72a78f5bf6f67e6b0d5a0ffb2bc18ba7382ae45238ea1d184ebadd4345a2a3304b76e9c94c09aac2414ae502c43b0aa5f35/5"))
+		baseBlobAccess.EXPECT().Get(ctxWithDigest, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		_, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.DataLoss, "Blob has secondary digest \"6-3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315-5-\", while \"6-db78b5afdd9868cbedbb62b8a5aad72a78f5bf6f67e6b0d5a0ffb2bc18ba7382ae45238ea1d184ebadd4345a2a3304b76e9c94c09aac2414ae502c43b0aa5f35-5-\" was expected"), err)
+	})
+
+	t.Run("MetadataInvalid", func(t *testing.T) {
+		// Malformed digests should be rejected without
+		// contacting the backend.
+		ctxWithDigest := metadata.NewIncomingContext(ctx, metadata.Pairs("build.buildbarn.secondary-digest", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5=hello/5"))
+
+		_, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid secondary digest \"hello/5\": Unsupported digest function \"hello\""), err)
+	})
+
+	t.Run("MetadataWithoutPrimaryDigest", func(t *testing.T) {
+		ctxWithDigest := metadata.NewIncomingContext(ctx, metadata.Pairs("build.buildbarn.secondary-digest", "3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315/5"))
+
+		_, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Secondary digest \"3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315/5\" does not contain a primary digest"), err)
+	})
+
+	t.Run("MultipleBlobs", func(t *testing.T) {
+		// When multiple objects are read as part of the same
+		// request (e.g., through BatchReadBlobs()), expected
+		// digests should only be applied to the objects whose
+		// primary digests they are associated with.
+		otherDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "78ae647dc5544d227130a0682a51e30bc7777fbb6d8a8f17007463a3ecd1d524", 5)
+		for name, ctxWithDigest := range map[string]context.Context{
+			"Context": blobstore.NewContextWithExpectedSecondaryDigest(
+				ctx,
+				blobDigest,
+				digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA512, "3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315", 5)),
+			"Metadata": metadata.NewIncomingContext(ctx, metadata.Pairs("build.buildbarn.secondary-digest", "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5=3615f80c9d293ed7402687f94b22d58e529b8cc7916f8fac7fddf7fbd5af4cf777d3d795a7a00a16bf7e7f3fb9561ee9baae480da9fe7a18769e71886b03f315/5")),
+		} {
+			t.Run(name, func(t *testing.T) {
+				baseBlobAccess.EXPECT().Get(ctxWithDigest, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+				baseBlobAccess.EXPECT().Get(ctxWithDigest, otherDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("World")))
+
+				data, err := blobAccess.Get(ctxWithDigest, blobDigest).ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+
+				data, err = blobAccess.Get(ctxWithDigest, otherDigest).ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("World"), data)
+			})
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package blobstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/servertiming"
+)
+
+type serverTimingBlobAccess struct {
+	BlobAccess
+	clock clock.Clock
+}
+
+// NewServerTimingBlobAccess creates a decorator for BlobAccess that
+// measures the amount of time spent in the backend, and reports it to
+// the gRPC server timing interceptors. This allows the duration of
+// storage operations to be returned to clients as response trailers.
+//
+// Requests that are not processed by the server timing interceptors
+// are forwarded to the backend without measuring their duration.
+func NewServerTimingBlobAccess(base BlobAccess, clock clock.Clock) BlobAccess {
+	return &serverTimingBlobAccess{
+		BlobAccess: base,
+		clock:      clock,
+	}
+}
+
+func (ba *serverTimingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	serverTiming := servertiming.ServerTimingFromContext(ctx)
+	if serverTiming == nil {
+		return ba.BlobAccess.Get(ctx, blobDigest)
+	}
+	timeStart := ba.clock.Now()
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, blobDigest),
+		&serverTimingErrorHandler{
+			blobAccess:   ba,
+			serverTiming: serverTiming,
+			timeStart:    timeStart,
+		})
+}
+
+func (ba *serverTimingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	serverTiming := servertiming.ServerTimingFromContext(ctx)
+	if serverTiming == nil {
+		return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+	}
+	timeStart := ba.clock.Now()
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+		&serverTimingErrorHandler{
+			blobAccess:   ba,
+			serverTiming: serverTiming,
+			timeStart:    timeStart,
+		})
+}
+
+func (ba *serverTimingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	serverTiming := servertiming.ServerTimingFromContext(ctx)
+	if serverTiming == nil {
+		return ba.BlobAccess.Put(ctx, blobDigest, b)
+	}
+	timeStart := ba.clock.Now()
+	err := ba.BlobAccess.Put(ctx, blobDigest, b)
+	serverTiming.AddBackendDuration(ba.clock.Now().Sub(timeStart))
+	return err
+}
+
+func (ba *serverTimingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	serverTiming := servertiming.ServerTimingFromContext(ctx)
+	if serverTiming == nil {
+		return ba.BlobAccess.FindMissing(ctx, digests)
+	}
+	timeStart := ba.clock.Now()
+	missing, err := ba.BlobAccess.FindMissing(ctx, digests)
+	serverTiming.AddBackendDuration(ba.clock.Now().Sub(timeStart))
+	return missing, err
+}
+
+type serverTimingErrorHandler struct {
+	blobAccess   *serverTimingBlobAccess
+	serverTiming *servertiming.ServerTiming
+	timeStart    time.Time
+}
+
+func (eh *serverTimingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	return nil, err
+}
+
+func (eh *serverTimingErrorHandler) Done() {
+	eh.serverTiming.AddBackendDuration(eh.blobAccess.clock.Now().Sub(eh.timeStart))
+}
@@ -0,0 +1,73 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/servertiming"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestServerTimingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewServerTimingBlobAccess(baseBlobAccess, clock)
+
+	blobDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+	t.Run("Disabled", func(t *testing.T) {
+		// If the server timing interceptors are not enabled, the
+		// clock should not be consulted.
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetSuccess", func(t *testing.T) {
+		// The time between the call to Get() and the buffer
+		// being consumed should be reported as backend time.
+		ctxWithServerTiming, serverTiming := servertiming.NewContextWithServerTiming(ctx)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		baseBlobAccess.EXPECT().Get(ctxWithServerTiming, blobDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		clock.EXPECT().Now().Return(time.Unix(1000, 250000000))
+
+		data, err := blobAccess.Get(ctxWithServerTiming, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.Equal(t, 250*time.Millisecond, serverTiming.GetBackendDuration())
+	})
+
+	t.Run("Accumulation", func(t *testing.T) {
+		// Durations of multiple operations performed as part of
+		// the same RPC should be summed.
+		ctxWithServerTiming, serverTiming := servertiming.NewContextWithServerTiming(ctx)
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		baseBlobAccess.EXPECT().FindMissing(ctxWithServerTiming, blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		clock.EXPECT().Now().Return(time.Unix(1001, 0))
+		clock.EXPECT().Now().Return(time.Unix(1002, 0))
+		baseBlobAccess.EXPECT().Put(ctxWithServerTiming, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		clock.EXPECT().Now().Return(time.Unix(1004, 0))
+
+		missing, err := blobAccess.FindMissing(ctxWithServerTiming, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+		require.NoError(t, blobAccess.Put(ctxWithServerTiming, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.Equal(t, 3*time.Second, serverTiming.GetBackendDuration())
+	})
+}
@@ -14,21 +14,56 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// FindMissingFailurePolicy determines how ShardingBlobAccess behaves
+// if a call to FindMissing() against one of its shards fails.
+type FindMissingFailurePolicy int
+
+const (
+	// FindMissingFailurePolicyFail causes the entire call to
+	// FindMissing() to fail.
+	FindMissingFailurePolicyFail FindMissingFailurePolicy = iota
+	// FindMissingFailurePolicyReportPresent causes the digests
+	// belonging to the failing shard to be reported as present.
+	// This prevents clients from uploading these objects again, at
+	// the risk of them being absent.
+	FindMissingFailurePolicyReportPresent
+	// FindMissingFailurePolicyReportMissing causes the digests
+	// belonging to the failing shard to be reported as missing,
+	// causing clients to upload these objects again.
+	FindMissingFailurePolicyReportMissing
+)
+
 type shardingBlobAccess struct {
-	backends             []blobstore.BlobAccess
-	shardPermuter        ShardPermuter
-	hashInitialization   uint64
-	getCapabilitiesRound atomic.Uint64
+	backends                 []blobstore.BlobAccess
+	shardPermuter            ShardPermuter
+	hashInitialization       uint64
+	maximumParallelism       int
+	findMissingFailurePolicy FindMissingFailurePolicy
+	errorLogger              util.ErrorLogger
+	getCapabilitiesRound     atomic.Uint64
 }
 
 // NewShardingBlobAccess is an adapter for BlobAccess that partitions
 // requests across backends by hashing the digest. A ShardPermuter is
 // used to map hashes to backends.
-func NewShardingBlobAccess(backends []blobstore.BlobAccess, shardPermuter ShardPermuter, hashInitialization uint64) blobstore.BlobAccess {
+//
+// Operations that need to be fanned out to multiple backends, such as
+// FindMissing(), call into at most maximumParallelism backends
+// concurrently. A value of zero or less lets all backends be called
+// concurrently.
+//
+// The provided FindMissingFailurePolicy determines whether a failure of
+// FindMissing() against a single backend causes the entire operation
+// to fail. If not, the error is reported through the error logger
+// instead.
+func NewShardingBlobAccess(backends []blobstore.BlobAccess, shardPermuter ShardPermuter, hashInitialization uint64, maximumParallelism int, findMissingFailurePolicy FindMissingFailurePolicy, errorLogger util.ErrorLogger) blobstore.BlobAccess {
 	return &shardingBlobAccess{
-		backends:           backends,
-		shardPermuter:      shardPermuter,
-		hashInitialization: hashInitialization,
+		backends:                 backends,
+		shardPermuter:            shardPermuter,
+		hashInitialization:       hashInitialization,
+		maximumParallelism:       maximumParallelism,
+		findMissingFailurePolicy: findMissingFailurePolicy,
+		errorLogger:              errorLogger,
 	}
 }
 
@@ -90,15 +125,27 @@ func (ba *shardingBlobAccess) FindMissing(ctx context.Context, digests digest.Se
 	// Asynchronously call FindMissing() on backends.
 	missingPerBackend := make([]digest.Set, 0, len(ba.backends))
 	group, ctxWithCancel := errgroup.WithContext(ctx)
+	if ba.maximumParallelism > 0 {
+		group.SetLimit(ba.maximumParallelism)
+	}
 	for indexIter, digestsIter := range digestsPerBackend {
 		index, digests := indexIter, digestsIter
 		if digests.Length() > 0 {
 			missingPerBackend = append(missingPerBackend, digest.EmptySet)
 			missingOut := &missingPerBackend[len(missingPerBackend)-1]
 			group.Go(func() error {
-				missing, err := ba.backends[index].FindMissing(ctxWithCancel, digests.Build())
+				digestsSet := digests.Build()
+				missing, err := ba.backends[index].FindMissing(ctxWithCancel, digestsSet)
 				if err != nil {
-					return util.StatusWrapf(err, "Shard %d", index)
+					err = util.StatusWrapf(err, "Shard %d", index)
+					if ba.findMissingFailurePolicy == FindMissingFailurePolicyFail || ctx.Err() != nil {
+						return err
+					}
+					ba.errorLogger.Log(err)
+					if ba.findMissingFailurePolicy == FindMissingFailurePolicyReportMissing {
+						*missingOut = digestsSet
+					}
+					return nil
 				}
 				*missingOut = missing
 				return nil
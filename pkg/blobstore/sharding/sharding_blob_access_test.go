@@ -2,15 +2,20 @@ package sharding_test
 
 import (
 	"context"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/internal/mock"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/sharding"
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/stretchr/testify/require"
 
 	"google.golang.org/grpc/codes"
@@ -25,6 +30,7 @@ func TestShardingBlobAccess(t *testing.T) {
 	shard0 := mock.NewMockBlobAccess(ctrl)
 	shard1 := mock.NewMockBlobAccess(ctrl)
 	shardPermuter := mock.NewMockShardPermuter(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
 	blobAccess := sharding.NewShardingBlobAccess(
 		[]blobstore.BlobAccess{
 			shard0,
@@ -32,7 +38,10 @@ func TestShardingBlobAccess(t *testing.T) {
 			nil, // Shard that is explicitly drained.
 		},
 		shardPermuter,
-		/* hashInitialization = */ 0x62994904405896a1)
+		/* hashInitialization = */ 0x62994904405896a1,
+		/* maximumParallelism = */ 0,
+		sharding.FindMissingFailurePolicyFail,
+		errorLogger)
 
 	helloDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
 	llDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "5b54c0a045f179bcbbbc9abcb8b5cd4c", 2)
@@ -192,3 +201,197 @@ func TestShardingBlobAccess(t *testing.T) {
 		require.Equal(t, digest.NewSetBuilder().Add(digest1).Add(digest3).Build(), missing)
 	})
 }
+
+func TestShardingBlobAccessMaximumParallelism(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Create a large number of shards that report all objects
+	// with an odd size as being absent. Keep track of how many
+	// calls are in flight simultaneously.
+	const shardsCount = 16
+	var inFlight, maximumInFlight atomic.Int32
+	backends := make([]blobstore.BlobAccess, 0, shardsCount)
+	weights := make([]uint32, 0, shardsCount)
+	for i := 0; i < shardsCount; i++ {
+		backend := mock.NewMockBlobAccess(ctrl)
+		backend.EXPECT().FindMissing(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+				current := inFlight.Add(1)
+				for {
+					maximum := maximumInFlight.Load()
+					if current <= maximum || maximumInFlight.CompareAndSwap(maximum, current) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				inFlight.Add(-1)
+
+				missing := digest.NewSetBuilder()
+				for _, blobDigest := range digests.Items() {
+					if blobDigest.GetSizeBytes()%2 == 1 {
+						missing.Add(blobDigest)
+					}
+				}
+				return missing.Build(), nil
+			}).AnyTimes()
+		backends = append(backends, backend)
+		weights = append(weights, 1)
+	}
+
+	allDigests := digest.NewSetBuilder()
+	expectedMissing := digest.NewSetBuilder()
+	for i := 0; i < 1000; i++ {
+		blobDigest := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, fmt.Sprintf("%032x", i), int64(i))
+		allDigests.Add(blobDigest)
+		if i%2 == 1 {
+			expectedMissing.Add(blobDigest)
+		}
+	}
+
+	for _, maximumParallelism := range []int{0, 1, 3, shardsCount} {
+		t.Run(fmt.Sprintf("MaximumParallelism%d", maximumParallelism), func(t *testing.T) {
+			blobAccess := sharding.NewShardingBlobAccess(
+				backends,
+				sharding.NewWeightedShardPermuter(weights),
+				/* hashInitialization = */ 0x62994904405896a1,
+				maximumParallelism,
+				sharding.FindMissingFailurePolicyFail,
+				util.DefaultErrorLogger)
+			maximumInFlight.Store(0)
+
+			// Results should be identical, regardless of the
+			// number of shards that are called concurrently.
+			missing, err := blobAccess.FindMissing(ctx, allDigests.Build())
+			require.NoError(t, err)
+			require.Equal(t, expectedMissing.Build(), missing)
+
+			if maximumParallelism > 0 {
+				require.LessOrEqual(t, maximumInFlight.Load(), int32(maximumParallelism))
+			}
+		})
+	}
+}
+
+func TestShardingBlobAccessFindMissingFailurePolicy(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	shard0 := mock.NewMockBlobAccess(ctrl)
+	shard1 := mock.NewMockBlobAccess(ctrl)
+	shard2 := mock.NewMockBlobAccess(ctrl)
+	shardPermuter := mock.NewMockShardPermuter(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+
+	digest1 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "21f843aefbfb88627ec2cad9e8f1f49a", 1)
+	digest2 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "48f2503cf369373b0631da97fb9de1c1", 2)
+	digest3 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "942a5b4164c26ae5d57a4f9526dcfca4", 3)
+
+	// Route every digest to its own shard, where the second shard
+	// fails. The other shards both report their digest as missing.
+	expectFindMissing := func() {
+		shardPermuter.EXPECT().GetShard(gomock.Any(), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(0))
+			})
+		shardPermuter.EXPECT().GetShard(gomock.Any(), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(1))
+			})
+		shardPermuter.EXPECT().GetShard(gomock.Any(), gomock.Any()).Do(
+			func(hash uint64, selector sharding.ShardSelector) {
+				require.False(t, selector(2))
+			})
+		shard0.EXPECT().FindMissing(gomock.Any(), digest1.ToSingletonSet()).
+			Return(digest1.ToSingletonSet(), nil)
+		shard1.EXPECT().FindMissing(gomock.Any(), digest2.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+		shard2.EXPECT().FindMissing(gomock.Any(), digest3.ToSingletonSet()).
+			Return(digest3.ToSingletonSet(), nil)
+	}
+	newBlobAccess := func(findMissingFailurePolicy sharding.FindMissingFailurePolicy) blobstore.BlobAccess {
+		return sharding.NewShardingBlobAccess(
+			[]blobstore.BlobAccess{shard0, shard1, shard2},
+			shardPermuter,
+			/* hashInitialization = */ 0x62994904405896a1,
+			/* maximumParallelism = */ 1,
+			findMissingFailurePolicy,
+			errorLogger)
+	}
+	allDigests := digest.NewSetBuilder().Add(digest1).Add(digest2).Add(digest3).Build()
+
+	t.Run("Fail", func(t *testing.T) {
+		expectFindMissing()
+
+		_, err := newBlobAccess(sharding.FindMissingFailurePolicyFail).FindMissing(ctx, allDigests)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Shard 1: Server offline"), err)
+	})
+
+	t.Run("ReportPresent", func(t *testing.T) {
+		// The digest belonging to the failing shard should not
+		// be reported as missing. The error should be logged.
+		expectFindMissing()
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Shard 1: Server offline")))
+
+		missing, err := newBlobAccess(sharding.FindMissingFailurePolicyReportPresent).FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, digest.NewSetBuilder().Add(digest1).Add(digest3).Build(), missing)
+	})
+
+	t.Run("ReportMissing", func(t *testing.T) {
+		// The digest belonging to the failing shard should be
+		// reported as missing, so that clients upload it again.
+		expectFindMissing()
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Shard 1: Server offline")))
+
+		missing, err := newBlobAccess(sharding.FindMissingFailurePolicyReportMissing).FindMissing(ctx, allDigests)
+		require.NoError(t, err)
+		require.Equal(t, allDigests, missing)
+	})
+}
+
+func TestShardingBlobAccessBackendTimeout(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	shard0 := mock.NewMockBlobAccess(ctrl)
+	shard1 := mock.NewMockBlobAccess(ctrl)
+	shardPermuter := mock.NewMockShardPermuter(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+
+	// The second shard has a per-backend timeout, but hangs.
+	blobAccess := sharding.NewShardingBlobAccess(
+		[]blobstore.BlobAccess{
+			shard0,
+			blobstore.NewTimeoutBlobAccess(shard1, clock.SystemClock, 10*time.Millisecond),
+		},
+		shardPermuter,
+		/* hashInitialization = */ 0x62994904405896a1,
+		/* maximumParallelism = */ 0,
+		sharding.FindMissingFailurePolicyReportMissing,
+		errorLogger)
+
+	digest1 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "21f843aefbfb88627ec2cad9e8f1f49a", 1)
+	digest2 := digest.MustNewDigest("", remoteexecution.DigestFunction_MD5, "48f2503cf369373b0631da97fb9de1c1", 2)
+
+	shardPermuter.EXPECT().GetShard(gomock.Any(), gomock.Any()).Do(
+		func(hash uint64, selector sharding.ShardSelector) {
+			require.False(t, selector(0))
+		})
+	shardPermuter.EXPECT().GetShard(gomock.Any(), gomock.Any()).Do(
+		func(hash uint64, selector sharding.ShardSelector) {
+			require.False(t, selector(1))
+		})
+	shard0.EXPECT().FindMissing(gomock.Any(), digest1.ToSingletonSet()).
+		Return(digest.EmptySet, nil)
+	shard1.EXPECT().FindMissing(gomock.Any(), digest2.ToSingletonSet()).DoAndReturn(
+		func(ctx context.Context, digests digest.Set) (digest.Set, error) {
+			<-ctx.Done()
+			return digest.EmptySet, util.StatusFromContext(ctx)
+		})
+	errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.DeadlineExceeded, "Shard 1: Backend did not complete the operation within 10ms")))
+
+	// Instead of waiting for the second shard indefinitely, the
+	// timed out shard should be handled according to the failure
+	// policy.
+	missing, err := blobAccess.FindMissing(ctx, digest.NewSetBuilder().Add(digest1).Add(digest2).Build())
+	require.NoError(t, err)
+	require.Equal(t, digest2.ToSingletonSet(), missing)
+}
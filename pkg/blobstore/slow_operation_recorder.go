@@ -0,0 +1,151 @@
+package blobstore
+
+import (
+	"container/heap"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+)
+
+// SlowOperation contains information on a single operation that was
+// recorded by SlowOperationRecorder.
+type SlowOperation struct {
+	Timestamp       time.Time `json:"timestamp"`
+	Backend         string    `json:"backend"`
+	Operation       string    `json:"operation"`
+	Digest          string    `json:"digest,omitempty"`
+	SizeBytes       int64     `json:"size_bytes"`
+	DurationSeconds float64   `json:"duration_seconds"`
+}
+
+// slowOperationHeap is a min-heap of operations, ordered by duration.
+// It allows SlowOperationRecorder to determine which of the retained
+// operations should be evicted in constant time.
+type slowOperationHeap []SlowOperation
+
+func (h slowOperationHeap) Len() int {
+	return len(h)
+}
+
+func (h slowOperationHeap) Less(i, j int) bool {
+	return h[i].DurationSeconds < h[j].DurationSeconds
+}
+
+func (h slowOperationHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *slowOperationHeap) Push(x any) {
+	*h = append(*h, x.(SlowOperation))
+}
+
+func (h *slowOperationHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// SlowOperationRecorder retains a bounded set of the slowest operations
+// that were performed against one or more storage backends recently.
+// This can be used to analyze performance issues after the fact,
+// without incurring the overhead of full tracing.
+//
+// Operations are discarded once they are older than a maximum age.
+// This ensures that a small number of pathologically slow operations
+// does not prevent more recent operations from being retained
+// indefinitely.
+//
+// SlowOperationRecorder implements http.Handler, returning the recorded
+// operations as JSON, ordered by decreasing duration.
+type SlowOperationRecorder struct {
+	clock      clock.Clock
+	threshold  time.Duration
+	capacity   int
+	maximumAge time.Duration
+
+	lock       sync.Mutex
+	operations slowOperationHeap
+}
+
+var _ http.Handler = &SlowOperationRecorder{}
+
+// NewSlowOperationRecorder creates a SlowOperationRecorder that retains
+// up to capacity operations that started no longer than maximumAge
+// ago. Operations that complete faster than the provided threshold are
+// never retained.
+func NewSlowOperationRecorder(clock clock.Clock, threshold time.Duration, capacity int, maximumAge time.Duration) *SlowOperationRecorder {
+	return &SlowOperationRecorder{
+		clock:      clock,
+		threshold:  threshold,
+		capacity:   capacity,
+		maximumAge: maximumAge,
+		operations: make(slowOperationHeap, 0, capacity),
+	}
+}
+
+// removeExpiredLocked discards all operations that are older than the
+// maximum age.
+func (r *SlowOperationRecorder) removeExpiredLocked() {
+	minimumTimestamp := r.clock.Now().Add(-r.maximumAge)
+	operations := r.operations[:0]
+	for _, operation := range r.operations {
+		if !operation.Timestamp.Before(minimumTimestamp) {
+			operations = append(operations, operation)
+		}
+	}
+	if len(operations) != len(r.operations) {
+		clear(r.operations[len(operations):])
+		r.operations = operations
+		heap.Init(&r.operations)
+	}
+}
+
+// Record an operation. If capacity has been reached, the fastest
+// operation that is currently retained is evicted, but only if it is
+// faster than the provided operation.
+func (r *SlowOperationRecorder) Record(operation SlowOperation, duration time.Duration) {
+	if duration < r.threshold || r.capacity <= 0 {
+		return
+	}
+	operation.DurationSeconds = duration.Seconds()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.removeExpiredLocked()
+	if len(r.operations) < r.capacity {
+		heap.Push(&r.operations, operation)
+	} else if operation.DurationSeconds > r.operations[0].DurationSeconds {
+		r.operations[0] = operation
+		heap.Fix(&r.operations, 0)
+	}
+}
+
+// GetOperations returns all operations that are currently retained,
+// ordered by decreasing duration.
+func (r *SlowOperationRecorder) GetOperations() []SlowOperation {
+	r.lock.Lock()
+	r.removeExpiredLocked()
+	operations := append([]SlowOperation(nil), r.operations...)
+	r.lock.Unlock()
+
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[i].DurationSeconds > operations[j].DurationSeconds
+	})
+	return operations
+}
+
+func (r *SlowOperationRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	operations := r.GetOperations()
+	if operations == nil {
+		operations = []SlowOperation{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(operations)
+}
@@ -0,0 +1,106 @@
+package blobstore_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSlowOperationRecorder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	clock := mock.NewMockClock(ctrl)
+	now := time.Unix(1000, 0)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+	recorder := blobstore.NewSlowOperationRecorder(clock, time.Second, 3, time.Hour)
+	record := func(name string, duration time.Duration) {
+		recorder.Record(blobstore.SlowOperation{
+			Timestamp: now,
+			Backend:   "backend",
+			Operation: name,
+		}, duration)
+	}
+	getOperationNames := func() []string {
+		var names []string
+		for _, operation := range recorder.GetOperations() {
+			names = append(names, operation.Operation)
+		}
+		return names
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		require.Empty(t, recorder.GetOperations())
+
+		w := httptest.NewRecorder()
+		recorder.ServeHTTP(w, httptest.NewRequest("GET", "/slow_operations/backend", nil))
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		require.JSONEq(t, "[]", w.Body.String())
+	})
+
+	t.Run("BelowThreshold", func(t *testing.T) {
+		// Operations that are faster than the threshold should
+		// not be recorded.
+		record("A", 500*time.Millisecond)
+		require.Empty(t, recorder.GetOperations())
+	})
+
+	t.Run("BelowCapacity", func(t *testing.T) {
+		// Operations should be returned slowest first.
+		record("B", 2*time.Second)
+		record("C", 5*time.Second)
+		record("D", 3*time.Second)
+		require.Equal(t, []string{"C", "D", "B"}, getOperationNames())
+	})
+
+	t.Run("AboveCapacity", func(t *testing.T) {
+		// Once capacity is reached, faster operations should be
+		// evicted in favor of slower ones.
+		record("E", 4*time.Second)
+		require.Equal(t, []string{"C", "E", "D"}, getOperationNames())
+
+		// Operations that are faster than all of the retained
+		// ones should be discarded.
+		record("F", 1500*time.Millisecond)
+		require.Equal(t, []string{"C", "E", "D"}, getOperationNames())
+
+		record("G", 10*time.Second)
+		require.Equal(t, []string{"G", "C", "E"}, getOperationNames())
+	})
+
+	t.Run("ServeHTTP", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		recorder.ServeHTTP(w, httptest.NewRequest("GET", "/slow_operations/backend", nil))
+		require.JSONEq(t, `[
+			{"timestamp": "1970-01-01T00:16:40Z", "backend": "backend", "operation": "G", "size_bytes": 0, "duration_seconds": 10},
+			{"timestamp": "1970-01-01T00:16:40Z", "backend": "backend", "operation": "C", "size_bytes": 0, "duration_seconds": 5},
+			{"timestamp": "1970-01-01T00:16:40Z", "backend": "backend", "operation": "E", "size_bytes": 0, "duration_seconds": 4}
+		]`, w.Body.String())
+	})
+
+	t.Run("Expiration", func(t *testing.T) {
+		// As long as slow operations are retained, faster
+		// operations that are performed afterwards should be
+		// discarded.
+		now = time.Unix(2800, 0)
+		record("H", 2*time.Second)
+		require.Equal(t, []string{"G", "C", "E"}, getOperationNames())
+
+		// Once the maximum age is exceeded, operations should
+		// be discarded, permitting faster operations that are
+		// more recent to be retained.
+		now = time.Unix(4601, 0)
+		require.Empty(t, recorder.GetOperations())
+
+		record("I", 2*time.Second)
+		require.Equal(t, []string{"I"}, getOperationNames())
+
+		now = time.Unix(8202, 0)
+		require.Empty(t, recorder.GetOperations())
+	})
+}
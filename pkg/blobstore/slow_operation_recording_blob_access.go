@@ -0,0 +1,106 @@
+package blobstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type slowOperationRecordingBlobAccess struct {
+	BlobAccess
+	clock       clock.Clock
+	recorder    *SlowOperationRecorder
+	backendName string
+}
+
+// NewSlowOperationRecordingBlobAccess creates a decorator for
+// BlobAccess that measures the duration of operations, and reports them
+// to a SlowOperationRecorder. The backend name is attached to every
+// recorded operation, so that multiple decorators may share a single
+// recorder.
+//
+// For Get() and GetFromComposite(), the duration includes the time it
+// takes to consume the buffer that is returned.
+func NewSlowOperationRecordingBlobAccess(base BlobAccess, clock clock.Clock, recorder *SlowOperationRecorder, backendName string) BlobAccess {
+	return &slowOperationRecordingBlobAccess{
+		BlobAccess:  base,
+		clock:       clock,
+		recorder:    recorder,
+		backendName: backendName,
+	}
+}
+
+func (ba *slowOperationRecordingBlobAccess) record(operation string, blobDigest digest.Digest, sizeBytes int64, timeStart time.Time) {
+	operationDigest := ""
+	if blobDigest != digest.BadDigest {
+		operationDigest = blobDigest.String()
+	}
+	ba.recorder.Record(SlowOperation{
+		Timestamp: timeStart,
+		Backend:   ba.backendName,
+		Operation: operation,
+		Digest:    operationDigest,
+		SizeBytes: sizeBytes,
+	}, ba.clock.Now().Sub(timeStart))
+}
+
+func (ba *slowOperationRecordingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	timeStart := ba.clock.Now()
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctx, blobDigest),
+		&slowOperationRecordingErrorHandler{
+			blobAccess: ba,
+			operation:  "Get",
+			blobDigest: blobDigest,
+			timeStart:  timeStart,
+		})
+}
+
+func (ba *slowOperationRecordingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	timeStart := ba.clock.Now()
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer),
+		&slowOperationRecordingErrorHandler{
+			blobAccess: ba,
+			operation:  "GetFromComposite",
+			blobDigest: childDigest,
+			timeStart:  timeStart,
+		})
+}
+
+func (ba *slowOperationRecordingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	timeStart := ba.clock.Now()
+	err := ba.BlobAccess.Put(ctx, blobDigest, b)
+	ba.record("Put", blobDigest, blobDigest.GetSizeBytes(), timeStart)
+	return err
+}
+
+func (ba *slowOperationRecordingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	timeStart := ba.clock.Now()
+	missing, err := ba.BlobAccess.FindMissing(ctx, digests)
+	var sizeBytes int64
+	for _, blobDigest := range digests.Items() {
+		sizeBytes += blobDigest.GetSizeBytes()
+	}
+	ba.record("FindMissing", digest.BadDigest, sizeBytes, timeStart)
+	return missing, err
+}
+
+type slowOperationRecordingErrorHandler struct {
+	blobAccess *slowOperationRecordingBlobAccess
+	operation  string
+	blobDigest digest.Digest
+	timeStart  time.Time
+}
+
+func (eh *slowOperationRecordingErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	return nil, err
+}
+
+func (eh *slowOperationRecordingErrorHandler) Done() {
+	eh.blobAccess.record(eh.operation, eh.blobDigest, eh.blobDigest.GetSizeBytes(), eh.timeStart)
+}
@@ -0,0 +1,130 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSlowOperationRecordingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	recorderClock := mock.NewMockClock(ctrl)
+	recorderClock.EXPECT().Now().Return(time.Unix(1100, 0)).AnyTimes()
+	recorder := blobstore.NewSlowOperationRecorder(recorderClock, time.Second, 10, time.Hour)
+	blobAccess := blobstore.NewSlowOperationRecordingBlobAccess(baseBlobAccess, clock, recorder, "storage")
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("Get", func(t *testing.T) {
+		// The duration of Get() should be recorded once the
+		// buffer has been consumed.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		clock.EXPECT().Now().Return(time.Unix(1003, 0))
+
+		data, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("GetFailure", func(t *testing.T) {
+		// Operations that fail should be recorded as well, as
+		// slow failures are of interest too.
+		clock.EXPECT().Now().Return(time.Unix(1005, 0))
+		baseBlobAccess.EXPECT().Get(ctx, blobDigest).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline")))
+		clock.EXPECT().Now().Return(time.Unix(1009, 0))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+	})
+
+	t.Run("GetFromComposite", func(t *testing.T) {
+		// The digest of the child object should be recorded.
+		parentDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "f5a7924e621e84c9280a9a27e1bcb7f6", 10)
+		slicer := mock.NewMockBlobSlicer(ctrl)
+		clock.EXPECT().Now().Return(time.Unix(1012, 0))
+		baseBlobAccess.EXPECT().GetFromComposite(ctx, parentDigest, blobDigest, slicing.BlobSlicer(slicer)).
+			Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+		clock.EXPECT().Now().Return(time.Unix(1013, 500000000))
+
+		data, err := blobAccess.GetFromComposite(ctx, parentDigest, blobDigest, slicer).ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		// Fast operations should not be recorded.
+		clock.EXPECT().Now().Return(time.Unix(1015, 0))
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		clock.EXPECT().Now().Return(time.Unix(1015, 100000000))
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+	})
+
+	t.Run("FindMissing", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1020, 0))
+		baseBlobAccess.EXPECT().FindMissing(ctx, blobDigest.ToSingletonSet()).Return(digest.EmptySet, nil)
+		clock.EXPECT().Now().Return(time.Unix(1022, 0))
+
+		missing, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptySet, missing)
+	})
+
+	require.Equal(t, []blobstore.SlowOperation{
+		{
+			Timestamp:       time.Unix(1005, 0),
+			Backend:         "storage",
+			Operation:       "Get",
+			Digest:          blobDigest.String(),
+			SizeBytes:       5,
+			DurationSeconds: 4,
+		},
+		{
+			Timestamp:       time.Unix(1000, 0),
+			Backend:         "storage",
+			Operation:       "Get",
+			Digest:          blobDigest.String(),
+			SizeBytes:       5,
+			DurationSeconds: 3,
+		},
+		{
+			Timestamp:       time.Unix(1020, 0),
+			Backend:         "storage",
+			Operation:       "FindMissing",
+			SizeBytes:       5,
+			DurationSeconds: 2,
+		},
+		{
+			Timestamp:       time.Unix(1012, 0),
+			Backend:         "storage",
+			Operation:       "GetFromComposite",
+			Digest:          blobDigest.String(),
+			SizeBytes:       5,
+			DurationSeconds: 1.5,
+		},
+	}, recorder.GetOperations())
+}
@@ -0,0 +1,270 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	staleWhileRevalidateBlobAccessPrometheusMetrics sync.Once
+
+	staleWhileRevalidateBlobAccessGetOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "stale_while_revalidate_blob_access_get_operations_total",
+			Help:      "Number of Get() operations performed by StaleWhileRevalidateBlobAccess, and the freshness of the local copy at the time of the call",
+		},
+		[]string{"freshness"})
+	staleWhileRevalidateBlobAccessGetOperationsFresh   = staleWhileRevalidateBlobAccessGetOperations.WithLabelValues("Fresh")
+	staleWhileRevalidateBlobAccessGetOperationsStale   = staleWhileRevalidateBlobAccessGetOperations.WithLabelValues("Stale")
+	staleWhileRevalidateBlobAccessGetOperationsExpired = staleWhileRevalidateBlobAccessGetOperations.WithLabelValues("Expired")
+)
+
+type staleWhileRevalidateCacheEntry struct {
+	validationTime time.Time
+	revalidating   bool
+	generation     uint64
+}
+
+type staleWhileRevalidateBlobAccess struct {
+	BlobAccess
+	localCache              BlobAccess
+	clock                   clock.Clock
+	maximumCacheSize        int
+	freshnessDuration       time.Duration
+	staleGraceDuration      time.Duration
+	maximumMessageSizeBytes int
+	errorLogger             util.ErrorLogger
+
+	lock           sync.Mutex
+	cachedObjects  map[string]*staleWhileRevalidateCacheEntry
+	evictionSet    eviction.Set[string]
+	nextGeneration uint64
+}
+
+// NewStaleWhileRevalidateBlobAccess creates a decorator for the Action
+// Cache (AC) that serves reads from a fast local cache, while using
+// another backend as the authoritative source of truth. It provides
+// stale-while-revalidate semantics:
+//
+//   - Objects that have been validated against the backend less than
+//     freshnessDuration ago are served from the local cache.
+//   - Objects that have been validated less than freshnessDuration +
+//     staleGraceDuration ago are served from the local cache as well,
+//     but cause the object to be revalidated in the background.
+//   - All other objects are read from the backend synchronously,
+//     causing the local cache to be updated.
+//
+// The time at which objects were last validated is tracked in memory,
+// for at most maximumCacheSize objects. Objects for which no
+// validation time is known are always read from the backend.
+//
+// Failures to update the local cache and failures to revalidate
+// objects in the background are not returned to the caller. They are
+// reported through the provided ErrorLogger instead.
+func NewStaleWhileRevalidateBlobAccess(backend, localCache BlobAccess, clock clock.Clock, evictionSet eviction.Set[string], maximumCacheSize int, freshnessDuration, staleGraceDuration time.Duration, maximumMessageSizeBytes int, errorLogger util.ErrorLogger) BlobAccess {
+	staleWhileRevalidateBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(staleWhileRevalidateBlobAccessGetOperations)
+	})
+
+	return &staleWhileRevalidateBlobAccess{
+		BlobAccess:              backend,
+		localCache:              localCache,
+		clock:                   clock,
+		maximumCacheSize:        maximumCacheSize,
+		freshnessDuration:       freshnessDuration,
+		staleGraceDuration:      staleGraceDuration,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+		errorLogger:             errorLogger,
+
+		cachedObjects: map[string]*staleWhileRevalidateCacheEntry{},
+		evictionSet:   evictionSet,
+	}
+}
+
+// getOrCreateEntry returns the entry that tracks the validation time
+// of an object, creating it if needed. Newly created entries have no
+// validation time, meaning that the local copy of the object is not
+// served until a refresh completes.
+func (ba *staleWhileRevalidateBlobAccess) getOrCreateEntry(key string) *staleWhileRevalidateCacheEntry {
+	if entry, ok := ba.cachedObjects[key]; ok {
+		ba.evictionSet.Touch(key)
+		return entry
+	}
+	for len(ba.cachedObjects) >= ba.maximumCacheSize && len(ba.cachedObjects) > 0 {
+		delete(ba.cachedObjects, ba.evictionSet.Peek())
+		ba.evictionSet.Remove()
+	}
+	ba.evictionSet.Insert(key)
+	entry := &staleWhileRevalidateCacheEntry{
+		generation: ba.nextGeneration,
+	}
+	ba.nextGeneration++
+	ba.cachedObjects[key] = entry
+	return entry
+}
+
+// invalidate causes the next read of an object to be forwarded to the
+// backend. Any refresh of the object that is in progress is prevented
+// from marking its results as valid.
+func (ba *staleWhileRevalidateBlobAccess) invalidate(key string) {
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	if entry, ok := ba.cachedObjects[key]; ok {
+		entry.validationTime = time.Time{}
+		entry.generation = ba.nextGeneration
+		ba.nextGeneration++
+	}
+}
+
+// refresh reads an object from the backend and stores a copy of it in
+// the local cache.
+//
+// As reading from the backend may race with calls to Put() and with
+// other refreshes, the generation of the object's entry is captured
+// before reading from the backend. The validation time is only updated
+// if the generation is unchanged by the time the local cache has been
+// updated. This prevents data that was read from the backend before a
+// Put() from being marked as valid.
+func (ba *staleWhileRevalidateBlobAccess) refresh(ctx context.Context, blobDigest digest.Digest, key string, validationTime time.Time) ([]byte, error) {
+	ba.lock.Lock()
+	generation := ba.getOrCreateEntry(key).generation
+	ba.lock.Unlock()
+
+	data, err := ba.BlobAccess.Get(ctx, blobDigest).ToByteSlice(ba.maximumMessageSizeBytes)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			// The object has been removed from the backend.
+			// Prevent the local copy from being served.
+			ba.invalidate(key)
+		}
+		return nil, err
+	}
+	// Failing to update the local cache is not fatal, as the
+	// object can still be returned to the caller. It merely means
+	// the object will be read from the backend next time.
+	if err := ba.localCache.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(data)); err != nil {
+		ba.errorLogger.Log(util.StatusWrapf(err, "Failed to store object %#v in the local cache", blobDigest.String()))
+		return data, nil
+	}
+
+	ba.lock.Lock()
+	if entry, ok := ba.cachedObjects[key]; ok && entry.generation == generation {
+		entry.validationTime = validationTime
+	}
+	ba.lock.Unlock()
+	return data, nil
+}
+
+func (ba *staleWhileRevalidateBlobAccess) getFromBackend(ctx context.Context, blobDigest digest.Digest, key string, validationTime time.Time) buffer.Buffer {
+	data, err := ba.refresh(ctx, blobDigest, key, validationTime)
+	if err != nil {
+		return buffer.NewBufferFromError(err)
+	}
+	return buffer.NewValidatedBufferFromByteSlice(data)
+}
+
+func (ba *staleWhileRevalidateBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	key := blobDigest.GetKey(digest.KeyWithInstance)
+	now := ba.clock.Now()
+
+	ba.lock.Lock()
+	entry, ok := ba.cachedObjects[key]
+	if !ok || !now.Before(entry.validationTime.Add(ba.freshnessDuration+ba.staleGraceDuration)) {
+		// No copy is available that is recent enough. Read the
+		// object from the backend synchronously.
+		ba.lock.Unlock()
+		staleWhileRevalidateBlobAccessGetOperationsExpired.Inc()
+		return ba.getFromBackend(ctx, blobDigest, key, now)
+	}
+	ba.evictionSet.Touch(key)
+	if now.Before(entry.validationTime.Add(ba.freshnessDuration)) {
+		ba.lock.Unlock()
+		staleWhileRevalidateBlobAccessGetOperationsFresh.Inc()
+	} else {
+		// The copy is stale, but still within the grace period.
+		// Serve it, but revalidate it in the background. Only
+		// launch a single revalidation per object at a time.
+		revalidate := !entry.revalidating
+		entry.revalidating = true
+		ba.lock.Unlock()
+		staleWhileRevalidateBlobAccessGetOperationsStale.Inc()
+
+		if revalidate {
+			backgroundCtx := context.WithoutCancel(ctx)
+			go func() {
+				if _, err := ba.refresh(backgroundCtx, blobDigest, key, now); err != nil && status.Code(err) != codes.NotFound {
+					ba.errorLogger.Log(util.StatusWrapf(err, "Failed to revalidate object %#v", blobDigest.String()))
+				}
+
+				ba.lock.Lock()
+				if entry, ok := ba.cachedObjects[key]; ok {
+					entry.revalidating = false
+				}
+				ba.lock.Unlock()
+			}()
+		}
+	}
+
+	return buffer.WithErrorHandler(
+		ba.localCache.Get(ctx, blobDigest),
+		&staleWhileRevalidateErrorHandler{
+			blobAccess:     ba,
+			context:        ctx,
+			digest:         blobDigest,
+			key:            key,
+			validationTime: now,
+		})
+}
+
+func (ba *staleWhileRevalidateBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	b, _ := slicer.Slice(ba.Get(ctx, parentDigest), childDigest)
+	return b
+}
+
+func (ba *staleWhileRevalidateBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	if err := ba.BlobAccess.Put(ctx, blobDigest, b); err != nil {
+		return err
+	}
+
+	// The copy in the local cache no longer corresponds to what is
+	// stored in the backend. Force the next read to go to the
+	// backend, so that the local cache gets updated.
+	ba.invalidate(blobDigest.GetKey(digest.KeyWithInstance))
+	return nil
+}
+
+// staleWhileRevalidateErrorHandler falls back to reading an object
+// from the backend synchronously if it is absent in the local cache.
+type staleWhileRevalidateErrorHandler struct {
+	blobAccess     *staleWhileRevalidateBlobAccess
+	context        context.Context
+	digest         digest.Digest
+	key            string
+	validationTime time.Time
+	fellBack       bool
+}
+
+func (eh *staleWhileRevalidateErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	if eh.fellBack || status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	eh.fellBack = true
+	return eh.blobAccess.getFromBackend(eh.context, eh.digest, eh.key, eh.validationTime), nil
+}
+
+func (eh *staleWhileRevalidateErrorHandler) Done() {}
@@ -0,0 +1,259 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/eviction"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestStaleWhileRevalidateBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	backend := mock.NewMockBlobAccess(ctrl)
+	localCache := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	blobAccess := blobstore.NewStaleWhileRevalidateBlobAccess(
+		backend,
+		localCache,
+		clock,
+		eviction.NewLRUSet[string](),
+		10,
+		time.Minute,
+		time.Hour,
+		1000,
+		errorLogger)
+
+	oldActionResult := &remoteexecution.ActionResult{
+		StdoutRaw: []byte("Old"),
+	}
+	newActionResult := &remoteexecution.ActionResult{
+		StdoutRaw: []byte("New"),
+	}
+	blobDigest := digest.MustNewDigest("instance", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 123)
+
+	expectLocalCachePut := func(expected *remoteexecution.ActionResult) {
+		localCache.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				m, err := b.ToProto(&remoteexecution.ActionResult{}, 1000)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, expected, m)
+				return nil
+			})
+	}
+
+	t.Run("Initial", func(t *testing.T) {
+		// Objects whose validation time is unknown should be
+		// read from the backend, and stored in the local cache.
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(oldActionResult, buffer.UserProvided))
+		expectLocalCachePut(oldActionResult)
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, oldActionResult, m)
+	})
+
+	t.Run("Fresh", func(t *testing.T) {
+		// Shortly after, the object should be served from the
+		// local cache without contacting the backend.
+		clock.EXPECT().Now().Return(time.Unix(1059, 0))
+		localCache.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(oldActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, oldActionResult, m)
+	})
+
+	t.Run("StaleWithinGrace", func(t *testing.T) {
+		// Once the freshness window has passed, the object
+		// should still be served from the local cache, but it
+		// should be revalidated in the background.
+		clock.EXPECT().Now().Return(time.Unix(1060, 0))
+		localCache.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(oldActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+		revalidationStarted := make(chan struct{})
+		allowRevalidation := make(chan struct{})
+		revalidationCompleted := make(chan struct{})
+		backend.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest) buffer.Buffer {
+				close(revalidationStarted)
+				<-allowRevalidation
+				return buffer.NewProtoBufferFromProto(newActionResult, buffer.UserProvided)
+			})
+		localCache.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				m, err := b.ToProto(&remoteexecution.ActionResult{}, 1000)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, newActionResult, m)
+				close(revalidationCompleted)
+				return nil
+			})
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, oldActionResult, m)
+		<-revalidationStarted
+
+		// While revalidation is in progress, subsequent reads
+		// should not launch additional revalidations.
+		clock.EXPECT().Now().Return(time.Unix(1070, 0))
+		localCache.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(oldActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+
+		m, err = blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, oldActionResult, m)
+
+		close(allowRevalidation)
+		<-revalidationCompleted
+	})
+
+	t.Run("BeyondGrace", func(t *testing.T) {
+		// Objects that were validated longer ago than the
+		// freshness and grace windows combined should be read
+		// from the backend synchronously.
+		clock.EXPECT().Now().Return(time.Unix(5000, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(newActionResult, buffer.UserProvided))
+		expectLocalCachePut(newActionResult)
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newActionResult, m)
+	})
+
+	t.Run("LocalCacheMiss", func(t *testing.T) {
+		// If the local cache no longer contains the object, it
+		// should be read from the backend synchronously.
+		clock.EXPECT().Now().Return(time.Unix(5010, 0))
+		localCache.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(newActionResult, buffer.UserProvided))
+		expectLocalCachePut(newActionResult)
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newActionResult, m)
+	})
+
+	t.Run("Put", func(t *testing.T) {
+		// Writes should be forwarded to the backend, and cause
+		// the next read to be forwarded to the backend as well.
+		backend.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(oldActionResult, buffer.UserProvided)))
+
+		clock.EXPECT().Now().Return(time.Unix(5020, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(oldActionResult, buffer.UserProvided))
+		expectLocalCachePut(oldActionResult)
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, oldActionResult, m)
+	})
+
+	t.Run("PutDuringRevalidation", func(t *testing.T) {
+		// If a Put() occurs while the object is being
+		// revalidated in the background, the data obtained by
+		// the revalidation may already be outdated. It should
+		// not be marked as valid.
+		clock.EXPECT().Now().Return(time.Unix(5080, 0))
+		localCache.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(oldActionResult, buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+		revalidationStarted := make(chan struct{})
+		allowRevalidation := make(chan struct{})
+		revalidationCompleted := make(chan struct{})
+		backend.EXPECT().Get(gomock.Any(), blobDigest).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest) buffer.Buffer {
+				close(revalidationStarted)
+				<-allowRevalidation
+				return buffer.NewProtoBufferFromProto(oldActionResult, buffer.UserProvided)
+			})
+		localCache.EXPECT().Put(gomock.Any(), blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				close(revalidationCompleted)
+				return nil
+			})
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, oldActionResult, m)
+		<-revalidationStarted
+
+		backend.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return nil
+			})
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(newActionResult, buffer.UserProvided)))
+
+		close(allowRevalidation)
+		<-revalidationCompleted
+
+		// The next read should be forwarded to the backend.
+		clock.EXPECT().Now().Return(time.Unix(5081, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(newActionResult, buffer.UserProvided))
+		expectLocalCachePut(newActionResult)
+
+		m, err = blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newActionResult, m)
+	})
+
+	t.Run("LocalCachePutFailure", func(t *testing.T) {
+		// Failing to update the local cache should not cause
+		// the read to fail. The error should be logged, and the
+		// next read should be forwarded to the backend.
+		clock.EXPECT().Now().Return(time.Unix(8800, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(newActionResult, buffer.UserProvided))
+		localCache.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.Internal, "Disk on fire")
+			})
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Internal, "Failed to store object \"1-185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969-123-instance\" in the local cache: Disk on fire")))
+
+		m, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newActionResult, m)
+
+		clock.EXPECT().Now().Return(time.Unix(8801, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewProtoBufferFromProto(newActionResult, buffer.UserProvided))
+		expectLocalCachePut(newActionResult)
+
+		m, err = blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newActionResult, m)
+	})
+
+	t.Run("BackendNotFound", func(t *testing.T) {
+		// If the backend reports the object as absent, the
+		// local copy should no longer be served.
+		clock.EXPECT().Now().Return(time.Unix(13000, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+
+		clock.EXPECT().Now().Return(time.Unix(13001, 0))
+		backend.EXPECT().Get(ctx, blobDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		_, err = blobAccess.Get(ctx, blobDigest).ToProto(&remoteexecution.ActionResult{}, 1000)
+		testutil.RequireEqualStatus(t, status.Error(codes.NotFound, "Object not found"), err)
+	})
+}
@@ -0,0 +1,74 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RunStartupSelfTest validates that a backend is configured correctly,
+// by writing a small canary object into it and reading it back. This
+// can be used to detect misconfiguration (e.g., incorrect bucket names
+// or credentials) when the program is started, as opposed to when the
+// first request is processed.
+//
+// The canary object is a serialized ActionResult message containing
+// the current time, which makes it possible to use this function
+// against both the Action Cache (AC) and the Content Addressable
+// Storage (CAS). As BlobAccess provides no means for removing objects,
+// the canary object is left behind. It is expected to be evicted from
+// storage eventually.
+//
+// The name of the backend is included in any errors returned, so that
+// it is clear which of the backends is misconfigured.
+func RunStartupSelfTest(ctx context.Context, name string, blobAccess BlobAccess, clock clock.Clock, digestFunction digest.Function, timeout time.Duration, maximumMessageSizeBytes int) error {
+	if err := runStartupSelfTest(ctx, blobAccess, clock, digestFunction, timeout, maximumMessageSizeBytes); err != nil {
+		return util.StatusWrapf(err, "Startup self-test of %s failed", name)
+	}
+	return nil
+}
+
+func runStartupSelfTest(ctx context.Context, blobAccess BlobAccess, clock clock.Clock, digestFunction digest.Function, timeout time.Duration, maximumMessageSizeBytes int) error {
+	// Include the current time in the canary object, so that a copy
+	// left behind by a previous self-test can't cause the read to
+	// succeed.
+	canaryData, err := proto.Marshal(&remoteexecution.ActionResult{
+		ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+			Worker:                   "buildbarn-startup-self-test",
+			WorkerCompletedTimestamp: timestamppb.New(clock.Now()),
+		},
+	})
+	if err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to marshal canary object")
+	}
+	generator := digestFunction.NewGenerator(int64(len(canaryData)))
+	if _, err := generator.Write(canaryData); err != nil {
+		return util.StatusWrapWithCode(err, codes.Internal, "Failed to compute digest of canary object")
+	}
+	canaryDigest := generator.Sum()
+
+	ctxWithTimeout, cancel := clock.NewContextWithTimeout(ctx, timeout)
+	defer cancel()
+	if err := blobAccess.Put(ctxWithTimeout, canaryDigest, buffer.NewValidatedBufferFromByteSlice(canaryData)); err != nil {
+		return util.StatusWrapf(err, "Failed to write canary object %#v", canaryDigest.String())
+	}
+	readData, err := blobAccess.Get(ctxWithTimeout, canaryDigest).ToByteSlice(maximumMessageSizeBytes)
+	if err != nil {
+		return util.StatusWrapf(err, "Failed to read back canary object %#v", canaryDigest.String())
+	}
+	if !bytes.Equal(readData, canaryData) {
+		return status.Errorf(codes.DataLoss, "Contents of canary object %#v differ from what was written", canaryDigest.String())
+	}
+	return nil
+}
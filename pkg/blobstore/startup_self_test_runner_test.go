@@ -0,0 +1,101 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRunStartupSelfTest(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	digestFunction := digest.MustNewFunction("hello", remoteexecution.DigestFunction_SHA256)
+
+	// The canary object contains the current time, meaning its
+	// contents are stable for a given time.
+	canaryData, err := proto.Marshal(&remoteexecution.ActionResult{
+		ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+			Worker:                   "buildbarn-startup-self-test",
+			WorkerCompletedTimestamp: &timestamppb.Timestamp{Seconds: 1000},
+		},
+	})
+	require.NoError(t, err)
+	generator := digestFunction.NewGenerator(int64(len(canaryData)))
+	_, err = generator.Write(canaryData)
+	require.NoError(t, err)
+	canaryDigest := generator.Sum()
+
+	expectCanaryWrite := func() {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(ctx, func() {})
+		blobAccess.EXPECT().Put(ctx, canaryDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(1000)
+				require.NoError(t, err)
+				require.Equal(t, canaryData, data)
+				return nil
+			})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expectCanaryWrite()
+		blobAccess.EXPECT().Get(ctx, canaryDigest).Return(buffer.NewValidatedBufferFromByteSlice(canaryData))
+
+		require.NoError(t, blobstore.RunStartupSelfTest(ctx, "Action Cache", blobAccess, clock, digestFunction, time.Minute, 1000))
+	})
+
+	t.Run("WriteFailure", func(t *testing.T) {
+		clock.EXPECT().Now().Return(time.Unix(1000, 0))
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(ctx, func() {})
+		blobAccess.EXPECT().Put(ctx, canaryDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return status.Error(codes.PermissionDenied, "Invalid credentials")
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.PermissionDenied, "Startup self-test of Action Cache failed: Failed to write canary object %#v: Invalid credentials", canaryDigest.String()),
+			blobstore.RunStartupSelfTest(ctx, "Action Cache", blobAccess, clock, digestFunction, time.Minute, 1000))
+	})
+
+	t.Run("ReadFailure", func(t *testing.T) {
+		expectCanaryWrite()
+		blobAccess.EXPECT().Get(ctx, canaryDigest).Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found")))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.NotFound, "Startup self-test of Content Addressable Storage failed: Failed to read back canary object %#v: Object not found", canaryDigest.String()),
+			blobstore.RunStartupSelfTest(ctx, "Content Addressable Storage", blobAccess, clock, digestFunction, time.Minute, 1000))
+	})
+
+	t.Run("ReadMismatch", func(t *testing.T) {
+		// The backend returns data that differs from what was
+		// written, for example because it is backed by storage
+		// that is shared with another cluster.
+		expectCanaryWrite()
+		blobAccess.EXPECT().Get(ctx, canaryDigest).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Errorf(codes.DataLoss, "Startup self-test of Action Cache failed: Contents of canary object %#v differ from what was written", canaryDigest.String()),
+			blobstore.RunStartupSelfTest(ctx, "Action Cache", blobAccess, clock, digestFunction, time.Minute, 1000))
+	})
+}
@@ -0,0 +1,102 @@
+package blobstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type timeoutBlobAccess struct {
+	BlobAccess
+	clock   clock.Clock
+	timeout time.Duration
+}
+
+// NewTimeoutBlobAccess creates a decorator for BlobAccess that bounds
+// the duration of every operation against the backend. It can be used
+// to prevent a single slow backend that is part of a sharded or
+// mirrored setup from stalling operations that are fanned out across
+// all backends.
+//
+// The timeout of Get() and GetFromComposite() also applies to reading
+// the contents of the returned buffer. It should therefore be chosen
+// such that the largest objects can be transferred within it.
+func NewTimeoutBlobAccess(base BlobAccess, clock clock.Clock, timeout time.Duration) BlobAccess {
+	return &timeoutBlobAccess{
+		BlobAccess: base,
+		clock:      clock,
+		timeout:    timeout,
+	}
+}
+
+// convertError replaces errors caused by the timeout being reached
+// with a message that states the backend was too slow. Errors caused
+// by the caller's context being canceled are returned as is.
+func (ba *timeoutBlobAccess) convertError(ctx, ctxWithTimeout context.Context, err error) error {
+	if err != nil && ctx.Err() == nil && ctxWithTimeout.Err() == context.DeadlineExceeded {
+		return status.Errorf(codes.DeadlineExceeded, "Backend did not complete the operation within %s", ba.timeout)
+	}
+	return err
+}
+
+func (ba *timeoutBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	ctxWithTimeout, cancel := ba.clock.NewContextWithTimeout(ctx, ba.timeout)
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.Get(ctxWithTimeout, blobDigest),
+		&timeoutErrorHandler{
+			blobAccess:     ba,
+			ctx:            ctx,
+			ctxWithTimeout: ctxWithTimeout,
+			cancel:         cancel,
+		})
+}
+
+func (ba *timeoutBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	ctxWithTimeout, cancel := ba.clock.NewContextWithTimeout(ctx, ba.timeout)
+	return buffer.WithErrorHandler(
+		ba.BlobAccess.GetFromComposite(ctxWithTimeout, parentDigest, childDigest, slicer),
+		&timeoutErrorHandler{
+			blobAccess:     ba,
+			ctx:            ctx,
+			ctxWithTimeout: ctxWithTimeout,
+			cancel:         cancel,
+		})
+}
+
+func (ba *timeoutBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	ctxWithTimeout, cancel := ba.clock.NewContextWithTimeout(ctx, ba.timeout)
+	defer cancel()
+	return ba.convertError(ctx, ctxWithTimeout, ba.BlobAccess.Put(ctxWithTimeout, blobDigest, b))
+}
+
+func (ba *timeoutBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	ctxWithTimeout, cancel := ba.clock.NewContextWithTimeout(ctx, ba.timeout)
+	defer cancel()
+	missing, err := ba.BlobAccess.FindMissing(ctxWithTimeout, digests)
+	if err != nil {
+		return digest.EmptySet, ba.convertError(ctx, ctxWithTimeout, err)
+	}
+	return missing, nil
+}
+
+type timeoutErrorHandler struct {
+	blobAccess     *timeoutBlobAccess
+	ctx            context.Context
+	ctxWithTimeout context.Context
+	cancel         context.CancelFunc
+}
+
+func (eh *timeoutErrorHandler) OnError(err error) (buffer.Buffer, error) {
+	return nil, eh.blobAccess.convertError(eh.ctx, eh.ctxWithTimeout, err)
+}
+
+func (eh *timeoutErrorHandler) Done() {
+	eh.cancel()
+}
@@ -0,0 +1,124 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTimeoutBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewTimeoutBlobAccess(baseBlobAccess, clock, time.Minute)
+
+	blobDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	// Contexts that are returned by the clock when the timeout
+	// has not been reached, and when it has been reached.
+	type contextKey struct{}
+	activeCtx := context.WithValue(ctx, contextKey{}, "active")
+	expiredCtx, cancelExpired := context.WithDeadline(ctx, time.Unix(0, 0))
+	defer cancelExpired()
+
+	t.Run("GetSuccess", func(t *testing.T) {
+		// The timeout should only be released after the
+		// buffer has been consumed.
+		canceled := false
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(activeCtx, func() { canceled = true })
+		baseBlobAccess.EXPECT().Get(activeCtx, blobDigest).
+			Return(buffer.NewCASBufferFromReader(blobDigest, io.NopCloser(bytes.NewBufferString("Hello")), buffer.BackendProvided(buffer.Irreparable(blobDigest))))
+
+		b := blobAccess.Get(ctx, blobDigest)
+		require.False(t, canceled)
+		data, err := b.ToByteSlice(100)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.True(t, canceled)
+	})
+
+	t.Run("GetTimeout", func(t *testing.T) {
+		canceled := false
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(expiredCtx, func() { canceled = true })
+		baseBlobAccess.EXPECT().Get(expiredCtx, blobDigest).
+			Return(buffer.NewBufferFromError(util.StatusFromContext(expiredCtx)))
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.DeadlineExceeded, "Backend did not complete the operation within 1m0s"), err)
+		require.True(t, canceled)
+	})
+
+	t.Run("PutSuccess", func(t *testing.T) {
+		canceled := false
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(activeCtx, func() { canceled = true })
+		baseBlobAccess.EXPECT().Put(activeCtx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.True(t, canceled)
+	})
+
+	t.Run("PutCallerCanceled", func(t *testing.T) {
+		// Errors caused by the caller's context being canceled
+		// should not be reported as the backend being slow.
+		canceledCtx, cancelCanceled := context.WithCancel(ctx)
+		cancelCanceled()
+		canceled := false
+		clock.EXPECT().NewContextWithTimeout(canceledCtx, time.Minute).Return(canceledCtx, func() { canceled = true })
+		baseBlobAccess.EXPECT().Put(canceledCtx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				b.Discard()
+				return util.StatusFromContext(ctx)
+			})
+
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Canceled, "context canceled"),
+			blobAccess.Put(canceledCtx, blobDigest, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+		require.True(t, canceled)
+	})
+
+	t.Run("FindMissingTimeout", func(t *testing.T) {
+		canceled := false
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(expiredCtx, func() { canceled = true })
+		baseBlobAccess.EXPECT().FindMissing(expiredCtx, blobDigest.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.DeadlineExceeded, "context deadline exceeded"))
+
+		_, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.DeadlineExceeded, "Backend did not complete the operation within 1m0s"), err)
+		require.True(t, canceled)
+	})
+
+	t.Run("FindMissingOtherError", func(t *testing.T) {
+		canceled := false
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(activeCtx, func() { canceled = true })
+		baseBlobAccess.EXPECT().FindMissing(activeCtx, blobDigest.ToSingletonSet()).
+			Return(digest.EmptySet, status.Error(codes.Unavailable, "Server offline"))
+
+		_, err := blobAccess.FindMissing(ctx, blobDigest.ToSingletonSet())
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+		require.True(t, canceled)
+	})
+}
@@ -0,0 +1,108 @@
+package trace
+
+import (
+	"bufio"
+	"io"
+	"sync"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	trace_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/trace"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Recorder of operations performed against a storage backend. Each
+// operation is written to an output stream as a length delimited
+// Operation message. Timestamps of operations are stored relative to
+// the time at which the Recorder was created.
+type Recorder struct {
+	clock clock.Clock
+	start time.Time
+
+	lock   sync.Mutex
+	writer *bufio.Writer
+}
+
+// NewRecorder creates a Recorder that writes operations to a provided
+// output stream. Writes are buffered. Call Flush() to ensure all
+// operations are written.
+func NewRecorder(w io.Writer, clock clock.Clock) *Recorder {
+	return &Recorder{
+		clock:  clock,
+		start:  clock.Now(),
+		writer: bufio.NewWriter(w),
+	}
+}
+
+// newOperation creates an Operation message for objects that share a
+// given instance name and digest function, with its timestamp set to
+// the current time.
+func (r *Recorder) newOperation(digestFunction digest.Function) *trace_pb.Operation {
+	return &trace_pb.Operation{
+		TimeSinceStart: durationpb.New(r.clock.Now().Sub(r.start)),
+		InstanceName:   digestFunction.GetInstanceName().String(),
+		DigestFunction: digestFunction.GetEnumValue(),
+	}
+}
+
+// RecordGet records that an object was read.
+func (r *Recorder) RecordGet(blobDigest digest.Digest) error {
+	operation := r.newOperation(blobDigest.GetDigestFunction())
+	operation.Kind = &trace_pb.Operation_Get{Get: blobDigest.GetProto()}
+	return r.write(operation)
+}
+
+// RecordPut records that an object was written.
+func (r *Recorder) RecordPut(blobDigest digest.Digest) error {
+	operation := r.newOperation(blobDigest.GetDigestFunction())
+	operation.Kind = &trace_pb.Operation_Put{Put: blobDigest.GetProto()}
+	return r.write(operation)
+}
+
+// RecordFindMissing records that the existence of a set of objects was
+// checked. As operations can only refer to objects having the same
+// instance name and digest function, one operation is recorded per
+// instance name and digest function.
+func (r *Recorder) RecordFindMissing(digests digest.Set) error {
+	for _, instanceNameDigests := range digests.PartitionByInstanceName() {
+		for _, partition := range instanceNameDigests.PartitionByDigestFunction() {
+			items := partition.Items()
+			operation := r.newOperation(items[0].GetDigestFunction())
+			findMissing := &trace_pb.Operation_FindMissing{
+				Digests: make([]*remoteexecution.Digest, 0, len(items)),
+			}
+			for _, blobDigest := range items {
+				findMissing.Digests = append(findMissing.Digests, blobDigest.GetProto())
+			}
+			operation.Kind = &trace_pb.Operation_FindMissing_{FindMissing: findMissing}
+			if err := r.write(operation); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Recorder) write(operation *trace_pb.Operation) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, err := protodelim.MarshalTo(r.writer, operation); err != nil {
+		return util.StatusWrap(err, "Failed to write operation")
+	}
+	return nil
+}
+
+// Flush all operations that have been recorded to the output stream.
+func (r *Recorder) Flush() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.writer.Flush(); err != nil {
+		return util.StatusWrap(err, "Failed to flush operations")
+	}
+	return nil
+}
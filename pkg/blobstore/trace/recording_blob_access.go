@@ -0,0 +1,63 @@
+package trace
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+)
+
+type recordingBlobAccess struct {
+	blobstore.BlobAccess
+	recorder    *Recorder
+	errorLogger util.ErrorLogger
+}
+
+// NewRecordingBlobAccess creates a decorator for BlobAccess that
+// records all Get(), GetFromComposite(), Put() and FindMissing()
+// operations using a Recorder. Recordings can be replayed against
+// another storage backend using Replay(), which makes it possible to
+// reproduce issues and perform load tests using realistic access
+// patterns.
+//
+// Operations are recorded before being forwarded, regardless of
+// whether they succeed. Failures to record operations are reported
+// through an ErrorLogger, and do not cause operations to fail.
+func NewRecordingBlobAccess(base blobstore.BlobAccess, recorder *Recorder, errorLogger util.ErrorLogger) blobstore.BlobAccess {
+	return &recordingBlobAccess{
+		BlobAccess:  base,
+		recorder:    recorder,
+		errorLogger: errorLogger,
+	}
+}
+
+func (ba *recordingBlobAccess) Get(ctx context.Context, blobDigest digest.Digest) buffer.Buffer {
+	if err := ba.recorder.RecordGet(blobDigest); err != nil {
+		ba.errorLogger.Log(err)
+	}
+	return ba.BlobAccess.Get(ctx, blobDigest)
+}
+
+func (ba *recordingBlobAccess) GetFromComposite(ctx context.Context, parentDigest, childDigest digest.Digest, slicer slicing.BlobSlicer) buffer.Buffer {
+	if err := ba.recorder.RecordGet(childDigest); err != nil {
+		ba.errorLogger.Log(err)
+	}
+	return ba.BlobAccess.GetFromComposite(ctx, parentDigest, childDigest, slicer)
+}
+
+func (ba *recordingBlobAccess) Put(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+	if err := ba.recorder.RecordPut(blobDigest); err != nil {
+		ba.errorLogger.Log(err)
+	}
+	return ba.BlobAccess.Put(ctx, blobDigest, b)
+}
+
+func (ba *recordingBlobAccess) FindMissing(ctx context.Context, digests digest.Set) (digest.Set, error) {
+	if err := ba.recorder.RecordFindMissing(digests); err != nil {
+		ba.errorLogger.Log(err)
+	}
+	return ba.BlobAccess.FindMissing(ctx, digests)
+}
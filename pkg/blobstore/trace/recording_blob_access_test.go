@@ -0,0 +1,124 @@
+package trace_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/trace"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	trace_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/trace"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecordingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	clock.EXPECT().Now().Return(time.Unix(1000, 0))
+	var recording bytes.Buffer
+	recorder := trace.NewRecorder(&recording, clock)
+	blobAccess := trace.NewRecordingBlobAccess(baseBlobAccess, recorder, mock.NewMockErrorLogger(ctrl))
+
+	digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+	digest3 := digest.MustNewDigest("world", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+	digest4 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5)
+
+	// Perform a sequence of operations against the backend.
+	clock.EXPECT().Now().Return(time.Unix(1001, 0))
+	baseBlobAccess.EXPECT().Put(ctx, digest1, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+			b.Discard()
+			return nil
+		})
+	require.NoError(t, blobAccess.Put(ctx, digest1, buffer.NewValidatedBufferFromByteSlice([]byte("Hello"))))
+
+	clock.EXPECT().Now().Return(time.Unix(1002, 500000000))
+	baseBlobAccess.EXPECT().Get(ctx, digest1).Return(buffer.NewValidatedBufferFromByteSlice([]byte("Hello")))
+	data, err := blobAccess.Get(ctx, digest1).ToByteSlice(100)
+	require.NoError(t, err)
+	require.Equal(t, []byte("Hello"), data)
+
+	clock.EXPECT().Now().Return(time.Unix(1004, 0)).Times(3)
+	digests := digest.NewSetBuilder().Add(digest1).Add(digest2).Add(digest3).Add(digest4).Build()
+	baseBlobAccess.EXPECT().FindMissing(ctx, digests).Return(digest2.ToSingletonSet(), nil)
+	missing, err := blobAccess.FindMissing(ctx, digests)
+	require.NoError(t, err)
+	require.Equal(t, digest2.ToSingletonSet(), missing)
+
+	// The recording should contain all of these operations,
+	// including their digests and timing. FindMissing() calls
+	// are split up by instance name and digest function.
+	require.NoError(t, recorder.Flush())
+	reader := bytes.NewReader(recording.Bytes())
+	for _, expectedOperation := range []*trace_pb.Operation{
+		{
+			TimeSinceStart: &durationpb.Duration{Seconds: 1},
+			InstanceName:   "hello",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			Kind:           &trace_pb.Operation_Put{Put: digest1.GetProto()},
+		},
+		{
+			TimeSinceStart: &durationpb.Duration{Seconds: 2, Nanos: 500000000},
+			InstanceName:   "hello",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			Kind:           &trace_pb.Operation_Get{Get: digest1.GetProto()},
+		},
+		{
+			TimeSinceStart: &durationpb.Duration{Seconds: 4},
+			InstanceName:   "hello",
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+			Kind: &trace_pb.Operation_FindMissing_{
+				FindMissing: &trace_pb.Operation_FindMissing{
+					Digests: []*remoteexecution.Digest{
+						digest4.GetProto(),
+					},
+				},
+			},
+		},
+		{
+			TimeSinceStart: &durationpb.Duration{Seconds: 4},
+			InstanceName:   "hello",
+			DigestFunction: remoteexecution.DigestFunction_MD5,
+			Kind: &trace_pb.Operation_FindMissing_{
+				FindMissing: &trace_pb.Operation_FindMissing{
+					Digests: []*remoteexecution.Digest{
+						digest2.GetProto(),
+						digest1.GetProto(),
+					},
+				},
+			},
+		},
+		{
+			TimeSinceStart: &durationpb.Duration{Seconds: 4},
+			InstanceName:   "world",
+			DigestFunction: remoteexecution.DigestFunction_SHA256,
+			Kind: &trace_pb.Operation_FindMissing_{
+				FindMissing: &trace_pb.Operation_FindMissing{
+					Digests: []*remoteexecution.Digest{
+						digest3.GetProto(),
+					},
+				},
+			},
+		},
+	} {
+		var operation trace_pb.Operation
+		require.NoError(t, protodelim.UnmarshalFrom(reader, &operation))
+		testutil.RequireEqualProto(t, expectedOperation, &operation)
+	}
+	var operation trace_pb.Operation
+	require.Equal(t, io.EOF, protodelim.UnmarshalFrom(reader, &operation))
+}
@@ -0,0 +1,223 @@
+package trace
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	trace_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/trace"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// Replayer of operations that were recorded by Recorder. Operations
+// are issued against a storage backend with the same timing as during
+// recording, optionally sped up or slowed down.
+//
+// Recordings don't contain the contents of objects. Put() operations
+// are therefore replayed by writing synthetic objects of the same size.
+// As these have a different digest, subsequent operations referring to
+// the original object are rewritten to refer to the synthetic object.
+type Replayer struct {
+	blobAccess  blobstore.BlobAccess
+	clock       clock.Clock
+	speed       float64
+	concurrency int64
+	errorLogger util.ErrorLogger
+
+	lock             sync.Mutex
+	syntheticDigests map[digest.Digest]digest.Digest
+}
+
+// NewReplayer creates a Replayer. The speed determines how quickly
+// operations are issued relative to the original recording (e.g., 2.0
+// replays operations twice as fast). If the speed is zero, operations
+// are issued as quickly as possible. The concurrency places a limit on
+// the number of operations that are in flight at any point in time.
+//
+// Failing operations are reported through an ErrorLogger. Get()
+// operations failing with NOT_FOUND are not reported, as recordings
+// may refer to objects that were written prior to recording.
+func NewReplayer(blobAccess blobstore.BlobAccess, clock clock.Clock, speed float64, concurrency int, errorLogger util.ErrorLogger) *Replayer {
+	return &Replayer{
+		blobAccess:  blobAccess,
+		clock:       clock,
+		speed:       speed,
+		concurrency: int64(concurrency),
+		errorLogger: errorLogger,
+
+		syntheticDigests: map[digest.Digest]digest.Digest{},
+	}
+}
+
+// translateDigest rewrites the digest of an object that was written
+// during the recording to that of its synthetic counterpart.
+func (r *Replayer) translateDigest(blobDigest digest.Digest) digest.Digest {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if syntheticDigest, ok := r.syntheticDigests[blobDigest]; ok {
+		return syntheticDigest
+	}
+	return blobDigest
+}
+
+// syntheticBlobReader generates the contents of a synthetic object by
+// repeating a pattern.
+type syntheticBlobReader struct {
+	pattern        []byte
+	offset         int
+	remainingBytes int64
+}
+
+func newSyntheticBlobReader(blobDigest digest.Digest) *syntheticBlobReader {
+	return &syntheticBlobReader{
+		pattern:        blobDigest.GetHashBytes(),
+		remainingBytes: blobDigest.GetSizeBytes(),
+	}
+}
+
+func (sr *syntheticBlobReader) Read(p []byte) (int, error) {
+	if sr.remainingBytes == 0 {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > sr.remainingBytes {
+		p = p[:sr.remainingBytes]
+	}
+	for i := range p {
+		p[i] = sr.pattern[sr.offset]
+		sr.offset = (sr.offset + 1) % len(sr.pattern)
+	}
+	sr.remainingBytes -= int64(len(p))
+	return len(p), nil
+}
+
+// newOperation converts an Operation message to a function that
+// replays it.
+func (r *Replayer) newOperation(operation *trace_pb.Operation) (func(ctx context.Context) error, error) {
+	instanceName, err := digest.NewInstanceName(operation.InstanceName)
+	if err != nil {
+		return nil, util.StatusWrapf(err, "Invalid instance name %#v", operation.InstanceName)
+	}
+	digestFunction, err := instanceName.GetDigestFunction(operation.DigestFunction, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind := operation.Kind.(type) {
+	case *trace_pb.Operation_Get:
+		blobDigest, err := digestFunction.NewDigestFromProto(kind.Get)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Invalid digest")
+		}
+		return func(ctx context.Context) error {
+			err := r.blobAccess.Get(ctx, r.translateDigest(blobDigest)).IntoWriter(io.Discard)
+			if err != nil && status.Code(err) != codes.NotFound {
+				return util.StatusWrapf(err, "Failed to get object %#v", blobDigest.String())
+			}
+			return nil
+		}, nil
+	case *trace_pb.Operation_Put:
+		blobDigest, err := digestFunction.NewDigestFromProto(kind.Put)
+		if err != nil {
+			return nil, util.StatusWrap(err, "Invalid digest")
+		}
+		generator := digestFunction.NewGenerator(blobDigest.GetSizeBytes())
+		if _, err := io.Copy(generator, newSyntheticBlobReader(blobDigest)); err != nil {
+			return nil, util.StatusWrap(err, "Failed to compute digest of synthetic object")
+		}
+		syntheticDigest := generator.Sum()
+		r.lock.Lock()
+		r.syntheticDigests[blobDigest] = syntheticDigest
+		r.lock.Unlock()
+		return func(ctx context.Context) error {
+			if err := r.blobAccess.Put(
+				ctx,
+				syntheticDigest,
+				buffer.NewCASBufferFromReader(syntheticDigest, io.NopCloser(newSyntheticBlobReader(blobDigest)), buffer.UserProvided),
+			); err != nil {
+				return util.StatusWrapf(err, "Failed to put object %#v", blobDigest.String())
+			}
+			return nil
+		}, nil
+	case *trace_pb.Operation_FindMissing_:
+		digests := digest.NewSetBuilder()
+		for i, blobDigest := range kind.FindMissing.Digests {
+			d, err := digestFunction.NewDigestFromProto(blobDigest)
+			if err != nil {
+				return nil, util.StatusWrapf(err, "Invalid digest at index %d", i)
+			}
+			digests.Add(r.translateDigest(d))
+		}
+		return func(ctx context.Context) error {
+			if _, err := r.blobAccess.FindMissing(ctx, digests.Build()); err != nil {
+				return util.StatusWrap(err, "Failed to find missing objects")
+			}
+			return nil
+		}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Unknown operation kind")
+	}
+}
+
+// Replay all operations contained in a recording. This function
+// returns once all operations have completed, or if the recording is
+// malformed.
+func (r *Replayer) Replay(ctx context.Context, recording io.Reader) error {
+	reader := bufio.NewReader(recording)
+	unmarshalOptions := protodelim.UnmarshalOptions{MaxSize: -1}
+	sem := semaphore.NewWeighted(r.concurrency)
+	start := r.clock.Now()
+	err := func() error {
+		for index := 0; ; index++ {
+			var operation trace_pb.Operation
+			if err := unmarshalOptions.UnmarshalFrom(reader, &operation); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return util.StatusWrapfWithCode(err, codes.InvalidArgument, "Failed to read operation at index %d", index)
+			}
+			replayOperation, err := r.newOperation(&operation)
+			if err != nil {
+				return util.StatusWrapf(err, "Invalid operation at index %d", index)
+			}
+
+			// Wait until the operation needs to be issued.
+			if r.speed > 0 {
+				issueTime := start.Add(time.Duration(float64(operation.TimeSinceStart.AsDuration()) / r.speed))
+				if delay := issueTime.Sub(r.clock.Now()); delay > 0 {
+					timer, timerChannel := r.clock.NewTimer(delay)
+					select {
+					case <-ctx.Done():
+						timer.Stop()
+						return util.StatusFromContext(ctx)
+					case <-timerChannel:
+					}
+				}
+			}
+
+			if err := util.AcquireSemaphore(ctx, sem, 1); err != nil {
+				return err
+			}
+			go func() {
+				defer sem.Release(1)
+				if err := replayOperation(ctx); err != nil {
+					r.errorLogger.Log(err)
+				}
+			}()
+		}
+	}()
+
+	// Wait for all operations in flight to complete.
+	sem.Acquire(context.Background(), r.concurrency)
+	return err
+}
@@ -0,0 +1,98 @@
+package trace_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/trace"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	trace_pb "github.com/buildbarn/bb-storage/pkg/proto/blobstore/trace"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestReplayer(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	digest1 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+	digest2 := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "6fc422233a40a75a1f028e11c3cd1140", 7)
+
+	// Objects written during the recording are replaced by
+	// synthetic objects of the same size, whose contents are
+	// derived from the original hash.
+	syntheticData := digest1.GetHashBytes()[:5]
+	syntheticHash := md5.Sum(syntheticData)
+	syntheticDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, hex.EncodeToString(syntheticHash[:]), 5)
+
+	var recording bytes.Buffer
+	for i, operation := range []*trace_pb.Operation{
+		{Kind: &trace_pb.Operation_Put{Put: digest1.GetProto()}},
+		{Kind: &trace_pb.Operation_Get{Get: digest1.GetProto()}},
+		{Kind: &trace_pb.Operation_Get{Get: digest2.GetProto()}},
+		{Kind: &trace_pb.Operation_FindMissing_{
+			FindMissing: &trace_pb.Operation_FindMissing{
+				Digests: []*remoteexecution.Digest{digest1.GetProto(), digest2.GetProto()},
+			},
+		}},
+		{Kind: &trace_pb.Operation_Get{Get: digest2.GetProto()}},
+	} {
+		operation.TimeSinceStart = durationpb.New(time.Duration(i) * time.Second)
+		operation.InstanceName = "hello"
+		operation.DigestFunction = remoteexecution.DigestFunction_MD5
+		_, err := protodelim.MarshalTo(&recording, operation)
+		require.NoError(t, err)
+	}
+
+	// Replay the recording at twice the original speed. With a
+	// concurrency of one, operations should be issued in the
+	// original order.
+	blobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	errorLogger := mock.NewMockErrorLogger(ctrl)
+	replayer := trace.NewReplayer(blobAccess, clock, 2.0, 1, errorLogger)
+
+	clock.EXPECT().Now().Return(time.Unix(1000, 0)).AnyTimes()
+	expectTimer := func(d time.Duration) {
+		timerChannel := make(chan time.Time, 1)
+		timerChannel <- time.Unix(1000, 0).Add(d)
+		clock.EXPECT().NewTimer(d).Return(mock.NewMockTimer(ctrl), timerChannel)
+	}
+	gomock.InOrder(
+		blobAccess.EXPECT().Put(gomock.Any(), syntheticDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(100)
+				require.NoError(t, err)
+				require.Equal(t, syntheticData, data)
+				return nil
+			}),
+		blobAccess.EXPECT().Get(gomock.Any(), syntheticDigest).
+			Return(buffer.NewValidatedBufferFromByteSlice(syntheticData)),
+		blobAccess.EXPECT().Get(gomock.Any(), digest2).
+			Return(buffer.NewBufferFromError(status.Error(codes.NotFound, "Object not found"))),
+		blobAccess.EXPECT().FindMissing(gomock.Any(), digest.NewSetBuilder().Add(syntheticDigest).Add(digest2).Build()).
+			Return(digest2.ToSingletonSet(), nil),
+		blobAccess.EXPECT().Get(gomock.Any(), digest2).
+			Return(buffer.NewBufferFromError(status.Error(codes.Unavailable, "Server offline"))),
+	)
+	expectTimer(500 * time.Millisecond)
+	expectTimer(time.Second)
+	expectTimer(1500 * time.Millisecond)
+	expectTimer(2 * time.Second)
+	errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Failed to get object \"3-6fc422233a40a75a1f028e11c3cd1140-7-hello\": Server offline")))
+
+	require.NoError(t, replayer.Replay(ctx, &recording))
+}
@@ -0,0 +1,159 @@
+package blobstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	writeCanaryingBlobAccessPrometheusMetrics sync.Once
+
+	writeCanaryingBlobAccessWritable = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "buildbarn",
+			Subsystem: "blobstore",
+			Name:      "write_canarying_blob_access_writable",
+			Help:      "Whether the most recent canary writes against the backend succeeded",
+		},
+		[]string{"storage_type", "name"})
+)
+
+// WriteCanaryingBlobAccess is a decorator for BlobAccess that
+// periodically writes a small canary object into the backend, to
+// determine whether the backend is still capable of accepting writes.
+// The outcome can be used to report the program as not being ready,
+// causing load balancers to stop routing traffic to instances whose
+// storage has degraded to being read-only.
+//
+// The canary object is a serialized ActionResult message, which makes
+// it possible to use this decorator for both the Action Cache (AC) and
+// the Content Addressable Storage (CAS). As the canary object is
+// identical for every write, repeated writes do not consume additional
+// space.
+type WriteCanaryingBlobAccess struct {
+	BlobAccess
+	clock            clock.Clock
+	canaryDigest     digest.Digest
+	canaryData       []byte
+	timeout          time.Duration
+	failureThreshold int
+	writableMetric   prometheus.Gauge
+
+	lock                sync.Mutex
+	consecutiveFailures int
+	lastErr             error
+}
+
+// NewWriteCanaryingBlobAccess creates a WriteCanaryingBlobAccess that
+// writes canary objects using the provided digest function. Each write
+// is permitted to take at most the provided timeout. The backend is
+// considered to be no longer writable after failureThreshold
+// consecutive writes have failed. It is considered to be writable again
+// as soon as a single write succeeds. The name is used to distinguish
+// the metrics of multiple instances of this decorator.
+func NewWriteCanaryingBlobAccess(base BlobAccess, clock clock.Clock, digestFunction digest.Function, timeout time.Duration, failureThreshold int, storageType, name string) *WriteCanaryingBlobAccess {
+	writeCanaryingBlobAccessPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(writeCanaryingBlobAccessWritable)
+	})
+
+	canaryData, err := proto.Marshal(&remoteexecution.ActionResult{
+		ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+			Worker: "buildbarn-write-canary",
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	generator := digestFunction.NewGenerator(int64(len(canaryData)))
+	if _, err := generator.Write(canaryData); err != nil {
+		panic(err)
+	}
+
+	return &WriteCanaryingBlobAccess{
+		BlobAccess:       base,
+		clock:            clock,
+		canaryDigest:     generator.Sum(),
+		canaryData:       canaryData,
+		timeout:          timeout,
+		failureThreshold: failureThreshold,
+		writableMetric:   writeCanaryingBlobAccessWritable.WithLabelValues(storageType, name),
+
+		// Don't report the backend as being writable until the
+		// first canary write has completed.
+		consecutiveFailures: failureThreshold,
+		lastErr:             status.Error(codes.Unavailable, "No canary writes have been performed yet"),
+	}
+}
+
+// PerformCanaryWrite writes the canary object into the backend and
+// updates the writability state accordingly. The outcome of the write
+// is returned.
+func (ba *WriteCanaryingBlobAccess) PerformCanaryWrite(ctx context.Context) error {
+	ctxWithTimeout, cancel := ba.clock.NewContextWithTimeout(ctx, ba.timeout)
+	err := ba.BlobAccess.Put(ctxWithTimeout, ba.canaryDigest, buffer.NewValidatedBufferFromByteSlice(ba.canaryData))
+	cancel()
+	if err != nil {
+		err = util.StatusWrapf(err, "Failed to write canary object %#v", ba.canaryDigest.String())
+	}
+
+	ba.lock.Lock()
+	if err == nil {
+		ba.consecutiveFailures = 0
+		ba.lastErr = nil
+	} else {
+		ba.consecutiveFailures++
+		ba.lastErr = err
+	}
+	writable := ba.consecutiveFailures < ba.failureThreshold
+	ba.lock.Unlock()
+
+	if writable {
+		ba.writableMetric.Set(1)
+	} else {
+		ba.writableMetric.Set(0)
+	}
+	return err
+}
+
+// CheckReadiness returns an error if the backend is currently not
+// considered to be writable. This function may be registered as a
+// readiness check.
+func (ba *WriteCanaryingBlobAccess) CheckReadiness() error {
+	ba.lock.Lock()
+	defer ba.lock.Unlock()
+
+	if ba.consecutiveFailures < ba.failureThreshold {
+		return nil
+	}
+	return util.StatusWrapWithCode(ba.lastErr, codes.Unavailable, "Backend is not writable")
+}
+
+// Run the loop that periodically writes canary objects into the
+// backend.
+func (ba *WriteCanaryingBlobAccess) Run(ctx context.Context, interval time.Duration, errorLogger util.ErrorLogger) {
+	for {
+		if err := ba.PerformCanaryWrite(ctx); err != nil && ctx.Err() == nil {
+			errorLogger.Log(err)
+		}
+
+		timer, timerChannel := ba.clock.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timerChannel:
+		}
+	}
+}
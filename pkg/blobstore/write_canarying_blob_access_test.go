@@ -0,0 +1,164 @@
+package blobstore_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestWriteCanaryingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	clock := mock.NewMockClock(ctrl)
+	blobAccess := blobstore.NewWriteCanaryingBlobAccess(
+		baseBlobAccess,
+		clock,
+		digest.MustNewFunction("canary", remoteexecution.DigestFunction_SHA256),
+		time.Minute,
+		/* failureThreshold = */ 2,
+		"cas",
+		"write_canarying_test")
+
+	expectCanaryWrite := func(err error) {
+		clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(ctx, func() {})
+		baseBlobAccess.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				// The canary object must be a valid
+				// ActionResult, whose digest matches its
+				// contents.
+				actionResult, bufErr := b.ToProto(&remoteexecution.ActionResult{}, 1000)
+				require.NoError(t, bufErr)
+				testutil.RequireEqualProto(t, &remoteexecution.ActionResult{
+					ExecutionMetadata: &remoteexecution.ExecutedActionMetadata{
+						Worker: "buildbarn-write-canary",
+					},
+				}, actionResult)
+				data, marshalErr := proto.Marshal(actionResult)
+				require.NoError(t, marshalErr)
+				generator := digest.MustNewFunction("canary", remoteexecution.DigestFunction_SHA256).NewGenerator(int64(len(data)))
+				_, writeErr := generator.Write(data)
+				require.NoError(t, writeErr)
+				require.Equal(t, generator.Sum(), blobDigest)
+				return err
+			})
+	}
+
+	t.Run("Initial", func(t *testing.T) {
+		// Until the first canary write completes, the backend
+		// should not be reported as being writable.
+		testutil.RequireEqualStatus(
+			t,
+			status.Error(codes.Unavailable, "Backend is not writable: No canary writes have been performed yet"),
+			blobAccess.CheckReadiness())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		expectCanaryWrite(nil)
+		require.NoError(t, blobAccess.PerformCanaryWrite(ctx))
+		require.NoError(t, blobAccess.CheckReadiness())
+	})
+
+	t.Run("FailureBelowThreshold", func(t *testing.T) {
+		// A single failure should not cause the readiness to
+		// flip, as the failure threshold is set to two.
+		expectCanaryWrite(status.Error(codes.ResourceExhausted, "Storage is read-only"))
+		require.Error(t, blobAccess.PerformCanaryWrite(ctx))
+		require.NoError(t, blobAccess.CheckReadiness())
+	})
+
+	t.Run("FailureAtThreshold", func(t *testing.T) {
+		expectCanaryWrite(status.Error(codes.ResourceExhausted, "Storage is read-only"))
+		require.Error(t, blobAccess.PerformCanaryWrite(ctx))
+
+		err := blobAccess.CheckReadiness()
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Contains(t, status.Convert(err).Message(), "Backend is not writable: Failed to write canary object")
+		require.Contains(t, status.Convert(err).Message(), "Storage is read-only")
+	})
+
+	t.Run("Recovery", func(t *testing.T) {
+		// A single successful write should cause the backend to
+		// be reported as being writable again.
+		expectCanaryWrite(nil)
+		require.NoError(t, blobAccess.PerformCanaryWrite(ctx))
+		require.NoError(t, blobAccess.CheckReadiness())
+	})
+}
+
+// getWriteCanaryingBlobAccessWritable returns the value of the
+// writability gauge for a given storage type and name.
+func getWriteCanaryingBlobAccessWritable(t *testing.T, storageType, name string) float64 {
+	metricFamilies, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "buildbarn_blobstore_write_canarying_blob_access_writable" {
+			continue
+		}
+		for _, metric := range metricFamily.Metric {
+			labelPairs := map[string]string{}
+			for _, labelPair := range metric.Label {
+				labelPairs[labelPair.GetName()] = labelPair.GetValue()
+			}
+			if labelPairs["storage_type"] == storageType && labelPairs["name"] == name {
+				return metric.Gauge.GetValue()
+			}
+		}
+	}
+	t.Fatalf("No writability gauge for storage type %#v and name %#v", storageType, name)
+	return 0
+}
+
+func TestWriteCanaryingBlobAccessMultipleInstances(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Multiple instances of this decorator may be used for the
+	// same storage type, e.g. when the Content Addressable Storage
+	// is sharded. Each of them should report their writability
+	// separately, instead of overwriting each other's state.
+	clock := mock.NewMockClock(ctrl)
+	baseBlobAccess1 := mock.NewMockBlobAccess(ctrl)
+	blobAccess1 := blobstore.NewWriteCanaryingBlobAccess(
+		baseBlobAccess1,
+		clock,
+		digest.MustNewFunction("canary", remoteexecution.DigestFunction_SHA256),
+		time.Minute,
+		/* failureThreshold = */ 1,
+		"cas",
+		"write_canarying_test_shard1")
+	baseBlobAccess2 := mock.NewMockBlobAccess(ctrl)
+	blobAccess2 := blobstore.NewWriteCanaryingBlobAccess(
+		baseBlobAccess2,
+		clock,
+		digest.MustNewFunction("canary", remoteexecution.DigestFunction_SHA256),
+		time.Minute,
+		/* failureThreshold = */ 1,
+		"cas",
+		"write_canarying_test_shard2")
+
+	clock.EXPECT().NewContextWithTimeout(ctx, time.Minute).Return(ctx, func() {}).Times(2)
+	baseBlobAccess1.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	require.NoError(t, blobAccess1.PerformCanaryWrite(ctx))
+	baseBlobAccess2.EXPECT().Put(ctx, gomock.Any(), gomock.Any()).
+		Return(status.Error(codes.ResourceExhausted, "Storage is read-only"))
+	require.Error(t, blobAccess2.PerformCanaryWrite(ctx))
+
+	require.Equal(t, 1.0, getWriteCanaryingBlobAccessWritable(t, "cas", "write_canarying_test_shard1"))
+	require.Equal(t, 0.0, getWriteCanaryingBlobAccessWritable(t, "cas", "write_canarying_test_shard2"))
+}
@@ -4,7 +4,10 @@ import (
 	"archive/zip"
 	"context"
 	"io"
+	"strconv"
+	"strings"
 
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/slicing"
 	"github.com/buildbarn/bb-storage/pkg/capabilities"
@@ -93,6 +96,73 @@ func (ba *zipReadingBlobAccess) FindMissing(ctx context.Context, digests digest.
 	return missing.Build(), nil
 }
 
+// FilterZIPFilesByHashPrefix returns the subset of files in a ZIP
+// archive whose names correspond to digests having a hash that starts
+// with one of the provided prefixes. This can be used to restore only
+// parts of a backup, without opening any of the files that are skipped.
+//
+// If no prefixes are provided, all files are returned.
+func FilterZIPFilesByHashPrefix(files []*zip.File, hashPrefixes []string) []*zip.File {
+	if len(hashPrefixes) == 0 {
+		return files
+	}
+	var filteredFiles []*zip.File
+	for _, file := range files {
+		// File names are digest keys of the form
+		// "${function}-${hash}-${size}[-${instance}]".
+		fields := strings.SplitN(file.Name, "-", 3)
+		if len(fields) < 3 {
+			continue
+		}
+		for _, hashPrefix := range hashPrefixes {
+			if strings.HasPrefix(fields[1], hashPrefix) {
+				filteredFiles = append(filteredFiles, file)
+				break
+			}
+		}
+	}
+	return filteredFiles
+}
+
+// GetZIPFileDigests returns the digests of all objects stored in a ZIP
+// archive, based on the names of the files contained within. Files
+// whose names don't contain an instance name are assumed to belong to
+// the provided instance name.
+func GetZIPFileDigests(files []*zip.File, instanceName digest.InstanceName) (digest.Set, error) {
+	digests := digest.NewSetBuilder()
+	for _, file := range files {
+		fields := strings.SplitN(file.Name, "-", 4)
+		if len(fields) < 3 {
+			return digest.EmptySet, status.Errorf(codes.InvalidArgument, "File %#v in ZIP archive does not have a valid digest key as its name", file.Name)
+		}
+		digestFunctionValue, err := strconv.ParseInt(fields[0], 10, 32)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapfWithCode(err, codes.InvalidArgument, "Invalid digest function in name of file %#v in ZIP archive", file.Name)
+		}
+		sizeBytes, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapfWithCode(err, codes.InvalidArgument, "Invalid size in name of file %#v in ZIP archive", file.Name)
+		}
+		fileInstanceName := instanceName
+		if len(fields) == 4 {
+			fileInstanceName, err = digest.NewInstanceName(fields[3])
+			if err != nil {
+				return digest.EmptySet, util.StatusWrapf(err, "Invalid instance name in name of file %#v in ZIP archive", file.Name)
+			}
+		}
+		digestFunction, err := fileInstanceName.GetDigestFunction(remoteexecution.DigestFunction_Value(digestFunctionValue), 0)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Invalid digest function in name of file %#v in ZIP archive", file.Name)
+		}
+		fileDigest, err := digestFunction.NewDigest(fields[1], sizeBytes)
+		if err != nil {
+			return digest.EmptySet, util.StatusWrapf(err, "Invalid digest in name of file %#v in ZIP archive", file.Name)
+		}
+		digests.Add(fileDigest)
+	}
+	return digests.Build(), nil
+}
+
 type nopAtCloser struct {
 	io.ReaderAt
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/buildbarn/bb-storage/internal/mock"
 	"github.com/buildbarn/bb-storage/pkg/blobstore"
 	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/replication"
 	"github.com/buildbarn/bb-storage/pkg/digest"
 	"github.com/buildbarn/bb-storage/pkg/testutil"
 	"github.com/stretchr/testify/require"
@@ -161,4 +162,50 @@ func TestZIPReadingBlobAccess(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA256, "522b44d647b6989f60302ef755c277e508d5bcc38f05e139906ebdb03a5b19f2", 9).ToSingletonSet(), missing)
 	})
+
+	t.Run("HashPrefixes", func(t *testing.T) {
+		// Only restore objects whose hash starts with a given
+		// prefix. Files that don't match should not be part of
+		// the index, and should therefore never be opened.
+		files := blobstore.FilterZIPFilesByHashPrefix(zipReader.File, []string{"8b1a", "ffff"})
+		digests, err := blobstore.GetZIPFileDigests(files, digest.MustNewInstanceName("example"))
+		require.NoError(t, err)
+		fileDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+		require.Equal(t, fileDigest.ToSingletonSet(), digests)
+
+		filteredBlobAccess := blobstore.NewZIPReadingBlobAccess(
+			capabilitiesProvider,
+			blobstore.CASReadBufferFactory,
+			digest.KeyWithoutInstance,
+			files)
+		sink := mock.NewMockBlobAccess(ctrl)
+		sink.EXPECT().Put(ctx, fileDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(1000)
+				require.NoError(t, err)
+				require.Equal(t, []byte("Hello"), data)
+				return nil
+			})
+		require.NoError(t, replication.NewLocalBlobReplicator(filteredBlobAccess, sink).ReplicateMultiple(ctx, digests))
+
+		otherDigest := digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA1, "897256b6709e1a4da9daba92b6bde39ccfccd8c1", 16384)
+		missing, err := filteredBlobAccess.FindMissing(ctx, otherDigest.ToSingletonSet())
+		require.NoError(t, err)
+		require.Equal(t, otherDigest.ToSingletonSet(), missing)
+	})
+
+	t.Run("AllFiles", func(t *testing.T) {
+		// Without any prefixes, all files should be returned.
+		digests, err := blobstore.GetZIPFileDigests(
+			blobstore.FilterZIPFilesByHashPrefix(zipReader.File, nil),
+			digest.MustNewInstanceName("example"))
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			digest.NewSetBuilder().
+				Add(digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)).
+				Add(digest.MustNewDigest("example", remoteexecution.DigestFunction_SHA1, "897256b6709e1a4da9daba92b6bde39ccfccd8c1", 16384)).
+				Build(),
+			digests)
+	})
 }
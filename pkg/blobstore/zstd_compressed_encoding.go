@@ -0,0 +1,68 @@
+package blobstore
+
+import (
+	"github.com/klauspost/compress/zstd"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Entries in the File System Access Cache (FSAC) and Initial Size
+// Class Cache (ISCC) may be stored in zstd compressed form. Such
+// entries start with the same marker byte as the compact encoding of
+// PreviousExecutionStats, followed by a distinct version byte. As zero
+// is not a valid Protobuf field tag, this allows distinguishing them
+// from the regular Protobuf encoding.
+const (
+	zstdCompressedEncodingVersion = 0x02
+
+	// The maximum size of an entry after decompression, preventing
+	// small compressed entries from consuming excessive amounts of
+	// memory.
+	zstdCompressedEncodingMaximumDecompressedSizeBytes = 64 * 1024 * 1024
+)
+
+var (
+	zstdCompressedEncodingEncoder = func() *zstd.Encoder {
+		encoder, err := zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			panic(err)
+		}
+		return encoder
+	}()
+	zstdCompressedEncodingDecoder = func() *zstd.Decoder {
+		decoder, err := zstd.NewReader(
+			nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderLowmem(true),
+			zstd.WithDecoderMaxMemory(zstdCompressedEncodingMaximumDecompressedSizeBytes))
+		if err != nil {
+			panic(err)
+		}
+		return decoder
+	}()
+)
+
+// marshalZstdCompressedEncoding compresses an FSAC or ISCC entry.
+func marshalZstdCompressedEncoding(data []byte) []byte {
+	return zstdCompressedEncodingEncoder.EncodeAll(data, []byte{isccCompactEncodingMarker, zstdCompressedEncodingVersion})
+}
+
+// isZstdCompressedEncoding returns whether an FSAC or ISCC entry is
+// stored in zstd compressed form.
+func isZstdCompressedEncoding(data []byte) bool {
+	return len(data) >= 2 && data[0] == isccCompactEncodingMarker && data[1] == zstdCompressedEncodingVersion
+}
+
+// unmarshalZstdCompressedEncoding decompresses an FSAC or ISCC entry
+// that was compressed using marshalZstdCompressedEncoding().
+func unmarshalZstdCompressedEncoding(data []byte) ([]byte, error) {
+	decompressed, err := zstdCompressedEncodingDecoder.DecodeAll(data[2:], nil)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to decompress entry: %s", err)
+	}
+	if isZstdCompressedEncoding(decompressed) {
+		return nil, status.Error(codes.InvalidArgument, "Entry is compressed multiple times")
+	}
+	return decompressed, nil
+}
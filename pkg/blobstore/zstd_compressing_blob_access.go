@@ -0,0 +1,67 @@
+package blobstore
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+)
+
+type zstdCompressingBlobAccess struct {
+	BlobAccess
+	minimumSizeBytes        int
+	maximumMessageSizeBytes int
+	compressionEnabledTrie  *digest.InstanceNameTrie
+	compressionEnabled      []bool
+}
+
+// NewZstdCompressingBlobAccess creates a decorator for BlobAccess that
+// compresses entries that are written into the File System Access
+// Cache (FSAC) or Initial Size Class Cache (ISCC) using zstd. Entries
+// smaller than minimumSizeBytes are stored uncompressed, as these tend
+// to compress poorly. Entries for which compression does not yield any
+// savings are stored uncompressed as well.
+//
+// This decorator should be placed directly on top of backends that
+// store data, such as LocalBlobAccess. Decompression is performed by
+// FSACReadBufferFactory and ISCCReadBufferFactory, meaning that it is
+// transparent to callers, and that existing uncompressed entries
+// remain readable.
+//
+// Compression can be enabled or disabled for individual instance name
+// prefixes, where the longest matching prefix is used. Compression is
+// enabled for instance names that don't match any of the prefixes. As
+// the encoding of entries is detected while reading, entries written
+// for an instance name remain readable if its policy is changed.
+func NewZstdCompressingBlobAccess(base BlobAccess, minimumSizeBytes, maximumMessageSizeBytes int, compressionEnabled map[digest.InstanceName]bool) BlobAccess {
+	ba := &zstdCompressingBlobAccess{
+		BlobAccess:              base,
+		minimumSizeBytes:        minimumSizeBytes,
+		maximumMessageSizeBytes: maximumMessageSizeBytes,
+		compressionEnabledTrie:  digest.NewInstanceNameTrie(),
+		compressionEnabled:      make([]bool, 0, len(compressionEnabled)),
+	}
+	for instanceName, enabled := range compressionEnabled {
+		ba.compressionEnabledTrie.Set(instanceName, len(ba.compressionEnabled))
+		ba.compressionEnabled = append(ba.compressionEnabled, enabled)
+	}
+	return ba
+}
+
+func (ba *zstdCompressingBlobAccess) isCompressionEnabled(instanceName digest.InstanceName) bool {
+	idx := ba.compressionEnabledTrie.GetLongestPrefix(instanceName)
+	return idx < 0 || ba.compressionEnabled[idx]
+}
+
+func (ba *zstdCompressingBlobAccess) Put(ctx context.Context, digest digest.Digest, b buffer.Buffer) error {
+	data, err := b.ToByteSlice(ba.maximumMessageSizeBytes)
+	if err != nil {
+		return err
+	}
+	if len(data) >= ba.minimumSizeBytes && ba.isCompressionEnabled(digest.GetInstanceName()) {
+		if compressed := marshalZstdCompressedEncoding(data); len(compressed) < len(data) {
+			data = compressed
+		}
+	}
+	return ba.BlobAccess.Put(ctx, digest, buffer.NewValidatedBufferFromByteSlice(data))
+}
@@ -0,0 +1,161 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/blobstore/buffer"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/proto/fsac"
+	"github.com/buildbarn/bb-storage/pkg/proto/iscc"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestZstdCompressingBlobAccess(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseBlobAccess := mock.NewMockBlobAccess(ctrl)
+	blobAccess := blobstore.NewZstdCompressingBlobAccess(baseBlobAccess, 100, 1<<20, nil)
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "d41d8cd98f00b204e9800998ecf8427e", 123)
+
+	// Capture the data that is written into the backend, so that it
+	// can be decoded using the read buffer factories.
+	expectPut := func() *[]byte {
+		var stored []byte
+		baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+				data, err := b.ToByteSlice(1 << 20)
+				require.NoError(t, err)
+				stored = data
+				return nil
+			})
+		return &stored
+	}
+	dataIntegrityCallback := func(dataIsValid bool) { require.True(t, dataIsValid) }
+
+	t.Run("FSACRoundTrip", func(t *testing.T) {
+		// Bloom filters containing few entries compress well.
+		profile := &fsac.FileSystemAccessProfile{
+			BloomFilter:              append(bytes.Repeat([]byte{0x00}, 1000), 0x80),
+			BloomFilterHashFunctions: 7,
+		}
+		stored := expectPut()
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(profile, buffer.UserProvided)))
+
+		protoData, err := proto.Marshal(profile)
+		require.NoError(t, err)
+		require.Less(t, len(*stored), len(protoData))
+
+		m, err := blobstore.FSACReadBufferFactory.NewBufferFromByteSlice(blobDigest, *stored, dataIntegrityCallback).
+			ToProto(&fsac.FileSystemAccessProfile{}, 1<<20)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, profile, m)
+	})
+
+	t.Run("ISCCRoundTrip", func(t *testing.T) {
+		// Compression should also be applicable on top of the
+		// compact encoding.
+		stats := &iscc.PreviousExecutionStats{
+			SizeClasses: map[uint32]*iscc.PerSizeClassStats{
+				1: {InitialPageRankProbability: 0.5},
+			},
+		}
+		for i := 0; i < 100; i++ {
+			stats.SizeClasses[1].PreviousExecutions = append(
+				stats.SizeClasses[1].PreviousExecutions,
+				&iscc.PreviousExecution{Outcome: &iscc.PreviousExecution_Succeeded{Succeeded: durationpb.New(time.Second)}})
+		}
+		compactData, err := blobstore.MarshalCompactPreviousExecutionStats(stats)
+		require.NoError(t, err)
+		stored := expectPut()
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewValidatedBufferFromByteSlice(compactData)))
+		require.Less(t, len(*stored), len(compactData))
+
+		m, err := blobstore.ISCCReadBufferFactory.NewBufferFromByteSlice(blobDigest, *stored, dataIntegrityCallback).
+			ToProto(&iscc.PreviousExecutionStats{}, 1<<20)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, stats, m)
+	})
+
+	t.Run("BelowThreshold", func(t *testing.T) {
+		// Small entries should be stored uncompressed, using
+		// the regular Protobuf encoding.
+		profile := &fsac.FileSystemAccessProfile{
+			BloomFilter:              []byte{0x00, 0x00, 0x00, 0x80},
+			BloomFilterHashFunctions: 7,
+		}
+		stored := expectPut()
+		require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(profile, buffer.UserProvided)))
+
+		protoData, err := proto.Marshal(profile)
+		require.NoError(t, err)
+		require.Equal(t, protoData, *stored)
+
+		m, err := blobstore.FSACReadBufferFactory.NewBufferFromByteSlice(blobDigest, *stored, dataIntegrityCallback).
+			ToProto(&fsac.FileSystemAccessProfile{}, 1<<20)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, profile, m)
+	})
+
+	t.Run("PerInstanceNamePolicy", func(t *testing.T) {
+		// Compression may be disabled for some instance names,
+		// while remaining enabled for others. Entries should be
+		// readable in both cases.
+		blobAccess := blobstore.NewZstdCompressingBlobAccess(baseBlobAccess, 100, 1<<20, map[digest.InstanceName]bool{
+			digest.MustNewInstanceName("binary"):      false,
+			digest.MustNewInstanceName("binary/text"): true,
+		})
+		profile := &fsac.FileSystemAccessProfile{
+			BloomFilter:              append(bytes.Repeat([]byte{0x00}, 1000), 0x80),
+			BloomFilterHashFunctions: 7,
+		}
+		protoData, err := proto.Marshal(profile)
+		require.NoError(t, err)
+
+		for _, testCase := range []struct {
+			instanceName string
+			compressed   bool
+		}{
+			{"binary", false},
+			{"binary/other", false},
+			{"binary/text", true},
+			{"binary/text/other", true},
+			{"other", true},
+		} {
+			t.Run(testCase.instanceName, func(t *testing.T) {
+				blobDigest := digest.MustNewDigest(testCase.instanceName, remoteexecution.DigestFunction_MD5, "d41d8cd98f00b204e9800998ecf8427e", 123)
+				var stored []byte
+				baseBlobAccess.EXPECT().Put(ctx, blobDigest, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, blobDigest digest.Digest, b buffer.Buffer) error {
+						data, err := b.ToByteSlice(1 << 20)
+						require.NoError(t, err)
+						stored = data
+						return nil
+					})
+				require.NoError(t, blobAccess.Put(ctx, blobDigest, buffer.NewProtoBufferFromProto(profile, buffer.UserProvided)))
+
+				if testCase.compressed {
+					require.Less(t, len(stored), len(protoData))
+				} else {
+					require.Equal(t, protoData, stored)
+				}
+
+				m, err := blobstore.FSACReadBufferFactory.NewBufferFromByteSlice(blobDigest, stored, dataIntegrityCallback).
+					ToProto(&fsac.FileSystemAccessProfile{}, 1<<20)
+				require.NoError(t, err)
+				testutil.RequireEqualProto(t, profile, m)
+			})
+		}
+	})
+}
@@ -29,7 +29,7 @@ type authorizingBuildQueue struct {
 }
 
 func (bq *authorizingBuildQueue) Execute(request *remoteexecution.ExecuteRequest, server remoteexecution.Execution_ExecuteServer) error {
-	instanceName, err := digest.NewInstanceName(request.InstanceName)
+	instanceName, err := digest.NewNormalizedInstanceName(request.InstanceName)
 	if err != nil {
 		return util.StatusWrapf(err, "Invalid instance name %#v", request.InstanceName)
 	}
@@ -0,0 +1,87 @@
+package capabilities_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/auth"
+	"github.com/buildbarn/bb-storage/pkg/blobstore"
+	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizingProvider(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseProvider := mock.NewMockCapabilitiesProvider(ctrl)
+	authorizer := mock.NewMockAuthorizer(ctrl)
+	provider := capabilities.NewAuthorizingProvider(baseProvider, authorizer)
+	instanceName := digest.MustNewInstanceName("hello")
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{instanceName}).
+			Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+
+		_, err := provider.GetCapabilities(ctx, instanceName)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		authorizer.EXPECT().Authorize(ctx, []digest.InstanceName{instanceName}).Return([]error{nil})
+		baseProvider.EXPECT().GetCapabilities(ctx, instanceName).Return(&remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{},
+		}, nil)
+
+		serverCapabilities, err := provider.GetCapabilities(ctx, instanceName)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{},
+		}, serverCapabilities)
+	})
+}
+
+func TestAuthorizingProviderSeparateFromData(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	// Capabilities may be protected by an authorizer that differs
+	// from the one used for data operations. This permits load
+	// balancer probes to call GetCapabilities() without having
+	// access to any of the data.
+	backend := mock.NewMockBlobAccess(ctrl)
+	dataAuthorizer := mock.NewMockAuthorizer(ctrl)
+	probeAuthorizer := auth.NewStaticAuthorizer(func(digest.InstanceName) bool { return true })
+	blobAccess := blobstore.NewAuthorizingBlobAccess(backend, dataAuthorizer, dataAuthorizer, dataAuthorizer)
+	provider := capabilities.NewAuthorizingProvider(backend, probeAuthorizer)
+
+	instanceName := digest.MustNewInstanceName("hello")
+	blobDigest := digest.MustNewDigest("hello", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5)
+
+	t.Run("Capabilities", func(t *testing.T) {
+		backend.EXPECT().GetCapabilities(ctx, instanceName).Return(&remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{},
+		}, nil)
+
+		serverCapabilities, err := provider.GetCapabilities(ctx, instanceName)
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{},
+		}, serverCapabilities)
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		dataAuthorizer.EXPECT().AuthorizeDigestFunctions(ctx, []digest.Function{blobDigest.GetDigestFunction()}).
+			Return([]error{status.Error(codes.PermissionDenied, "You shall not pass")})
+
+		_, err := blobAccess.Get(ctx, blobDigest).ToByteSlice(100)
+		testutil.RequireEqualStatus(t, status.Error(codes.PermissionDenied, "Authorization: You shall not pass"), err)
+	})
+}
@@ -0,0 +1,47 @@
+package capabilities
+
+import (
+	"context"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type maxBatchTotalSizeSettingProvider struct {
+	base                       Provider
+	maximumMessageSizeResolver *digest.MaximumMessageSizeResolver
+}
+
+// NewMaxBatchTotalSizeSettingProvider creates a decorator for a
+// capabilities provider that sets the
+// CacheCapabilities.max_batch_total_size_bytes field to the maximum
+// message size that is configured for the instance name. This ensures
+// that clients don't send batches that exceed the limits enforced by
+// the Content Addressable Storage server.
+//
+// For instance names for which no limit is configured explicitly, the
+// capabilities of the base provider are returned unmodified.
+func NewMaxBatchTotalSizeSettingProvider(base Provider, maximumMessageSizeResolver *digest.MaximumMessageSizeResolver) Provider {
+	return &maxBatchTotalSizeSettingProvider{
+		base:                       base,
+		maximumMessageSizeResolver: maximumMessageSizeResolver,
+	}
+}
+
+func (p *maxBatchTotalSizeSettingProvider) GetCapabilities(ctx context.Context, instanceName digest.InstanceName) (*remoteexecution.ServerCapabilities, error) {
+	serverCapabilities, err := p.base.GetCapabilities(ctx, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	maximumMessageSizeBytes, ok := p.maximumMessageSizeResolver.GetConfiguredMaximumMessageSizeBytes(instanceName)
+	if !ok || serverCapabilities.CacheCapabilities == nil {
+		return serverCapabilities, nil
+	}
+
+	var copiedCapabilities remoteexecution.ServerCapabilities
+	proto.Merge(&copiedCapabilities, serverCapabilities)
+	copiedCapabilities.CacheCapabilities.MaxBatchTotalSizeBytes = maximumMessageSizeBytes
+	return &copiedCapabilities, nil
+}
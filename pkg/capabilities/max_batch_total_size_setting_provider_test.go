@@ -0,0 +1,81 @@
+package capabilities_test
+
+import (
+	"context"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/capabilities"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestMaxBatchTotalSizeSettingProvider(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseProvider := mock.NewMockCapabilitiesProvider(ctrl)
+	maximumMessageSizeResolver, err := digest.NewMaximumMessageSizeResolver(1<<20, map[string]int64{
+		"small": 1000,
+		"large": 1 << 24,
+	})
+	require.NoError(t, err)
+	provider := capabilities.NewMaxBatchTotalSizeSettingProvider(baseProvider, maximumMessageSizeResolver)
+
+	t.Run("NoCacheCapabilities", func(t *testing.T) {
+		baseProvider.EXPECT().GetCapabilities(ctx, digest.MustNewInstanceName("small")).
+			Return(&remoteexecution.ServerCapabilities{}, nil)
+
+		response, err := provider.GetCapabilities(ctx, digest.MustNewInstanceName("small"))
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.ServerCapabilities{}, response)
+	})
+
+	t.Run("PerInstanceName", func(t *testing.T) {
+		// The limit that is announced should correspond to the
+		// one of the instance name.
+		for instanceName, expectedLimit := range map[string]int64{
+			"small":     1000,
+			"small/foo": 1000,
+			"large":     1 << 24,
+		} {
+			baseProvider.EXPECT().GetCapabilities(ctx, digest.MustNewInstanceName(instanceName)).
+				Return(&remoteexecution.ServerCapabilities{
+					CacheCapabilities: &remoteexecution.CacheCapabilities{
+						DigestFunctions: digest.SupportedDigestFunctions,
+					},
+				}, nil)
+
+			response, err := provider.GetCapabilities(ctx, digest.MustNewInstanceName(instanceName))
+			require.NoError(t, err)
+			testutil.RequireEqualProto(t, &remoteexecution.ServerCapabilities{
+				CacheCapabilities: &remoteexecution.CacheCapabilities{
+					DigestFunctions:        digest.SupportedDigestFunctions,
+					MaxBatchTotalSizeBytes: expectedLimit,
+				},
+			}, response)
+		}
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		// No limit should be announced for instance names for
+		// which no limit is configured explicitly.
+		baseProvider.EXPECT().GetCapabilities(ctx, digest.MustNewInstanceName("other")).
+			Return(&remoteexecution.ServerCapabilities{
+				CacheCapabilities: &remoteexecution.CacheCapabilities{
+					DigestFunctions: digest.SupportedDigestFunctions,
+				},
+			}, nil)
+
+		response, err := provider.GetCapabilities(ctx, digest.MustNewInstanceName("other"))
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, &remoteexecution.ServerCapabilities{
+			CacheCapabilities: &remoteexecution.CacheCapabilities{
+				DigestFunctions: digest.SupportedDigestFunctions,
+			},
+		}, response)
+	})
+}
@@ -11,7 +11,11 @@ import (
 // message. The resulting client options object can be used to access
 // GCP services such as GCS.
 func NewClientOptionsFromConfiguration(configuration *gcp_pb.ClientOptionsConfiguration, name string) ([]option.ClientOption, error) {
-	// TODO: Are there any client options which we want to support?
-	// https://pkg.go.dev/google.golang.org/api/option
-	return nil, nil
+	// TODO: Are there any other client options which we want to
+	// support? https://pkg.go.dev/google.golang.org/api/option
+	var clientOptions []option.ClientOption
+	if userAgent := configuration.GetUserAgent(); userAgent != "" {
+		clientOptions = append(clientOptions, option.WithUserAgent(userAgent))
+	}
+	return clientOptions, nil
 }
@@ -0,0 +1,51 @@
+package gcp_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/buildbarn/bb-storage/pkg/cloud/gcp"
+	gcp_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/cloud/gcp"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/api/option"
+)
+
+func TestNewClientOptionsFromConfigurationUserAgent(t *testing.T) {
+	// Requests sent to GCS should contain the configured
+	// User-Agent.
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.Write([]byte("Hello"))
+	}))
+	defer server.Close()
+
+	clientOptions, err := gcp.NewClientOptionsFromConfiguration(&gcp_pb.ClientOptionsConfiguration{
+		UserAgent: "buildbarn-storage-eu",
+	}, "GCS")
+	require.NoError(t, err)
+
+	client, err := storage.NewClient(
+		context.Background(),
+		append(
+			clientOptions,
+			option.WithEndpoint(server.URL+"/storage/v1/"),
+			option.WithoutAuthentication())...)
+	require.NoError(t, err)
+	defer client.Close()
+
+	r, err := gcp.NewWrappedStorageClient(client).Bucket("bucket").Object("object").NewRangeReader(context.Background(), 0, gcp.ReadUntilEOF)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, []byte("Hello"), data)
+
+	require.True(t, strings.HasPrefix(userAgent, "buildbarn-storage-eu"), userAgent)
+}
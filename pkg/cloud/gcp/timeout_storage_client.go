@@ -0,0 +1,104 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+type timeoutStorageClient struct {
+	base             StorageClient
+	operationTimeout time.Duration
+	maximumAttempts  int
+}
+
+// NewTimeoutStorageClient creates a decorator for StorageClient that
+// bounds the duration of every operation performed against Google
+// Cloud Storage. Without it, operations only terminate when the
+// caller's context is canceled, meaning that stalls on the GCS side may
+// cause requests to hang indefinitely.
+//
+// Operations that fail due to the timeout being reached or due to
+// transient errors returned by GCS are retried, up to maximumAttempts
+// attempts in total. As the timeout also applies to reading the
+// object's contents, it should be chosen such that the largest objects
+// can be downloaded within it.
+func NewTimeoutStorageClient(base StorageClient, operationTimeout time.Duration, maximumAttempts int) StorageClient {
+	return &timeoutStorageClient{
+		base:             base,
+		operationTimeout: operationTimeout,
+		maximumAttempts:  maximumAttempts,
+	}
+}
+
+func (c *timeoutStorageClient) Bucket(name string) StorageBucketHandle {
+	return &timeoutStorageBucketHandle{
+		client: c,
+		base:   c.base.Bucket(name),
+	}
+}
+
+type timeoutStorageBucketHandle struct {
+	client *timeoutStorageClient
+	base   StorageBucketHandle
+}
+
+func (h *timeoutStorageBucketHandle) Object(name string) StorageObjectHandle {
+	return &timeoutStorageObjectHandle{
+		client: h.client,
+		base:   h.base.Object(name),
+	}
+}
+
+type timeoutStorageObjectHandle struct {
+	client *timeoutStorageClient
+	base   StorageObjectHandle
+}
+
+// isRetriableError returns true if an error returned by GCS is likely
+// transient, meaning the operation may be retried.
+func isRetriableError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusTooManyRequests || apiErr.Code >= http.StatusInternalServerError
+	}
+	return false
+}
+
+func (h *timeoutStorageObjectHandle) NewRangeReader(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	for attempt := 1; ; attempt++ {
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, h.client.operationTimeout)
+		r, err := h.base.NewRangeReader(ctxWithTimeout, offset, length)
+		if err == nil {
+			// The timeout also applies to reading the object's
+			// contents, so only release it when the reader is
+			// closed.
+			return &timeoutReadCloser{
+				ReadCloser: r,
+				cancel:     cancel,
+			}, nil
+		}
+		cancel()
+		if attempt >= h.client.maximumAttempts || ctx.Err() != nil || !isRetriableError(err) {
+			return nil, err
+		}
+	}
+}
+
+type timeoutReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *timeoutReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
@@ -0,0 +1,108 @@
+package gcp_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/cloud/gcp"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/api/googleapi"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestTimeoutStorageClient(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	baseClient := mock.NewMockStorageClient(ctrl)
+	client := gcp.NewTimeoutStorageClient(baseClient, time.Minute, 3)
+
+	newObjectHandle := func() *mock.MockStorageObjectHandle {
+		bucketHandle := mock.NewMockStorageBucketHandle(ctrl)
+		baseClient.EXPECT().Bucket("bucket").Return(bucketHandle)
+		objectHandle := mock.NewMockStorageObjectHandle(ctrl)
+		bucketHandle.EXPECT().Object("object").Return(objectHandle)
+		return objectHandle
+	}
+	requireDeadline := func(ctx context.Context) {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		require.WithinDuration(t, time.Now().Add(time.Minute), deadline, 10*time.Second)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		// The context provided to GCS should have a deadline,
+		// which should remain valid until the reader is closed.
+		objectHandle := newObjectHandle()
+		var readerCtx context.Context
+		objectHandle.EXPECT().NewRangeReader(gomock.Any(), int64(3), int64(5)).DoAndReturn(
+			func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+				requireDeadline(ctx)
+				readerCtx = ctx
+				return io.NopCloser(strings.NewReader("Hello")), nil
+			})
+
+		r, err := client.Bucket("bucket").Object("object").NewRangeReader(ctx, 3, 5)
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, []byte("Hello"), data)
+		require.NoError(t, readerCtx.Err())
+
+		require.NoError(t, r.Close())
+		require.Equal(t, context.Canceled, readerCtx.Err())
+	})
+
+	t.Run("RetryTransientFailures", func(t *testing.T) {
+		// Timeouts and server-side errors should be retried.
+		objectHandle := newObjectHandle()
+		gomock.InOrder(
+			objectHandle.EXPECT().NewRangeReader(gomock.Any(), int64(0), gcp.ReadUntilEOF).DoAndReturn(
+				func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+					requireDeadline(ctx)
+					return nil, context.DeadlineExceeded
+				}),
+			objectHandle.EXPECT().NewRangeReader(gomock.Any(), int64(0), gcp.ReadUntilEOF).DoAndReturn(
+				func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+					requireDeadline(ctx)
+					return nil, &googleapi.Error{Code: http.StatusServiceUnavailable}
+				}),
+			objectHandle.EXPECT().NewRangeReader(gomock.Any(), int64(0), gcp.ReadUntilEOF).DoAndReturn(
+				func(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+					requireDeadline(ctx)
+					return io.NopCloser(strings.NewReader("Hello")), nil
+				}))
+
+		r, err := client.Bucket("bucket").Object("object").NewRangeReader(ctx, 0, gcp.ReadUntilEOF)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("MaximumAttemptsExceeded", func(t *testing.T) {
+		// The error of the last attempt should be returned.
+		objectHandle := newObjectHandle()
+		objectHandle.EXPECT().NewRangeReader(gomock.Any(), int64(0), gcp.ReadUntilEOF).
+			Return(nil, &googleapi.Error{Code: http.StatusInternalServerError}).
+			Times(3)
+
+		_, err := client.Bucket("bucket").Object("object").NewRangeReader(ctx, 0, gcp.ReadUntilEOF)
+		require.Equal(t, &googleapi.Error{Code: http.StatusInternalServerError}, err)
+	})
+
+	t.Run("NonRetriableFailure", func(t *testing.T) {
+		// Errors that are not transient should be returned
+		// immediately.
+		objectHandle := newObjectHandle()
+		objectHandle.EXPECT().NewRangeReader(gomock.Any(), int64(0), gcp.ReadUntilEOF).
+			Return(nil, &googleapi.Error{Code: http.StatusForbidden})
+
+		_, err := client.Bucket("bucket").Object("object").NewRangeReader(ctx, 0, gcp.ReadUntilEOF)
+		require.Equal(t, &googleapi.Error{Code: http.StatusForbidden}, err)
+	})
+}
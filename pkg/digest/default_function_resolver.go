@@ -0,0 +1,134 @@
+package digest
+
+import (
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultFunctionResolver determines which digest function should be
+// used for requests in which clients don't explicitly specify one.
+// Defaults can be configured on a per instance name prefix basis. If
+// no default is configured for an instance name, the digest function
+// is inferred from the hash length, as described in REv2.
+//
+// As multiple digest functions may have the same hash length (e.g.,
+// SHA256 and SHA256TREE), inference may be ambiguous. Inference
+// overrides can be configured on a per instance name prefix basis to
+// specify which digest function should be inferred for a given hash
+// length.
+//
+// A nil DefaultFunctionResolver may be used to disable the use of
+// defaults entirely.
+type DefaultFunctionResolver struct {
+	trie          *InstanceNameTrie
+	bareFunctions []*bareFunction
+
+	inferenceTrie      *InstanceNameTrie
+	inferenceOverrides []map[int]*bareFunction
+}
+
+// NewDefaultFunctionResolver creates a DefaultFunctionResolver that
+// uses the provided digest functions as defaults. The keys of the maps
+// correspond to instance name prefixes. In case of multiple matches,
+// the entry with the longest matching prefix is used.
+//
+// Inference overrides are only consulted for instance names for which
+// no default is configured. Each digest function that is provided
+// overrides the digest function that is inferred for hashes of its
+// length.
+func NewDefaultFunctionResolver(defaults map[string]remoteexecution.DigestFunction_Value, inferenceOverrides map[string][]remoteexecution.DigestFunction_Value) (*DefaultFunctionResolver, error) {
+	r := &DefaultFunctionResolver{
+		trie:          NewInstanceNameTrie(),
+		inferenceTrie: NewInstanceNameTrie(),
+	}
+	for instanceNamePrefixStr, digestFunction := range defaults {
+		instanceNamePrefix, err := NewInstanceName(instanceNamePrefixStr)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid instance name prefix %#v", instanceNamePrefixStr)
+		}
+		if digestFunction == remoteexecution.DigestFunction_UNKNOWN {
+			return nil, status.Errorf(codes.InvalidArgument, "No default digest function provided for instance name prefix %#v", instanceNamePrefixStr)
+		}
+		bareFunction := getBareFunction(digestFunction, 0)
+		if bareFunction == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Unsupported default digest function %s for instance name prefix %#v", digestFunction, instanceNamePrefixStr)
+		}
+		r.trie.Set(instanceNamePrefix, len(r.bareFunctions))
+		r.bareFunctions = append(r.bareFunctions, bareFunction)
+	}
+	for instanceNamePrefixStr, digestFunctions := range inferenceOverrides {
+		instanceNamePrefix, err := NewInstanceName(instanceNamePrefixStr)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid instance name prefix %#v", instanceNamePrefixStr)
+		}
+		bareFunctionsByHashLength := map[int]*bareFunction{}
+		for _, digestFunction := range digestFunctions {
+			if digestFunction == remoteexecution.DigestFunction_UNKNOWN {
+				return nil, status.Errorf(codes.InvalidArgument, "No inference override digest function provided for instance name prefix %#v", instanceNamePrefixStr)
+			}
+			bareFunction := getBareFunction(digestFunction, 0)
+			if bareFunction == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Unsupported inference override digest function %s for instance name prefix %#v", digestFunction, instanceNamePrefixStr)
+			}
+			hashLength := bareFunction.hashBytesSize * 2
+			if existing, ok := bareFunctionsByHashLength[hashLength]; ok {
+				return nil, status.Errorf(codes.InvalidArgument, "Inference override digest functions %s and %s for instance name prefix %#v have the same hash length", existing.enumValue, digestFunction, instanceNamePrefixStr)
+			}
+			bareFunctionsByHashLength[hashLength] = bareFunction
+		}
+		r.inferenceTrie.Set(instanceNamePrefix, len(r.inferenceOverrides))
+		r.inferenceOverrides = append(r.inferenceOverrides, bareFunctionsByHashLength)
+	}
+	return r, nil
+}
+
+// getDefaultBareFunction returns the digest function to use for an
+// instance name if the client did not specify one explicitly. Nil is
+// returned if the digest function should be inferred from the hash
+// length as usual.
+func (r *DefaultFunctionResolver) getDefaultBareFunction(instanceName InstanceName, hashLength int) *bareFunction {
+	if r == nil {
+		return nil
+	}
+	if idx := r.trie.GetLongestPrefix(instanceName); idx >= 0 {
+		return r.bareFunctions[idx]
+	}
+	if idx := r.inferenceTrie.GetLongestPrefix(instanceName); idx >= 0 {
+		return r.inferenceOverrides[idx][hashLength]
+	}
+	return nil
+}
+
+// GetDigestFunction is identical to InstanceName.GetDigestFunction(),
+// except that the configured default digest function is used if
+// digestFunction is set to UNKNOWN.
+func (r *DefaultFunctionResolver) GetDigestFunction(instanceName InstanceName, digestFunction remoteexecution.DigestFunction_Value, fallbackHashLength int) (Function, error) {
+	if digestFunction == remoteexecution.DigestFunction_UNKNOWN {
+		if bareFunction := r.getDefaultBareFunction(instanceName, fallbackHashLength); bareFunction != nil {
+			return Function{
+				instanceName: instanceName,
+				bareFunction: bareFunction,
+			}, nil
+		}
+	}
+	return instanceName.GetDigestFunction(digestFunction, fallbackHashLength)
+}
+
+// NewDigestFromByteStreamReadPath is identical to the function of the
+// same name declared at the package level, except that the configured
+// default digest function is used if the path does not contain the name
+// of a digest function.
+func (r *DefaultFunctionResolver) NewDigestFromByteStreamReadPath(path string) (Digest, remoteexecution.Compressor_Value, error) {
+	return newDigestFromByteStreamReadPath(path, r)
+}
+
+// NewDigestFromByteStreamWritePath is identical to the function of the
+// same name declared at the package level, except that the configured
+// default digest function is used if the path does not contain the name
+// of a digest function.
+func (r *DefaultFunctionResolver) NewDigestFromByteStreamWritePath(path string) (Digest, remoteexecution.Compressor_Value, error) {
+	return newDigestFromByteStreamWritePath(path, r)
+}
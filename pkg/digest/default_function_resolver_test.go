@@ -0,0 +1,145 @@
+package digest_test
+
+import (
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDefaultFunctionResolver(t *testing.T) {
+	r, err := digest.NewDefaultFunctionResolver(map[string]remoteexecution.DigestFunction_Value{
+		"tree":          remoteexecution.DigestFunction_SHA256TREE,
+		"tree/legacy":   remoteexecution.DigestFunction_SHA256,
+		"tree/legacy/x": remoteexecution.DigestFunction_SHA1,
+	}, nil)
+	require.NoError(t, err)
+
+	t.Run("InvalidConfiguration", func(t *testing.T) {
+		_, err := digest.NewDefaultFunctionResolver(map[string]remoteexecution.DigestFunction_Value{
+			"hello": remoteexecution.DigestFunction_UNKNOWN,
+		}, nil)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "No default digest function provided for instance name prefix \"hello\""), err)
+	})
+
+	t.Run("ExplicitFunction", func(t *testing.T) {
+		// Explicitly provided digest functions should always be
+		// honored, even if a default is configured.
+		digestFunction, err := r.GetDigestFunction(digest.MustNewInstanceName("tree"), remoteexecution.DigestFunction_SHA256, 64)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256, digestFunction.GetEnumValue())
+
+		d, _, err := r.NewDigestFromByteStreamReadPath("tree/blobs/sha256tree/185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5")
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewDigest("tree", remoteexecution.DigestFunction_SHA256TREE, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5), d)
+	})
+
+	t.Run("DefaultFunction", func(t *testing.T) {
+		// If the digest function is omitted, the default
+		// belonging to the longest matching instance name
+		// prefix should be used.
+		digestFunction, err := r.GetDigestFunction(digest.MustNewInstanceName("tree/a"), remoteexecution.DigestFunction_UNKNOWN, 64)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256TREE, digestFunction.GetEnumValue())
+		require.Equal(t, digest.MustNewInstanceName("tree/a"), digestFunction.GetInstanceName())
+
+		digestFunction, err = r.GetDigestFunction(digest.MustNewInstanceName("tree/legacy/a"), remoteexecution.DigestFunction_UNKNOWN, 40)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256, digestFunction.GetEnumValue())
+
+		d, _, err := r.NewDigestFromByteStreamReadPath("tree/blobs/185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5")
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewDigest("tree", remoteexecution.DigestFunction_SHA256TREE, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5), d)
+
+		d, _, err = r.NewDigestFromByteStreamWritePath("tree/uploads/3aa7b10e-6ab3-4d9a-8f3d-e1dd4dc1c1c5/blobs/185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5")
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewDigest("tree", remoteexecution.DigestFunction_SHA256TREE, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5), d)
+	})
+
+	t.Run("InferenceFallback", func(t *testing.T) {
+		// For instance names without a default, the digest
+		// function should be inferred from the hash length.
+		digestFunction, err := r.GetDigestFunction(digest.MustNewInstanceName("other"), remoteexecution.DigestFunction_UNKNOWN, 32)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_MD5, digestFunction.GetEnumValue())
+
+		d, _, err := r.NewDigestFromByteStreamReadPath("other/blobs/185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5")
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewDigest("other", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5), d)
+
+		// The same applies when no defaults are configured at all.
+		var noDefaults *digest.DefaultFunctionResolver
+		digestFunction, err = noDefaults.GetDigestFunction(digest.MustNewInstanceName("tree"), remoteexecution.DigestFunction_UNKNOWN, 64)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256, digestFunction.GetEnumValue())
+	})
+}
+
+func TestDefaultFunctionResolverInferenceOverrides(t *testing.T) {
+	r, err := digest.NewDefaultFunctionResolver(
+		map[string]remoteexecution.DigestFunction_Value{
+			"pinned": remoteexecution.DigestFunction_SHA1,
+		},
+		map[string][]remoteexecution.DigestFunction_Value{
+			"":       {remoteexecution.DigestFunction_SHA256TREE},
+			"pinned": {remoteexecution.DigestFunction_SHA256TREE},
+			"legacy": {},
+		})
+	require.NoError(t, err)
+
+	t.Run("InvalidConfiguration", func(t *testing.T) {
+		_, err := digest.NewDefaultFunctionResolver(nil, map[string][]remoteexecution.DigestFunction_Value{
+			"hello": {remoteexecution.DigestFunction_SHA256, remoteexecution.DigestFunction_SHA256TREE},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Inference override digest functions SHA256 and SHA256TREE for instance name prefix \"hello\" have the same hash length"), err)
+	})
+
+	t.Run("Override", func(t *testing.T) {
+		// Hashes having the length of an overridden digest
+		// function should resolve to that function.
+		digestFunction, err := r.GetDigestFunction(digest.MustNewInstanceName("tree"), remoteexecution.DigestFunction_UNKNOWN, 64)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256TREE, digestFunction.GetEnumValue())
+
+		d, _, err := r.NewDigestFromByteStreamReadPath("tree/blobs/185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5")
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewDigest("tree", remoteexecution.DigestFunction_SHA256TREE, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5), d)
+
+		// Hashes of other lengths should still be inferred as
+		// usual.
+		digestFunction, err = r.GetDigestFunction(digest.MustNewInstanceName("tree"), remoteexecution.DigestFunction_UNKNOWN, 32)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_MD5, digestFunction.GetEnumValue())
+	})
+
+	t.Run("NoOverride", func(t *testing.T) {
+		// Instance names matching an entry without any
+		// overrides should use the default inference.
+		digestFunction, err := r.GetDigestFunction(digest.MustNewInstanceName("legacy/a"), remoteexecution.DigestFunction_UNKNOWN, 64)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256, digestFunction.GetEnumValue())
+
+		d, _, err := r.NewDigestFromByteStreamWritePath("legacy/uploads/3aa7b10e-6ab3-4d9a-8f3d-e1dd4dc1c1c5/blobs/185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969/5")
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewDigest("legacy", remoteexecution.DigestFunction_SHA256, "185f8db32271fe25f561a6fc938b2e264306ec304eda518007d1764826381969", 5), d)
+	})
+
+	t.Run("DefaultTakesPrecedence", func(t *testing.T) {
+		// Configured defaults apply regardless of the hash
+		// length, and take precedence over overrides.
+		digestFunction, err := r.GetDigestFunction(digest.MustNewInstanceName("pinned"), remoteexecution.DigestFunction_UNKNOWN, 64)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA1, digestFunction.GetEnumValue())
+	})
+
+	t.Run("ExplicitFunction", func(t *testing.T) {
+		digestFunction, err := r.GetDigestFunction(digest.MustNewInstanceName("tree"), remoteexecution.DigestFunction_SHA256, 64)
+		require.NoError(t, err)
+		require.Equal(t, remoteexecution.DigestFunction_SHA256, digestFunction.GetEnumValue())
+	})
+}
@@ -144,6 +144,10 @@ func MustNewDigest(instanceName string, digestFunctionEnum remoteexecution.Diges
 //
 // This notation is used to read files through the ByteStream service.
 func NewDigestFromByteStreamReadPath(path string) (Digest, remoteexecution.Compressor_Value, error) {
+	return newDigestFromByteStreamReadPath(path, nil)
+}
+
+func newDigestFromByteStreamReadPath(path string, defaultFunctions *DefaultFunctionResolver) (Digest, remoteexecution.Compressor_Value, error) {
 	fields := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
 	if len(fields) < 3 {
 		return BadDigest, remoteexecution.Compressor_IDENTITY, status.Error(codes.InvalidArgument, "Invalid resource naming scheme")
@@ -156,7 +160,7 @@ func NewDigestFromByteStreamReadPath(path string) (Digest, remoteexecution.Compr
 			return BadDigest, remoteexecution.Compressor_IDENTITY, status.Error(codes.InvalidArgument, "Invalid resource naming scheme")
 		}
 	}
-	return newDigestFromByteStreamPathCommon(fields[:split], fields[split:])
+	return newDigestFromByteStreamPathCommon(fields[:split], fields[split:], defaultFunctions)
 }
 
 // NewDigestFromByteStreamWritePath creates a Digest from a string
@@ -167,6 +171,10 @@ func NewDigestFromByteStreamReadPath(path string) (Digest, remoteexecution.Compr
 //
 // This notation is used to write files through the ByteStream service.
 func NewDigestFromByteStreamWritePath(path string) (Digest, remoteexecution.Compressor_Value, error) {
+	return newDigestFromByteStreamWritePath(path, nil)
+}
+
+func newDigestFromByteStreamWritePath(path string, defaultFunctions *DefaultFunctionResolver) (Digest, remoteexecution.Compressor_Value, error) {
 	fields := strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
 	if len(fields) < 5 {
 		return BadDigest, remoteexecution.Compressor_IDENTITY, status.Error(codes.InvalidArgument, "Invalid resource naming scheme")
@@ -182,10 +190,10 @@ func NewDigestFromByteStreamWritePath(path string) (Digest, remoteexecution.Comp
 			return BadDigest, remoteexecution.Compressor_IDENTITY, status.Error(codes.InvalidArgument, "Invalid resource naming scheme")
 		}
 	}
-	return newDigestFromByteStreamPathCommon(fields[:split], fields[split+2:])
+	return newDigestFromByteStreamPathCommon(fields[:split], fields[split+2:], defaultFunctions)
 }
 
-func newDigestFromByteStreamPathCommon(header, trailer []string) (Digest, remoteexecution.Compressor_Value, error) {
+func newDigestFromByteStreamPathCommon(header, trailer []string, defaultFunctions *DefaultFunctionResolver) (Digest, remoteexecution.Compressor_Value, error) {
 	instanceName, err := NewInstanceNameFromComponents(header)
 	if err != nil {
 		return BadDigest, remoteexecution.Compressor_IDENTITY, util.StatusWrapf(err, "Invalid instance name %#v", strings.Join(header, "/"))
@@ -210,8 +218,10 @@ func newDigestFromByteStreamPathCommon(header, trailer []string) (Digest, remote
 	if ok {
 		// Explicit digest function name provided.
 		trailer = trailer[1:]
-	} else {
-		// Infer digest function from the hash length.
+	} else if bareFunction = defaultFunctions.getDefaultBareFunction(instanceName, len(trailer[0])); bareFunction == nil {
+		// No digest function name provided, and no default or
+		// inference override is configured for this instance
+		// name. Infer the digest function from the hash length.
 		bareFunction = getBareFunction(remoteexecution.DigestFunction_UNKNOWN, len(trailer[0]))
 		if bareFunction == nil {
 			return BadDigest, remoteexecution.Compressor_IDENTITY, status.Errorf(codes.InvalidArgument, "Unsupported digest function %#v", trailer[0])
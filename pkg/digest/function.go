@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash"
+	"strings"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 
@@ -100,6 +101,28 @@ func (f Function) NewDigestFromProto(digest *remoteexecution.Digest) (Digest, er
 	return f.NewDigest(digest.Hash, digest.SizeBytes)
 }
 
+// NewDigestsFromProtos constructs Digest objects for a list of
+// protocol-level digest objects. Unlike calling NewDigestFromProto()
+// repeatedly, all digests are validated before returning. If one or
+// more digests are invalid, the error that is returned describes all
+// of them.
+func (f Function) NewDigestsFromProtos(digests []*remoteexecution.Digest) ([]Digest, error) {
+	newDigests := make([]Digest, 0, len(digests))
+	var invalidDigests []string
+	for i, digest := range digests {
+		newDigest, err := f.NewDigestFromProto(digest)
+		if err != nil {
+			invalidDigests = append(invalidDigests, fmt.Sprintf("index %d: %s", i, status.Convert(err).Message()))
+			continue
+		}
+		newDigests = append(newDigests, newDigest)
+	}
+	if len(invalidDigests) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%d out of %d digests are invalid: %s", len(invalidDigests), len(digests), strings.Join(invalidDigests, "; "))
+	}
+	return newDigests, nil
+}
+
 // Generator is a writer that may be used to compute digests of newly
 // created files.
 type Generator struct {
@@ -0,0 +1,45 @@
+package digest_test
+
+import (
+	"fmt"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"github.com/buildbarn/bb-storage/pkg/digest"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFunctionNewDigestsFromProtos(t *testing.T) {
+	digestFunction := digest.MustNewFunction("example", remoteexecution.DigestFunction_MD5)
+
+	t.Run("Success", func(t *testing.T) {
+		digests, err := digestFunction.NewDigestsFromProtos([]*remoteexecution.Digest{
+			{Hash: "8b1a9953c4611296a827abf8c47804d7", SizeBytes: 5},
+			{Hash: "6cd3556deb0da54bca060b4c39479839", SizeBytes: 13},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []digest.Digest{
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "8b1a9953c4611296a827abf8c47804d7", 5),
+			digest.MustNewDigest("example", remoteexecution.DigestFunction_MD5, "6cd3556deb0da54bca060b4c39479839", 13),
+		}, digests)
+	})
+
+	t.Run("InvalidDigests", func(t *testing.T) {
+		// All invalid digests should be reported, as opposed to
+		// only the first one.
+		protos := make([]*remoteexecution.Digest, 0, 1000)
+		for i := 0; i < 1000; i++ {
+			protos = append(protos, &remoteexecution.Digest{Hash: fmt.Sprintf("%032x", i), SizeBytes: int64(i)})
+		}
+		protos[3] = nil
+		protos[500].Hash = "8b1a9953"
+		protos[998].SizeBytes = -1
+
+		_, err := digestFunction.NewDigestsFromProtos(protos)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "3 out of 1000 digests are invalid: index 3: No digest provided; index 500: Hash has length 8, while 32 characters were expected; index 998: Invalid digest size: -1 bytes"), err)
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"io"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 
@@ -65,6 +67,33 @@ func NewInstanceName(value string) (InstanceName, error) {
 	}, nil
 }
 
+// NewNormalizedInstanceName is identical to NewInstanceName, except
+// that it canonicalizes the instance name before validating it. This
+// function should be used to parse instance names provided by clients,
+// so that equivalent spellings of the same instance name share the
+// same storage keys. The following rules are applied:
+//
+//   - Leading and trailing slashes are removed.
+//   - Sequences of multiple slashes are collapsed into a single slash.
+//   - Instance names containing invalid UTF-8 or control characters
+//     are rejected.
+//
+// These rules are identical to how instance names embedded in
+// ByteStream resource names are parsed. Instance names are
+// deliberately not converted to lowercase, as the REv2 protocol
+// treats them as case sensitive.
+func NewNormalizedInstanceName(value string) (InstanceName, error) {
+	if !utf8.ValidString(value) {
+		return InstanceName{}, status.Error(codes.InvalidArgument, "Instance name is not valid UTF-8")
+	}
+	for _, r := range value {
+		if unicode.IsControl(r) {
+			return InstanceName{}, status.Errorf(codes.InvalidArgument, "Instance name contains control character %U", r)
+		}
+	}
+	return NewInstanceNameFromComponents(strings.FieldsFunc(value, func(r rune) bool { return r == '/' }))
+}
+
 // NewInstanceNameFromComponents is identical to NewInstanceName, except
 // that it takes a series of pathname components instead of a single
 // string.
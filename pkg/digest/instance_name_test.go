@@ -40,6 +40,61 @@ func TestNewInstanceName(t *testing.T) {
 	})
 }
 
+func TestNewNormalizedInstanceName(t *testing.T) {
+	t.Run("RedundantSlashes", func(t *testing.T) {
+		for _, value := range []string{"old/cluster", "old/cluster/", "/old/cluster", "old//cluster", "//old///cluster//"} {
+			instanceName, err := digest.NewNormalizedInstanceName(value)
+			require.NoError(t, err)
+			require.Equal(t, digest.MustNewInstanceName("old/cluster"), instanceName)
+		}
+
+		instanceName, err := digest.NewNormalizedInstanceName("/")
+		require.NoError(t, err)
+		require.Equal(t, digest.EmptyInstanceName, instanceName)
+	})
+
+	t.Run("CaseSensitive", func(t *testing.T) {
+		instanceName, err := digest.NewNormalizedInstanceName("Old/Cluster/")
+		require.NoError(t, err)
+		require.Equal(t, digest.MustNewInstanceName("Old/Cluster"), instanceName)
+	})
+
+	t.Run("InvalidCharacters", func(t *testing.T) {
+		_, err := digest.NewNormalizedInstanceName("old/clu\nster")
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Instance name contains control character U+000A"), err)
+
+		_, err = digest.NewNormalizedInstanceName("old/cluster\x00")
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Instance name contains control character U+0000"), err)
+
+		_, err = digest.NewNormalizedInstanceName("old/\xffcluster")
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Instance name is not valid UTF-8"), err)
+	})
+
+	t.Run("ReservedKeyword", func(t *testing.T) {
+		_, err := digest.NewNormalizedInstanceName("keyword/blobs/is/reserved/")
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Instance name contains reserved keyword \"blobs\""), err)
+	})
+
+	t.Run("SameStorageKey", func(t *testing.T) {
+		// Equivalent instance names should yield digests that
+		// share the same storage key.
+		instanceName1, err := digest.NewNormalizedInstanceName("old/cluster")
+		require.NoError(t, err)
+		instanceName2, err := digest.NewNormalizedInstanceName("old/cluster/")
+		require.NoError(t, err)
+
+		digestFunction1, err := instanceName1.GetDigestFunction(remoteexecution.DigestFunction_MD5, 0)
+		require.NoError(t, err)
+		digest1, err := digestFunction1.NewDigest("8b1a9953c4611296a827abf8c47804d7", 5)
+		require.NoError(t, err)
+		digestFunction2, err := instanceName2.GetDigestFunction(remoteexecution.DigestFunction_MD5, 0)
+		require.NoError(t, err)
+		digest2, err := digestFunction2.NewDigest("8b1a9953c4611296a827abf8c47804d7", 5)
+		require.NoError(t, err)
+		require.Equal(t, digest1.GetKey(digest.KeyWithInstance), digest2.GetKey(digest.KeyWithInstance))
+	})
+}
+
 func TestInstanceNameGetDigestFunction(t *testing.T) {
 	instanceName := digest.MustNewInstanceName("hello")
 
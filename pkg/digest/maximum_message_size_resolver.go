@@ -0,0 +1,75 @@
+package digest
+
+import (
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaximumMessageSizeResolver determines the maximum size of messages
+// that may be exchanged for requests against a given instance name.
+// Limits can be configured on a per instance name prefix basis. If no
+// limit is configured for an instance name, a default is used.
+type MaximumMessageSizeResolver struct {
+	defaultMaximumMessageSizeBytes int64
+	trie                           *InstanceNameTrie
+	maximumMessageSizesBytes       []int64
+}
+
+// NewMaximumMessageSizeResolver creates a MaximumMessageSizeResolver
+// that uses the provided limits. The keys of the map correspond to
+// instance name prefixes. In case of multiple matches, the limit with
+// the longest matching prefix is used.
+func NewMaximumMessageSizeResolver(defaultMaximumMessageSizeBytes int64, limits map[string]int64) (*MaximumMessageSizeResolver, error) {
+	r := &MaximumMessageSizeResolver{
+		defaultMaximumMessageSizeBytes: defaultMaximumMessageSizeBytes,
+		trie:                           NewInstanceNameTrie(),
+	}
+	for instanceNamePrefixStr, maximumMessageSizeBytes := range limits {
+		instanceNamePrefix, err := NewInstanceName(instanceNamePrefixStr)
+		if err != nil {
+			return nil, util.StatusWrapf(err, "Invalid instance name prefix %#v", instanceNamePrefixStr)
+		}
+		if maximumMessageSizeBytes <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Maximum message size for instance name prefix %#v must be positive", instanceNamePrefixStr)
+		}
+		r.trie.Set(instanceNamePrefix, len(r.maximumMessageSizesBytes))
+		r.maximumMessageSizesBytes = append(r.maximumMessageSizesBytes, maximumMessageSizeBytes)
+	}
+	return r, nil
+}
+
+// GetMaximumMessageSizeBytes returns the maximum message size that
+// applies to requests against a given instance name.
+func (r *MaximumMessageSizeResolver) GetMaximumMessageSizeBytes(instanceName InstanceName) int64 {
+	if maximumMessageSizeBytes, ok := r.GetConfiguredMaximumMessageSizeBytes(instanceName); ok {
+		return maximumMessageSizeBytes
+	}
+	return r.defaultMaximumMessageSizeBytes
+}
+
+// GetConfiguredMaximumMessageSizeBytes returns the maximum message
+// size that is configured for an instance name prefix matching a given
+// instance name. Unlike GetMaximumMessageSizeBytes(), it does not fall
+// back to the default.
+func (r *MaximumMessageSizeResolver) GetConfiguredMaximumMessageSizeBytes(instanceName InstanceName) (int64, bool) {
+	if idx := r.trie.GetLongestPrefix(instanceName); idx >= 0 {
+		return r.maximumMessageSizesBytes[idx], true
+	}
+	return 0, false
+}
+
+// GetLargestMaximumMessageSizeBytes returns the largest maximum
+// message size that applies to any instance name. This value may be
+// used to configure transport level limits, such as those of the gRPC
+// server.
+func (r *MaximumMessageSizeResolver) GetLargestMaximumMessageSizeBytes() int64 {
+	largest := r.defaultMaximumMessageSizeBytes
+	for _, maximumMessageSizeBytes := range r.maximumMessageSizesBytes {
+		if largest < maximumMessageSizeBytes {
+			largest = maximumMessageSizeBytes
+		}
+	}
+	return largest
+}
@@ -2,6 +2,8 @@ package digest
 
 import (
 	"container/heap"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 )
 
 // Set of digests. Sets are immutable and can be created using
@@ -68,48 +70,68 @@ func (s Set) RemoveEmptyBlob() Set {
 // they are returned corresponds with the order in which their first
 // element was stored in the original set.
 func (s Set) PartitionByInstanceName() []Set {
+	return partitionSet(s, Digest.GetInstanceName)
+}
+
+// PartitionByDigestFunction partitions the elements stored in a set
+// into a list of sets, each containing elements of a single digest
+// function.
+//
+// All resulting sets are guaranteed to be non-empty. The order in which
+// they are returned corresponds with the order in which their first
+// element was stored in the original set.
+func (s Set) PartitionByDigestFunction() []Set {
+	return partitionSet(s, func(d Digest) remoteexecution.DigestFunction_Value {
+		return d.GetDigestFunction().GetEnumValue()
+	})
+}
+
+// partitionSet partitions the elements stored in a set into a list of
+// sets, each containing elements for which getKey returns the same
+// value.
+func partitionSet[K comparable](s Set, getKey func(Digest) K) []Set {
 	if len(s.digests) == 0 {
 		return nil
 	}
 
-	firstInstanceName := s.digests[0].GetInstanceName()
+	firstKey := getKey(s.digests[0])
 	for i := 1; i < len(s.digests); i++ {
-		if secondInstanceName := s.digests[i].GetInstanceName(); firstInstanceName != secondInstanceName {
+		if secondKey := getKey(s.digests[i]); firstKey != secondKey {
 			// Elements in the set use at least two distinct
-			// REv2 instance names.
+			// keys.
 			//
 			// Split up the entries processed thus far into
 			// two sets. Set capacities to the length, so
 			// that append() operations later on leave the
 			// original set intact.
-			digestsByInstanceName := []Set{
+			digestsByKey := []Set{
 				{digests: s.digests[:i:i]},
 				{digests: s.digests[i : i+1 : i+1]},
 			}
-			observedInstanceNames := map[InstanceName]int{
-				firstInstanceName:  0,
-				secondInstanceName: 1,
+			observedKeys := map[K]int{
+				firstKey:  0,
+				secondKey: 1,
 			}
 			for j := i + 1; j < len(s.digests); j++ {
 				digest := s.digests[j]
-				instanceName := digest.GetInstanceName()
-				if index, ok := observedInstanceNames[instanceName]; ok {
-					digestsByInstanceName[index].digests = append(digestsByInstanceName[index].digests, digest)
+				key := getKey(digest)
+				if index, ok := observedKeys[key]; ok {
+					digestsByKey[index].digests = append(digestsByKey[index].digests, digest)
 				} else {
 					// Elements in the set use three
-					// or more REv2 instance names.
-					observedInstanceNames[instanceName] = len(digestsByInstanceName)
-					digestsByInstanceName = append(digestsByInstanceName, Set{
+					// or more distinct keys.
+					observedKeys[key] = len(digestsByKey)
+					digestsByKey = append(digestsByKey, Set{
 						digests: s.digests[j : j+1 : j+1],
 					})
 				}
 			}
-			return digestsByInstanceName
+			return digestsByKey
 		}
 	}
 
-	// Common case: all elements in the set use the same REv2
-	// instance name. Return the original set without copying it.
+	// Common case: all elements in the set use the same key.
+	// Return the original set without copying it.
 	return []Set{s}
 }
 
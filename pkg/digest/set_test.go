@@ -130,6 +130,37 @@ func TestPartitionByInstanceName(t *testing.T) {
 			PartitionByInstanceName())
 }
 
+func TestPartitionByDigestFunction(t *testing.T) {
+	require.Empty(t, digest.EmptySet.PartitionByDigestFunction())
+
+	singleDigestFunction := digest.NewSetBuilder().
+		Add(digest.MustNewDigest("a", remoteexecution.DigestFunction_MD5, "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", 1)).
+		Add(digest.MustNewDigest("b", remoteexecution.DigestFunction_MD5, "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", 2)).
+		Build()
+	require.Equal(t, []digest.Set{singleDigestFunction}, singleDigestFunction.PartitionByDigestFunction())
+
+	require.Equal(
+		t, []digest.Set{
+			digest.NewSetBuilder().
+				Add(digest.MustNewDigest("a", remoteexecution.DigestFunction_SHA256, "4444444444444444444444444444444444444444444444444444444444444444", 4)).
+				Build(),
+			digest.NewSetBuilder().
+				Add(digest.MustNewDigest("a", remoteexecution.DigestFunction_SHA1, "3333333333333333333333333333333333333333", 3)).
+				Build(),
+			digest.NewSetBuilder().
+				Add(digest.MustNewDigest("a", remoteexecution.DigestFunction_MD5, "11111111111111111111111111111111", 1)).
+				Add(digest.MustNewDigest("b", remoteexecution.DigestFunction_MD5, "22222222222222222222222222222222", 2)).
+				Build(),
+		},
+		digest.NewSetBuilder().
+			Add(digest.MustNewDigest("a", remoteexecution.DigestFunction_MD5, "11111111111111111111111111111111", 1)).
+			Add(digest.MustNewDigest("b", remoteexecution.DigestFunction_MD5, "22222222222222222222222222222222", 2)).
+			Add(digest.MustNewDigest("a", remoteexecution.DigestFunction_SHA1, "3333333333333333333333333333333333333333", 3)).
+			Add(digest.MustNewDigest("a", remoteexecution.DigestFunction_SHA256, "4444444444444444444444444444444444444444444444444444444444444444", 4)).
+			Build().
+			PartitionByDigestFunction())
+}
+
 func TestGetDifferenceAndIntersection(t *testing.T) {
 	onlyA, both, onlyB := digest.GetDifferenceAndIntersection(
 		digest.NewSetBuilder().
@@ -22,6 +22,7 @@ import (
 	"github.com/buildbarn/bb-storage/pkg/program"
 	bb_prometheus "github.com/buildbarn/bb-storage/pkg/prometheus"
 	pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/global"
+	"github.com/buildbarn/bb-storage/pkg/readiness"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
@@ -64,6 +65,11 @@ func (ls *LifecycleState) MarkReadyAndWait(group program.Group) {
 	if ls.config != nil {
 		router := mux.NewRouter()
 		router.HandleFunc("/-/healthy", func(http.ResponseWriter, *http.Request) {})
+		router.HandleFunc("/-/ready", func(w http.ResponseWriter, r *http.Request) {
+			if err := readiness.Check(); err != nil {
+				http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			}
+		})
 		if ls.config.EnablePrometheus {
 			router.Handle("/metrics", promhttp.Handler())
 		}
@@ -73,6 +79,11 @@ func (ls *LifecycleState) MarkReadyAndWait(group program.Group) {
 		if httpHandler := ls.activeSpansReportingHTTPHandler; httpHandler != nil {
 			router.Handle("/active_spans", httpHandler)
 		}
+		diagnosticsHTTPHandlersLock.Lock()
+		for path, httpHandler := range diagnosticsHTTPHandlers {
+			router.Handle(path, httpHandler)
+		}
+		diagnosticsHTTPHandlersLock.Unlock()
 
 		bb_http.NewServersFromConfigurationAndServe(
 			ls.config.HttpServers,
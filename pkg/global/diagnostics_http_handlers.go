@@ -0,0 +1,32 @@
+package global
+
+import (
+	"net/http"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	diagnosticsHTTPHandlersLock sync.Mutex
+	diagnosticsHTTPHandlers     = map[string]http.Handler{}
+)
+
+// RegisterDiagnosticsHTTPHandler registers a HTTP handler that is
+// exposed by the diagnostics HTTP server under a given path. This can
+// be used by components that are constructed after ApplyConfiguration()
+// is called, such as storage backends, to expose information about
+// their internal state.
+//
+// Handlers need to be registered before MarkReadyAndWait() is called.
+func RegisterDiagnosticsHTTPHandler(path string, handler http.Handler) error {
+	diagnosticsHTTPHandlersLock.Lock()
+	defer diagnosticsHTTPHandlersLock.Unlock()
+
+	if _, ok := diagnosticsHTTPHandlers[path]; ok {
+		return status.Errorf(codes.AlreadyExists, "A diagnostics HTTP handler for path %#v has already been registered", path)
+	}
+	diagnosticsHTTPHandlers[path] = handler
+	return nil
+}
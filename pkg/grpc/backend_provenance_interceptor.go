@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/buildbarn/bb-storage/pkg/provenance"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// BackendProvenanceTrailerKey is the name of the gRPC response trailer
+// in which the backend provenance interceptors report the names of the
+// storage backends that served reads as part of an RPC. Every backend
+// is reported as a separate value.
+const BackendProvenanceTrailerKey = "backend-provenance"
+
+func getBackendProvenanceTrailer(backendProvenance *provenance.BackendProvenance) metadata.MD {
+	backends := backendProvenance.GetBackends()
+	if len(backends) == 0 {
+		return nil
+	}
+	return metadata.MD{BackendProvenanceTrailerKey: backends}
+}
+
+// NewBackendProvenanceUnaryInterceptor creates a gRPC request
+// interceptor for unary calls that returns the names of the storage
+// backends that served reads to the client as a response trailer.
+func NewBackendProvenanceUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctxWithBackendProvenance, backendProvenance := provenance.NewContextWithBackendProvenance(ctx)
+		resp, err := handler(ctxWithBackendProvenance, req)
+		if trailer := getBackendProvenanceTrailer(backendProvenance); trailer != nil {
+			grpc.SetTrailer(ctx, trailer)
+		}
+		return resp, err
+	}
+}
+
+// NewBackendProvenanceStreamInterceptor creates a gRPC request
+// interceptor for streaming calls that returns the names of the storage
+// backends that served reads to the client as a response trailer.
+func NewBackendProvenanceStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		wrappedServerStream := grpc_middleware.WrapServerStream(ss)
+		ctxWithBackendProvenance, backendProvenance := provenance.NewContextWithBackendProvenance(ss.Context())
+		wrappedServerStream.WrappedContext = ctxWithBackendProvenance
+		err := handler(srv, wrappedServerStream)
+		if trailer := getBackendProvenanceTrailer(backendProvenance); trailer != nil {
+			ss.SetTrailer(trailer)
+		}
+		return err
+	}
+}
@@ -0,0 +1,104 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/provenance"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestBackendProvenanceUnaryInterceptor(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	interceptor := bb_grpc.NewBackendProvenanceUnaryInterceptor()
+	handler := mock.NewMockUnaryHandler(ctrl)
+	req := &emptypb.Empty{}
+	resp := &emptypb.Empty{}
+
+	t.Run("NoBackends", func(t *testing.T) {
+		// If no backends served any reads, no trailer should
+		// be set.
+		serverTransportStream := mock.NewMockServerTransportStream(ctrl)
+		handler.EXPECT().Call(gomock.Any(), req).DoAndReturn(
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				require.NotNil(t, provenance.BackendProvenanceFromContext(ctx))
+				return resp, nil
+			})
+
+		gotResp, err := interceptor(grpc.NewContextWithServerTransportStream(ctx, serverTransportStream), req, nil, handler.Call)
+		require.NoError(t, err)
+		require.Equal(t, resp, gotResp)
+	})
+
+	t.Run("MultipleBackends", func(t *testing.T) {
+		// Backends that served reads should be reported in a
+		// single trailer, without any duplicates.
+		serverTransportStream := mock.NewMockServerTransportStream(ctrl)
+		handler.EXPECT().Call(gomock.Any(), req).DoAndReturn(
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				backendProvenance := provenance.BackendProvenanceFromContext(ctx)
+				backendProvenance.AddBackend("primary")
+				backendProvenance.AddBackend("secondary")
+				backendProvenance.AddBackend("primary")
+				return resp, nil
+			})
+		serverTransportStream.EXPECT().SetTrailer(metadata.MD{
+			bb_grpc.BackendProvenanceTrailerKey: []string{"primary", "secondary"},
+		})
+
+		gotResp, err := interceptor(grpc.NewContextWithServerTransportStream(ctx, serverTransportStream), req, nil, handler.Call)
+		require.NoError(t, err)
+		require.Equal(t, resp, gotResp)
+	})
+}
+
+func TestBackendProvenanceStreamInterceptor(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	interceptor := bb_grpc.NewBackendProvenanceStreamInterceptor()
+	handler := mock.NewMockStreamHandler(ctrl)
+	srv := &emptypb.Empty{}
+
+	t.Run("NoBackends", func(t *testing.T) {
+		// If no backends served any reads, no trailer should
+		// be set.
+		serverStream := mock.NewMockServerStream(ctrl)
+		serverStream.EXPECT().Context().Return(ctx).AnyTimes()
+		handler.EXPECT().Call(srv, gomock.Any()).DoAndReturn(
+			func(srv interface{}, stream grpc.ServerStream) error {
+				require.NotNil(t, provenance.BackendProvenanceFromContext(stream.Context()))
+				return nil
+			})
+
+		require.NoError(t, interceptor(srv, serverStream, nil, handler.Call))
+	})
+
+	t.Run("MultipleBackends", func(t *testing.T) {
+		// Backends that served reads should be reported in a
+		// single trailer, without any duplicates.
+		serverStream := mock.NewMockServerStream(ctrl)
+		serverStream.EXPECT().Context().Return(ctx).AnyTimes()
+		handler.EXPECT().Call(srv, gomock.Any()).DoAndReturn(
+			func(srv interface{}, stream grpc.ServerStream) error {
+				backendProvenance := provenance.BackendProvenanceFromContext(stream.Context())
+				backendProvenance.AddBackend("primary")
+				backendProvenance.AddBackend("secondary")
+				backendProvenance.AddBackend("primary")
+				return nil
+			})
+		serverStream.EXPECT().SetTrailer(metadata.MD{
+			bb_grpc.BackendProvenanceTrailerKey: []string{"primary", "secondary"},
+		})
+
+		require.NoError(t, interceptor(srv, serverStream, nil, handler.Call))
+	})
+}
@@ -3,6 +3,7 @@ package grpc
 import (
 	"context"
 	"crypto/tls"
+	"maps"
 	"math"
 	"net/http"
 	"net/url"
@@ -18,6 +19,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/security/advancedtls"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type baseClientFactory struct {
@@ -144,6 +146,10 @@ func (cf baseClientFactory) NewClientFromConfiguration(config *configuration.Cli
 		}))
 	}
 
+	if userAgent := config.UserAgent; userAgent != "" {
+		dialOptions = append(dialOptions, grpc.WithUserAgent(userAgent))
+	}
+
 	// Optional: add metadata.
 	if headers := config.AddMetadata; len(headers) > 0 {
 		var headerValues MetadataHeaderValues
@@ -189,7 +195,66 @@ func (cf baseClientFactory) NewClientFromConfiguration(config *configuration.Cli
 	}
 
 	// Optional: service config.
-	if serviceConfig := config.DefaultServiceConfig; serviceConfig != nil {
+	serviceConfig := config.DefaultServiceConfig
+	if retryBudget := config.RetryBudget; retryBudget != nil {
+		if retryBudget.MaxTokens < 1 || retryBudget.MaxTokens > 1000 {
+			return nil, status.Error(codes.InvalidArgument, "Retry budget maximum number of tokens must be in range [1, 1000]")
+		}
+		if retryBudget.TokenRatio <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Retry budget token ratio must be positive")
+		}
+		if _, ok := serviceConfig.GetFields()["retryThrottling"]; ok {
+			return nil, status.Error(codes.InvalidArgument, "Retry budget cannot be combined with a default service config that sets retryThrottling")
+		}
+		serviceConfig = &structpb.Struct{Fields: maps.Clone(serviceConfig.GetFields())}
+		if serviceConfig.Fields == nil {
+			serviceConfig.Fields = map[string]*structpb.Value{}
+		}
+		serviceConfig.Fields["retryThrottling"] = structpb.NewStructValue(&structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"maxTokens":  structpb.NewNumberValue(float64(retryBudget.MaxTokens)),
+				"tokenRatio": structpb.NewNumberValue(retryBudget.TokenRatio),
+			},
+		})
+	}
+	if outlierEjection := config.OutlierEjection; outlierEjection != nil {
+		interval := outlierEjection.Interval
+		if err := interval.CheckValid(); err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid outlier ejection interval")
+		}
+		ejectionDuration := outlierEjection.EjectionDuration
+		if err := ejectionDuration.CheckValid(); err != nil {
+			return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid outlier ejection duration")
+		}
+		fields := serviceConfig.GetFields()
+		if _, ok := fields["loadBalancingConfig"]; ok {
+			return nil, status.Error(codes.InvalidArgument, "Outlier ejection cannot be combined with a default service config that sets loadBalancingConfig")
+		}
+		if _, ok := fields["loadBalancingPolicy"]; ok {
+			return nil, status.Error(codes.InvalidArgument, "Outlier ejection cannot be combined with a default service config that sets loadBalancingPolicy")
+		}
+		serviceConfig = &structpb.Struct{Fields: maps.Clone(fields)}
+		if serviceConfig.Fields == nil {
+			serviceConfig.Fields = map[string]*structpb.Value{}
+		}
+		serviceConfig.Fields["loadBalancingConfig"] = structpb.NewListValue(&structpb.ListValue{
+			Values: []*structpb.Value{
+				structpb.NewStructValue(&structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						OutlierEjectingBalancerName: structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"interval":                   structpb.NewStringValue(interval.AsDuration().String()),
+								"failurePercentageThreshold": structpb.NewNumberValue(float64(outlierEjection.FailurePercentageThreshold)),
+								"minimumRequestVolume":       structpb.NewNumberValue(float64(outlierEjection.MinimumRequestVolume)),
+								"ejectionDuration":           structpb.NewStringValue(ejectionDuration.AsDuration().String()),
+							},
+						}),
+					},
+				}),
+			},
+		})
+	}
+	if serviceConfig != nil {
 		serviceConfigJSON, err := serviceConfig.MarshalJSON()
 		if err != nil {
 			return nil, util.StatusWrap(err, "Failed to marshal default service config")
@@ -0,0 +1,217 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// unavailableCapabilitiesServer is a Capabilities service that fails
+// every call, while keeping track of the number of calls made.
+type unavailableCapabilitiesServer struct {
+	remoteexecution.UnimplementedCapabilitiesServer
+	calls atomic.Int32
+}
+
+func (s *unavailableCapabilitiesServer) GetCapabilities(ctx context.Context, in *remoteexecution.GetCapabilitiesRequest) (*remoteexecution.ServerCapabilities, error) {
+	s.calls.Add(1)
+	return nil, status.Error(codes.Unavailable, "Server offline")
+}
+
+func TestBaseClientFactoryRetryBudget(t *testing.T) {
+	clientFactory := bb_grpc.NewBaseClientFactory(bb_grpc.BaseClientDialer, nil, nil)
+	retryServiceConfig, err := structpb.NewStruct(map[string]interface{}{
+		"methodConfig": []interface{}{
+			map[string]interface{}{
+				"name": []interface{}{
+					map[string]interface{}{
+						"service": "build.bazel.remote.execution.v2.Capabilities",
+					},
+				},
+				"retryPolicy": map[string]interface{}{
+					"maxAttempts":          5,
+					"initialBackoff":       "0.001s",
+					"maxBackoff":           "0.001s",
+					"backoffMultiplier":    1,
+					"retryableStatusCodes": []interface{}{"UNAVAILABLE"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("InvalidMaxTokens", func(t *testing.T) {
+		_, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+			Address: "unix:///nonexistent",
+			RetryBudget: &configuration.ClientRetryBudgetConfiguration{
+				MaxTokens:  0,
+				TokenRatio: 0.1,
+			},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Retry budget maximum number of tokens must be in range [1, 1000]"), err)
+	})
+
+	t.Run("InvalidTokenRatio", func(t *testing.T) {
+		_, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+			Address: "unix:///nonexistent",
+			RetryBudget: &configuration.ClientRetryBudgetConfiguration{
+				MaxTokens:  10,
+				TokenRatio: 0,
+			},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Retry budget token ratio must be positive"), err)
+	})
+
+	t.Run("ConflictingServiceConfig", func(t *testing.T) {
+		_, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+			Address: "unix:///nonexistent",
+			DefaultServiceConfig: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"retryThrottling": structpb.NewStructValue(&structpb.Struct{}),
+				},
+			},
+			RetryBudget: &configuration.ClientRetryBudgetConfiguration{
+				MaxTokens:  10,
+				TokenRatio: 0.1,
+			},
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Retry budget cannot be combined with a default service config that sets retryThrottling"), err)
+	})
+
+	t.Run("Exhaustion", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "grpc")
+		listener, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		server := grpc.NewServer()
+		capabilitiesServer := &unavailableCapabilitiesServer{}
+		remoteexecution.RegisterCapabilitiesServer(server, capabilitiesServer)
+		go server.Serve(listener)
+		defer server.Stop()
+
+		client, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+			Address:              "unix://" + path,
+			DefaultServiceConfig: retryServiceConfig,
+			RetryBudget: &configuration.ClientRetryBudgetConfiguration{
+				MaxTokens:  10,
+				TokenRatio: 0.1,
+			},
+		})
+		require.NoError(t, err)
+		capabilitiesClient := remoteexecution.NewCapabilitiesClient(client)
+
+		// The first call starts out with a full budget. It
+		// should be retried until the maximum number of attempts
+		// is reached.
+		_, err = capabilitiesClient.GetCapabilities(context.Background(), &remoteexecution.GetCapabilitiesRequest{})
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+		require.Equal(t, int32(5), capabilitiesServer.calls.Load())
+
+		// Each failed attempt consumed a token, meaning that
+		// the budget is now exhausted. The second call should
+		// therefore not be retried.
+		_, err = capabilitiesClient.GetCapabilities(context.Background(), &remoteexecution.GetCapabilitiesRequest{})
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+		require.Equal(t, int32(6), capabilitiesServer.calls.Load())
+	})
+}
+
+// userAgentCapturingCapabilitiesServer is a Capabilities service that
+// stores the User-Agent of the last call made.
+type userAgentCapturingCapabilitiesServer struct {
+	remoteexecution.UnimplementedCapabilitiesServer
+	userAgent []string
+}
+
+func (s *userAgentCapturingCapabilitiesServer) GetCapabilities(ctx context.Context, in *remoteexecution.GetCapabilitiesRequest) (*remoteexecution.ServerCapabilities, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.userAgent = md.Get("user-agent")
+	return &remoteexecution.ServerCapabilities{}, nil
+}
+
+func TestBaseClientFactoryUserAgent(t *testing.T) {
+	clientFactory := bb_grpc.NewBaseClientFactory(bb_grpc.BaseClientDialer, nil, nil)
+
+	path := filepath.Join(t.TempDir(), "grpc")
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	capabilitiesServer := &userAgentCapturingCapabilitiesServer{}
+	remoteexecution.RegisterCapabilitiesServer(server, capabilitiesServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	client, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+		Address:   "unix://" + path,
+		UserAgent: "buildbarn-storage-eu",
+	})
+	require.NoError(t, err)
+
+	// The configured User-Agent should be prepended to the one
+	// provided by gRPC.
+	_, err = remoteexecution.NewCapabilitiesClient(client).GetCapabilities(context.Background(), &remoteexecution.GetCapabilitiesRequest{})
+	require.NoError(t, err)
+	require.Len(t, capabilitiesServer.userAgent, 1)
+	require.True(t, strings.HasPrefix(capabilitiesServer.userAgent[0], "buildbarn-storage-eu grpc-go/"), capabilitiesServer.userAgent[0])
+}
+
+func TestBaseClientFactoryOutlierEjection(t *testing.T) {
+	clientFactory := bb_grpc.NewBaseClientFactory(bb_grpc.BaseClientDialer, nil, nil)
+	outlierEjection := &configuration.ClientOutlierEjectionConfiguration{
+		Interval:                   &durationpb.Duration{Seconds: 10},
+		FailurePercentageThreshold: 50,
+		MinimumRequestVolume:       10,
+		EjectionDuration:           &durationpb.Duration{Seconds: 30},
+	}
+
+	t.Run("ConflictingServiceConfig", func(t *testing.T) {
+		_, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+			Address: "unix:///nonexistent",
+			DefaultServiceConfig: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"loadBalancingConfig": structpb.NewListValue(&structpb.ListValue{}),
+				},
+			},
+			OutlierEjection: outlierEjection,
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Outlier ejection cannot be combined with a default service config that sets loadBalancingConfig"), err)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		// The load balancing policy should be accepted by gRPC,
+		// and calls should be forwarded to the backend.
+		path := filepath.Join(t.TempDir(), "grpc")
+		listener, err := net.Listen("unix", path)
+		require.NoError(t, err)
+		server := grpc.NewServer()
+		capabilitiesServer := &unavailableCapabilitiesServer{}
+		remoteexecution.RegisterCapabilitiesServer(server, capabilitiesServer)
+		go server.Serve(listener)
+		defer server.Stop()
+
+		client, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+			Address:         "unix://" + path,
+			OutlierEjection: outlierEjection,
+		})
+		require.NoError(t, err)
+
+		_, err = remoteexecution.NewCapabilitiesClient(client).GetCapabilities(context.Background(), &remoteexecution.GetCapabilitiesRequest{})
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Server offline"), err)
+		require.Equal(t, int32(1), capabilitiesServer.calls.Load())
+	})
+}
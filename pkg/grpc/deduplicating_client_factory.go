@@ -20,6 +20,10 @@ type deduplicatingClientFactory struct {
 // that deduplicates requests for creating gRPC clients. This means that
 // clients for identical endpoints, having identical TLS settings, etc.
 // will not cause multiple connections to be established.
+//
+// Clients whose configurations only differ in connection_pool are not
+// deduplicated, allowing callers to explicitly request separate
+// connections to the same endpoint.
 func NewDeduplicatingClientFactory(base ClientFactory) ClientFactory {
 	return &deduplicatingClientFactory{
 		base:    base,
@@ -85,4 +85,43 @@ func TestDeduplicatingClientFactory(t *testing.T) {
 			require.Equal(t, client2, client)
 		}
 	})
+	t.Run("ConnectionPools", func(t *testing.T) {
+		// Clients for the same address that use different
+		// connection pools should not share a connection. This
+		// allows isolating Action Cache traffic from Content
+		// Addressable Storage traffic.
+		acClient := mock.NewMockClientConnInterface(ctrl)
+		baseClientFactory.EXPECT().NewClientFromConfiguration(
+			testutil.EqProto(
+				t,
+				&configuration.ClientConfiguration{
+					Address:        "example.com:3",
+					ConnectionPool: "ac",
+				})).Return(acClient, nil)
+
+		casClient := mock.NewMockClientConnInterface(ctrl)
+		baseClientFactory.EXPECT().NewClientFromConfiguration(
+			testutil.EqProto(
+				t,
+				&configuration.ClientConfiguration{
+					Address:        "example.com:3",
+					ConnectionPool: "cas",
+				})).Return(casClient, nil)
+
+		for i := 0; i < 10; i++ {
+			client, err := clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+				Address:        "example.com:3",
+				ConnectionPool: "ac",
+			})
+			require.NoError(t, err)
+			require.Same(t, acClient, client)
+
+			client, err = clientFactory.NewClientFromConfiguration(&configuration.ClientConfiguration{
+				Address:        "example.com:3",
+				ConnectionPool: "cas",
+			})
+			require.NoError(t, err)
+			require.Same(t, casClient, client)
+		}
+	})
 }
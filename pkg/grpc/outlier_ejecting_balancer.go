@@ -0,0 +1,247 @@
+package grpc
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/serviceconfig"
+	"google.golang.org/grpc/status"
+)
+
+// OutlierEjectingBalancerName is the name under which the load
+// balancing policy created by NewOutlierEjectingBalancerBuilder() is
+// registered. It may be referenced from the 'loadBalancingConfig'
+// field of a gRPC service config.
+const OutlierEjectingBalancerName = "buildbarn_outlier_ejecting"
+
+var (
+	outlierEjectingBalancerPrometheusMetrics sync.Once
+
+	outlierEjectingBalancerEjections = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "buildbarn",
+			Subsystem: "grpc",
+			Name:      "outlier_ejecting_balancer_ejections_total",
+			Help:      "Number of times a backend was ejected by the outlier ejecting load balancer, due to its rate of failed calls.",
+		})
+)
+
+func init() {
+	balancer.Register(NewOutlierEjectingBalancerBuilder(clock.SystemClock))
+}
+
+// outlierEjectingConfig contains the options of the outlier ejecting
+// load balancing policy, as provided through the gRPC service config.
+type outlierEjectingConfig struct {
+	serviceconfig.LoadBalancingConfig
+
+	Interval                   string `json:"interval"`
+	FailurePercentageThreshold uint32 `json:"failurePercentageThreshold"`
+	MinimumRequestVolume       uint32 `json:"minimumRequestVolume"`
+	EjectionDuration           string `json:"ejectionDuration"`
+
+	interval         time.Duration
+	ejectionDuration time.Duration
+}
+
+type outlierEjectingBalancerBuilder struct {
+	clock clock.Clock
+}
+
+// NewOutlierEjectingBalancerBuilder creates a gRPC load balancing
+// policy that distributes calls across all backends in a round-robin
+// fashion, similar to gRPC's "round_robin" policy. In addition to
+// that, it keeps track of the rate at which calls against individual
+// backends fail with infrastructure errors (e.g., UNAVAILABLE). Backends
+// whose failure rate exceeds a threshold are ejected temporarily,
+// meaning that no calls are sent to them. Once the ejection duration
+// has elapsed, calls are sent to the backend again, thereby probing
+// whether it has recovered.
+//
+// This can be used to route traffic away from backends that still
+// accept connections, but fail to process calls successfully. If all
+// backends are ejected, calls are distributed across all of them, so
+// that ejection never causes an outage of its own.
+func NewOutlierEjectingBalancerBuilder(clock clock.Clock) balancer.Builder {
+	outlierEjectingBalancerPrometheusMetrics.Do(func() {
+		prometheus.MustRegister(outlierEjectingBalancerEjections)
+	})
+
+	return &outlierEjectingBalancerBuilder{
+		clock: clock,
+	}
+}
+
+func (bb *outlierEjectingBalancerBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	pb := &outlierEjectingPickerBuilder{
+		clock: bb.clock,
+	}
+	return &outlierEjectingBalancer{
+		Balancer:      base.NewBalancerBuilder(OutlierEjectingBalancerName, pb, base.Config{}).Build(cc, opts),
+		pickerBuilder: pb,
+	}
+}
+
+func (outlierEjectingBalancerBuilder) Name() string {
+	return OutlierEjectingBalancerName
+}
+
+func (outlierEjectingBalancerBuilder) ParseConfig(data json.RawMessage) (serviceconfig.LoadBalancingConfig, error) {
+	var config outlierEjectingConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Failed to unmarshal configuration: %s", err)
+	}
+	var err error
+	if config.interval, err = time.ParseDuration(config.Interval); err != nil || config.interval <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid interval %#v", config.Interval)
+	}
+	if config.FailurePercentageThreshold < 1 || config.FailurePercentageThreshold > 100 {
+		return nil, status.Error(codes.InvalidArgument, "Failure percentage threshold must be in range [1, 100]")
+	}
+	if config.MinimumRequestVolume < 1 {
+		return nil, status.Error(codes.InvalidArgument, "Minimum request volume must be positive")
+	}
+	if config.ejectionDuration, err = time.ParseDuration(config.EjectionDuration); err != nil || config.ejectionDuration <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid ejection duration %#v", config.EjectionDuration)
+	}
+	return &config, nil
+}
+
+// outlierEjectingBalancer is a decorator for the balancer provided by
+// the "base" package. It captures the configuration provided through
+// the service config, as the "base" balancer discards it.
+type outlierEjectingBalancer struct {
+	balancer.Balancer
+	pickerBuilder *outlierEjectingPickerBuilder
+}
+
+func (b *outlierEjectingBalancer) UpdateClientConnState(s balancer.ClientConnState) error {
+	if config, ok := s.BalancerConfig.(*outlierEjectingConfig); ok {
+		b.pickerBuilder.config.Store(config)
+	}
+	return b.Balancer.UpdateClientConnState(s)
+}
+
+// outlierEjectingSubConnState keeps track of the number of calls that
+// failed against a single backend during the current interval, and
+// whether the backend is ejected.
+type outlierEjectingSubConnState struct {
+	subConn balancer.SubConn
+
+	lock          sync.Mutex
+	intervalStart time.Time
+	requests      uint32
+	failures      uint32
+	ejectedUntil  time.Time
+}
+
+func (s *outlierEjectingSubConnState) isEjected(now time.Time) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return now.Before(s.ejectedUntil)
+}
+
+func (s *outlierEjectingSubConnState) recordResult(config *outlierEjectingConfig, now time.Time, failed bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if now.Sub(s.intervalStart) >= config.interval {
+		s.intervalStart = now
+		s.requests = 0
+		s.failures = 0
+	}
+	s.requests++
+	if failed {
+		s.failures++
+	}
+
+	if s.requests >= config.MinimumRequestVolume &&
+		uint64(s.failures)*100 >= uint64(config.FailurePercentageThreshold)*uint64(s.requests) &&
+		!now.Before(s.ejectedUntil) {
+		s.ejectedUntil = now.Add(config.ejectionDuration)
+		s.intervalStart = s.ejectedUntil
+		s.requests = 0
+		s.failures = 0
+		outlierEjectingBalancerEjections.Inc()
+	}
+}
+
+type outlierEjectingPickerBuilder struct {
+	clock  clock.Clock
+	config atomic.Pointer[outlierEjectingConfig]
+
+	lock   sync.Mutex
+	states map[balancer.SubConn]*outlierEjectingSubConnState
+}
+
+func (pb *outlierEjectingPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+
+	// Retain the state of backends that remain ready, so that
+	// ejections are not lifted by unrelated connectivity changes.
+	pb.lock.Lock()
+	states := make(map[balancer.SubConn]*outlierEjectingSubConnState, len(info.ReadySCs))
+	subConns := make([]*outlierEjectingSubConnState, 0, len(info.ReadySCs))
+	for subConn := range info.ReadySCs {
+		state, ok := pb.states[subConn]
+		if !ok {
+			state = &outlierEjectingSubConnState{subConn: subConn}
+		}
+		states[subConn] = state
+		subConns = append(subConns, state)
+	}
+	pb.states = states
+	pb.lock.Unlock()
+
+	return &outlierEjectingPicker{
+		pickerBuilder: pb,
+		subConns:      subConns,
+	}
+}
+
+type outlierEjectingPicker struct {
+	pickerBuilder *outlierEjectingPickerBuilder
+	subConns      []*outlierEjectingSubConnState
+	next          atomic.Uint32
+}
+
+func (p *outlierEjectingPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	pb := p.pickerBuilder
+	now := pb.clock.Now()
+	n := uint32(len(p.subConns))
+	start := p.next.Add(1)
+	selected := p.subConns[start%n]
+	for i := uint32(0); i < n; i++ {
+		if candidate := p.subConns[(start+i)%n]; !candidate.isEjected(now) {
+			selected = candidate
+			break
+		}
+	}
+
+	config := pb.config.Load()
+	if config == nil {
+		return balancer.PickResult{SubConn: selected.subConn}, nil
+	}
+	return balancer.PickResult{
+		SubConn: selected.subConn,
+		Done: func(di balancer.DoneInfo) {
+			// In addition to infrastructure errors, count
+			// DATA_LOSS towards the failure rate, as it
+			// indicates that the backend returned corrupted
+			// data. It is not caused by the request itself.
+			failed := util.IsInfrastructureError(di.Err) || status.Code(di.Err) == codes.DataLoss
+			selected.recordResult(config, pb.clock.Now(), failed)
+		},
+	}, nil
+}
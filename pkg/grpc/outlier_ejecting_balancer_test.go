@@ -0,0 +1,226 @@
+package grpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+// fakeSubConn is a SubConn that is created by fakeBalancerClientConn.
+// It records the address to which it corresponds, and the listener
+// through which connectivity state changes need to be reported.
+type fakeSubConn struct {
+	balancer.SubConn
+	address       string
+	stateListener func(balancer.SubConnState)
+}
+
+func (fakeSubConn) Connect()  {}
+func (fakeSubConn) Shutdown() {}
+
+// fakeBalancerClientConn is a ClientConn that is provided to the load
+// balancer. It captures the SubConns and pickers created by the load
+// balancer, so that they can be inspected.
+type fakeBalancerClientConn struct {
+	balancer.ClientConn
+	subConns []*fakeSubConn
+	picker   balancer.Picker
+}
+
+func (cc *fakeBalancerClientConn) NewSubConn(addrs []resolver.Address, opts balancer.NewSubConnOptions) (balancer.SubConn, error) {
+	sc := &fakeSubConn{
+		address:       addrs[0].Addr,
+		stateListener: opts.StateListener,
+	}
+	cc.subConns = append(cc.subConns, sc)
+	return sc, nil
+}
+
+func (cc *fakeBalancerClientConn) UpdateState(s balancer.State) {
+	cc.picker = s.Picker
+}
+
+func TestOutlierEjectingBalancer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	clock := mock.NewMockClock(ctrl)
+	now := time.Unix(1000, 0)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+
+	builder := bb_grpc.NewOutlierEjectingBalancerBuilder(clock)
+	configParser := builder.(balancer.ConfigParser)
+
+	t.Run("InvalidConfiguration", func(t *testing.T) {
+		_, err := configParser.ParseConfig([]byte(`{"interval": "10s", "failurePercentageThreshold": 0, "minimumRequestVolume": 4, "ejectionDuration": "30s"}`))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Failure percentage threshold must be in range [1, 100]"), err)
+
+		_, err = configParser.ParseConfig([]byte(`{"interval": "10s", "failurePercentageThreshold": 50, "minimumRequestVolume": 4, "ejectionDuration": "soon"}`))
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Invalid ejection duration \"soon\""), err)
+	})
+
+	t.Run("EjectionAndProbing", func(t *testing.T) {
+		config, err := configParser.ParseConfig([]byte(`{"interval": "10s", "failurePercentageThreshold": 50, "minimumRequestVolume": 4, "ejectionDuration": "30s"}`))
+		require.NoError(t, err)
+
+		cc := &fakeBalancerClientConn{}
+		b := builder.Build(cc, balancer.BuildOptions{})
+		defer b.Close()
+		require.NoError(t, b.UpdateClientConnState(balancer.ClientConnState{
+			ResolverState: resolver.State{
+				Addresses: []resolver.Address{
+					{Addr: "healthy:8980"},
+					{Addr: "failing:8980"},
+				},
+			},
+			BalancerConfig: config,
+		}))
+		require.Len(t, cc.subConns, 2)
+		for _, sc := range cc.subConns {
+			sc.stateListener(balancer.SubConnState{ConnectivityState: connectivity.Ready})
+		}
+
+		// Perform a series of calls, where calls against one of
+		// the backends fail. Both backends should initially
+		// receive calls.
+		pickAndComplete := func() string {
+			result, err := cc.picker.Pick(balancer.PickInfo{})
+			require.NoError(t, err)
+			address := result.SubConn.(*fakeSubConn).address
+			if address == "failing:8980" {
+				result.Done(balancer.DoneInfo{Err: status.Error(codes.Unavailable, "Server returned errors")})
+			} else {
+				result.Done(balancer.DoneInfo{})
+			}
+			return address
+		}
+		picked := map[string]int{}
+		for i := 0; i < 8; i++ {
+			picked[pickAndComplete()]++
+		}
+		require.Equal(t, map[string]int{
+			"failing:8980": 4,
+			"healthy:8980": 4,
+		}, picked)
+
+		// The failing backend should now be ejected, causing
+		// all calls to go to the healthy backend.
+		for i := 0; i < 10; i++ {
+			require.Equal(t, "healthy:8980", pickAndComplete())
+		}
+
+		// Once the ejection duration has elapsed, calls should
+		// be sent to the failing backend again to probe it.
+		now = now.Add(30 * time.Second)
+		picked = map[string]int{}
+		for i := 0; i < 8; i++ {
+			picked[pickAndComplete()]++
+		}
+		require.Equal(t, map[string]int{
+			"failing:8980": 4,
+			"healthy:8980": 4,
+		}, picked)
+
+		// As it still fails, it should be ejected once more.
+		for i := 0; i < 10; i++ {
+			require.Equal(t, "healthy:8980", pickAndComplete())
+		}
+	})
+
+	t.Run("ErrorClassification", func(t *testing.T) {
+		config, err := configParser.ParseConfig([]byte(`{"interval": "10s", "failurePercentageThreshold": 50, "minimumRequestVolume": 2, "ejectionDuration": "30s"}`))
+		require.NoError(t, err)
+
+		// Only errors that are caused by the backend, as
+		// opposed to the request, should cause it to be ejected.
+		for code, ejected := range map[codes.Code]bool{
+			codes.DataLoss:         true,
+			codes.Internal:         true,
+			codes.Unavailable:      true,
+			codes.Unknown:          true,
+			codes.InvalidArgument:  false,
+			codes.NotFound:         false,
+			codes.PermissionDenied: false,
+		} {
+			t.Run(code.String(), func(t *testing.T) {
+				cc := &fakeBalancerClientConn{}
+				b := builder.Build(cc, balancer.BuildOptions{})
+				defer b.Close()
+				require.NoError(t, b.UpdateClientConnState(balancer.ClientConnState{
+					ResolverState: resolver.State{
+						Addresses: []resolver.Address{
+							{Addr: "healthy:8980"},
+							{Addr: "failing:8980"},
+						},
+					},
+					BalancerConfig: config,
+				}))
+				require.Len(t, cc.subConns, 2)
+				for _, sc := range cc.subConns {
+					sc.stateListener(balancer.SubConnState{ConnectivityState: connectivity.Ready})
+				}
+
+				picked := map[string]int{}
+				for i := 0; i < 8; i++ {
+					result, err := cc.picker.Pick(balancer.PickInfo{})
+					require.NoError(t, err)
+					address := result.SubConn.(*fakeSubConn).address
+					if address == "failing:8980" {
+						result.Done(balancer.DoneInfo{Err: status.Error(code, "Server returned errors")})
+					} else {
+						result.Done(balancer.DoneInfo{})
+					}
+					picked[address]++
+				}
+				if ejected {
+					require.Equal(t, map[string]int{
+						"failing:8980": 2,
+						"healthy:8980": 6,
+					}, picked)
+				} else {
+					require.Equal(t, map[string]int{
+						"failing:8980": 4,
+						"healthy:8980": 4,
+					}, picked)
+				}
+			})
+		}
+	})
+
+	t.Run("AllEjected", func(t *testing.T) {
+		// If all backends are ejected, calls should still be
+		// sent to them.
+		config, err := configParser.ParseConfig([]byte(`{"interval": "10s", "failurePercentageThreshold": 50, "minimumRequestVolume": 1, "ejectionDuration": "30s"}`))
+		require.NoError(t, err)
+
+		cc := &fakeBalancerClientConn{}
+		b := builder.Build(cc, balancer.BuildOptions{})
+		defer b.Close()
+		require.NoError(t, b.UpdateClientConnState(balancer.ClientConnState{
+			ResolverState: resolver.State{
+				Addresses: []resolver.Address{{Addr: "failing:8980"}},
+			},
+			BalancerConfig: config,
+		}))
+		require.Len(t, cc.subConns, 1)
+		cc.subConns[0].stateListener(balancer.SubConnState{ConnectivityState: connectivity.Ready})
+
+		for i := 0; i < 3; i++ {
+			result, err := cc.picker.Pick(balancer.PickInfo{})
+			require.NoError(t, err)
+			require.Equal(t, "failing:8980", result.SubConn.(*fakeSubConn).address)
+			result.Done(balancer.DoneInfo{Err: status.Error(codes.Internal, "Server returned errors")})
+		}
+	})
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"net"
 	"os"
+	"time"
 
+	"github.com/buildbarn/bb-storage/pkg/clock"
 	"github.com/buildbarn/bb-storage/pkg/program"
 	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
+	"github.com/buildbarn/bb-storage/pkg/readiness"
 	"github.com/buildbarn/bb-storage/pkg/util"
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
 
@@ -29,174 +32,280 @@ func init() {
 			util.DecimalExponentialBuckets(-3, 6, 2)))
 }
 
+// messageSizeOverheadBytes is the amount of space that is reserved on
+// top of the application-level maximum message size when determining
+// the default transport-level message size limits. This accounts for
+// fields that are stored next to payloads, such as digests and status
+// messages in BatchReadBlobs() responses.
+const messageSizeOverheadBytes = 1 << 20
+
 // NewServersFromConfigurationAndServe creates a series of gRPC servers
 // based on a configuration stored in a list of Protobuf messages. It
 // then lets all of these gRPC servers listen on the network addresses
 // of UNIX socket paths provided.
-func NewServersFromConfigurationAndServe(configurations []*configuration.ServerConfiguration, registrationFunc func(grpc.ServiceRegistrar), group program.Group) error {
-	for _, configuration := range configurations {
-		// Create an authenticator for requests.
-		authenticator, needsPeerTransportCredentials, requestTLSClientCertificate, err := NewAuthenticatorFromConfiguration(configuration.AuthenticationPolicy, group)
-		if err != nil {
-			return err
-		}
-
-		// Default server options.
-		unaryInterceptors := []grpc.UnaryServerInterceptor{
-			grpc_prometheus.UnaryServerInterceptor,
-			otelgrpc.UnaryServerInterceptor(),
-			RequestMetadataTracingUnaryInterceptor,
+//
+// If nonzero, maximumMessageSizeBytes is used to derive the default
+// sizes of messages that may be received and sent by the gRPC
+// transport. This prevents messages that are permitted at the
+// application level from being rejected by the transport.
+//
+// Each server configuration may contain multiple listeners, each
+// having their own TLS configuration and authentication policy. As
+// transport credentials are a property of a gRPC server, a separate
+// server is created for every listener. The services are registered
+// against each of these servers.
+func NewServersFromConfigurationAndServe(configurations []*configuration.ServerConfiguration, registrationFunc func(grpc.ServiceRegistrar), maximumMessageSizeBytes int64, group program.Group) error {
+	for _, serverConfiguration := range configurations {
+		// The listen addresses, TLS configuration and
+		// authentication policy that are placed at the top
+		// level of the configuration act as a shorthand for
+		// declaring a single listener.
+		var listeners []*configuration.ListenerConfiguration
+		if len(serverConfiguration.ListenAddresses)+len(serverConfiguration.ListenPaths) > 0 {
+			listeners = append(listeners, &configuration.ListenerConfiguration{
+				ListenAddresses:      serverConfiguration.ListenAddresses,
+				ListenPaths:          serverConfiguration.ListenPaths,
+				Tls:                  serverConfiguration.Tls,
+				AuthenticationPolicy: serverConfiguration.AuthenticationPolicy,
+			})
 		}
-		streamInterceptors := []grpc.StreamServerInterceptor{
-			grpc_prometheus.StreamServerInterceptor,
-			otelgrpc.StreamServerInterceptor(),
-			RequestMetadataTracingStreamInterceptor,
+		listeners = append(listeners, serverConfiguration.Listeners...)
+		if len(listeners) == 0 {
+			return status.Error(codes.InvalidArgument, "GRPC server configured without any listen addresses or paths")
 		}
 
-		// Optional: Tracing attributes.
-		if tracing := configuration.Tracing; len(tracing) > 0 {
-			extractor := NewProtoTraceAttributesExtractor(tracing, util.DefaultErrorLogger)
-			unaryInterceptors = append(unaryInterceptors, extractor.InterceptUnaryServer)
-			streamInterceptors = append(streamInterceptors, extractor.InterceptStreamServer)
+		for i, listener := range listeners {
+			if err := newServerFromConfigurationAndServe(serverConfiguration, listener, registrationFunc, maximumMessageSizeBytes, group); err != nil {
+				return util.StatusWrapf(err, "Listener %d", i)
+			}
 		}
+	}
+	return nil
+}
 
-		unaryInterceptors = append(unaryInterceptors, NewAuthenticatingUnaryInterceptor(authenticator))
-		streamInterceptors = append(streamInterceptors, NewAuthenticatingStreamInterceptor(authenticator))
+// readinessCheckInterval is the interval at which readiness checks are
+// called to update the status reported by the health checking service.
+const readinessCheckInterval = time.Second
 
-		serverOptions := []grpc.ServerOption{
-			grpc.ChainUnaryInterceptor(unaryInterceptors...),
-			grpc.ChainStreamInterceptor(streamInterceptors...),
-		}
+// updateHealthServingStatus sets the status reported by the health
+// checking service, both for the server as a whole and for the
+// configured service name, based on the outcome of the readiness
+// checks.
+func updateHealthServingStatus(h *health.Server, service string) {
+	servingStatus := grpc_health_v1.HealthCheckResponse_SERVING
+	if readiness.Check() != nil {
+		servingStatus = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	h.SetServingStatus("", servingStatus)
+	h.SetServingStatus(service, servingStatus)
+}
 
-		// Enable TLS transport credentials if provided.
-		hasCredsOption := false
-		if tlsConfig, err := util.NewTLSConfigFromServerConfiguration(configuration.Tls, requestTLSClientCertificate); err != nil {
-			return err
-		} else if tlsConfig != nil {
-			hasCredsOption = true
-			serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
-		}
+func newServerFromConfigurationAndServe(configuration *configuration.ServerConfiguration, listener *configuration.ListenerConfiguration, registrationFunc func(grpc.ServiceRegistrar), maximumMessageSizeBytes int64, group program.Group) error {
+	// Create an authenticator for requests.
+	authenticator, needsPeerTransportCredentials, requestTLSClientCertificate, err := NewAuthenticatorFromConfiguration(listener.AuthenticationPolicy, group)
+	if err != nil {
+		return err
+	}
 
-		// Enable UNIX socket peer credentials if used in the
-		// authenticator configuration.
-		if needsPeerTransportCredentials {
-			if hasCredsOption {
-				return status.Error(codes.InvalidArgument, "Peer credentials authentication and TLS cannot be enabled at the same time")
-			}
-			serverOptions = append(serverOptions, grpc.Creds(PeerTransportCredentials))
+	// Default server options.
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		grpc_prometheus.UnaryServerInterceptor,
+		otelgrpc.UnaryServerInterceptor(),
+		RequestMetadataTracingUnaryInterceptor,
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_prometheus.StreamServerInterceptor,
+		otelgrpc.StreamServerInterceptor(),
+		RequestMetadataTracingStreamInterceptor,
+	}
+
+	// Optional: Tracing attributes.
+	if tracing := configuration.Tracing; len(tracing) > 0 {
+		extractor := NewProtoTraceAttributesExtractor(tracing, util.DefaultErrorLogger)
+		unaryInterceptors = append(unaryInterceptors, extractor.InterceptUnaryServer)
+		streamInterceptors = append(streamInterceptors, extractor.InterceptStreamServer)
+	}
+
+	// Optional: Report the duration of RPCs as response trailers.
+	if configuration.EmitServerTimingTrailers {
+		unaryInterceptors = append(unaryInterceptors, NewServerTimingUnaryInterceptor(clock.SystemClock))
+		streamInterceptors = append(streamInterceptors, NewServerTimingStreamInterceptor(clock.SystemClock))
+	}
+
+	// Optional: Report which storage backends served reads as
+	// response trailers.
+	if configuration.EmitBackendProvenanceTrailers {
+		unaryInterceptors = append(unaryInterceptors, NewBackendProvenanceUnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, NewBackendProvenanceStreamInterceptor())
+	}
+
+	unaryInterceptors = append(unaryInterceptors, NewAuthenticatingUnaryInterceptor(authenticator))
+	streamInterceptors = append(streamInterceptors, NewAuthenticatingStreamInterceptor(authenticator))
+
+	serverOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+
+	// Enable TLS transport credentials if provided.
+	hasCredsOption := false
+	if tlsConfig, err := util.NewTLSConfigFromServerConfiguration(listener.Tls, requestTLSClientCertificate); err != nil {
+		return err
+	} else if tlsConfig != nil {
+		hasCredsOption = true
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	// Enable UNIX socket peer credentials if used in the
+	// authenticator configuration.
+	if needsPeerTransportCredentials {
+		if hasCredsOption {
+			return status.Error(codes.InvalidArgument, "Peer credentials authentication and TLS cannot be enabled at the same time")
 		}
+		serverOptions = append(serverOptions, grpc.Creds(PeerTransportCredentials))
+	}
+
+	// Message size limits of the transport. If not provided, derive
+	// them from the application-level maximum message size.
+	var defaultMessageSizeBytes int64
+	if maximumMessageSizeBytes > 0 {
+		defaultMessageSizeBytes = maximumMessageSizeBytes + messageSizeOverheadBytes
+	}
+	maxRecvMsgSize := configuration.MaximumReceivedMessageSizeBytes
+	if maxRecvMsgSize == 0 {
+		maxRecvMsgSize = defaultMessageSizeBytes
+	}
+	if maxRecvMsgSize < 0 {
+		return status.Error(codes.InvalidArgument, "Maximum received message size cannot be negative")
+	} else if maxRecvMsgSize > 0 {
+		serverOptions = append(serverOptions, grpc.MaxRecvMsgSize(int(maxRecvMsgSize)))
+	}
+	maxSendMsgSize := configuration.MaximumSentMessageSizeBytes
+	if maxSendMsgSize == 0 {
+		maxSendMsgSize = defaultMessageSizeBytes
+	}
+	if maxSendMsgSize < 0 {
+		return status.Error(codes.InvalidArgument, "Maximum sent message size cannot be negative")
+	} else if maxSendMsgSize > 0 {
+		serverOptions = append(serverOptions, grpc.MaxSendMsgSize(int(maxSendMsgSize)))
+	}
 
-		if maxRecvMsgSize := configuration.MaximumReceivedMessageSizeBytes; maxRecvMsgSize != 0 {
-			serverOptions = append(serverOptions, grpc.MaxRecvMsgSize(int(maxRecvMsgSize)))
+	if windowSize := configuration.InitialWindowSizeBytes; windowSize != 0 {
+		serverOptions = append(serverOptions, grpc.InitialWindowSize(windowSize))
+	}
+	if connWindowSize := configuration.InitialConnWindowSizeBytes; connWindowSize != 0 {
+		serverOptions = append(serverOptions, grpc.InitialConnWindowSize(connWindowSize))
+	}
+
+	// Optional: Keepalive enforcement policy.
+	if policy := configuration.KeepaliveEnforcementPolicy; policy != nil {
+		minTime := policy.MinTime
+		if err := minTime.CheckValid(); err != nil {
+			return util.StatusWrap(err, "Failed to parse keepalive enforcement policy minimum time")
 		}
+		serverOptions = append(serverOptions, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             minTime.AsDuration(),
+			PermitWithoutStream: policy.PermitWithoutStream,
+		}))
+	}
 
-		if windowSize := configuration.InitialWindowSizeBytes; windowSize != 0 {
-			serverOptions = append(serverOptions, grpc.InitialWindowSize(windowSize))
+	if keepaliveParams := configuration.KeepaliveParameters; keepaliveParams != nil {
+		maxConnectionIdle := keepaliveParams.MaxConnectionIdle
+		if err := maxConnectionIdle.CheckValid(); err != nil {
+			return util.StatusWrap(err, "Failed to parse keepalive server parameter max connection idle")
 		}
-		if connWindowSize := configuration.InitialConnWindowSizeBytes; connWindowSize != 0 {
-			serverOptions = append(serverOptions, grpc.InitialConnWindowSize(connWindowSize))
+		maxConnectionAge := keepaliveParams.MaxConnectionAge
+		if err := maxConnectionAge.CheckValid(); err != nil {
+			return util.StatusWrap(err, "Failed to parse keepalive server parameter max connection age")
 		}
-
-		// Optional: Keepalive enforcement policy.
-		if policy := configuration.KeepaliveEnforcementPolicy; policy != nil {
-			minTime := policy.MinTime
-			if err := minTime.CheckValid(); err != nil {
-				return util.StatusWrap(err, "Failed to parse keepalive enforcement policy minimum time")
-			}
-			serverOptions = append(serverOptions, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
-				MinTime:             minTime.AsDuration(),
-				PermitWithoutStream: policy.PermitWithoutStream,
-			}))
+		maxConnectionAgeGrace := keepaliveParams.MaxConnectionAgeGrace
+		if err := maxConnectionAgeGrace.CheckValid(); err != nil {
+			return util.StatusWrap(err, "Failed to parse keepalive server parameter max connection age grace")
+		}
+		time := keepaliveParams.Time
+		if err := time.CheckValid(); err != nil {
+			return util.StatusWrap(err, "Failed to parse keepalive server parameter time")
 		}
+		timeout := keepaliveParams.Timeout
+		if err := timeout.CheckValid(); err != nil {
+			return util.StatusWrap(err, "Failed to parse keepalive server parameter timeout")
+		}
+		serverOptions = append(serverOptions, grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle:     maxConnectionIdle.AsDuration(),
+			MaxConnectionAge:      maxConnectionAge.AsDuration(),
+			MaxConnectionAgeGrace: maxConnectionAgeGrace.AsDuration(),
+			Time:                  time.AsDuration(),
+			Timeout:               timeout.AsDuration(),
+		}))
+	}
 
-		if keepaliveParams := configuration.KeepaliveParameters; keepaliveParams != nil {
-			maxConnectionIdle := keepaliveParams.MaxConnectionIdle
-			if err := maxConnectionIdle.CheckValid(); err != nil {
-				return util.StatusWrap(err, "Failed to parse keepalive server parameter max connection idle")
-			}
-			maxConnectionAge := keepaliveParams.MaxConnectionAge
-			if err := maxConnectionAge.CheckValid(); err != nil {
-				return util.StatusWrap(err, "Failed to parse keepalive server parameter max connection age")
-			}
-			maxConnectionAgeGrace := keepaliveParams.MaxConnectionAgeGrace
-			if err := maxConnectionAgeGrace.CheckValid(); err != nil {
-				return util.StatusWrap(err, "Failed to parse keepalive server parameter max connection age grace")
-			}
-			time := keepaliveParams.Time
-			if err := time.CheckValid(); err != nil {
-				return util.StatusWrap(err, "Failed to parse keepalive server parameter time")
-			}
-			timeout := keepaliveParams.Timeout
-			if err := timeout.CheckValid(); err != nil {
-				return util.StatusWrap(err, "Failed to parse keepalive server parameter timeout")
+	// Create server.
+	s := grpc.NewServer(serverOptions...)
+	stopFunc := s.Stop
+	if configuration.StopGracefully {
+		stopFunc = s.GracefulStop
+	}
+	group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		<-ctx.Done()
+		stopFunc()
+		return nil
+	})
+	registrationFunc(s)
+
+	// Enable default services.
+	grpc_prometheus.Register(s)
+	reflection.Register(s)
+	h := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(s, h)
+	updateHealthServingStatus(h, configuration.HealthCheckService)
+	group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		for {
+			timer, timerChannel := clock.SystemClock.NewTimer(readinessCheckInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil
+			case <-timerChannel:
+				updateHealthServingStatus(h, configuration.HealthCheckService)
 			}
-			serverOptions = append(serverOptions, grpc.KeepaliveParams(keepalive.ServerParameters{
-				MaxConnectionIdle:     maxConnectionIdle.AsDuration(),
-				MaxConnectionAge:      maxConnectionAge.AsDuration(),
-				MaxConnectionAgeGrace: maxConnectionAgeGrace.AsDuration(),
-				Time:                  time.AsDuration(),
-				Timeout:               timeout.AsDuration(),
-			}))
 		}
+	})
+
+	if len(listener.ListenAddresses)+len(listener.ListenPaths) == 0 {
+		return status.Error(codes.InvalidArgument, "Listener configured without any listen addresses or paths")
+	}
 
-		// Create server.
-		s := grpc.NewServer(serverOptions...)
-		stopFunc := s.Stop
-		if configuration.StopGracefully {
-			stopFunc = s.GracefulStop
+	// TCP sockets.
+	for _, listenAddressIter := range listener.ListenAddresses {
+		listenAddress := listenAddressIter
+		sock, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to create listening socket for %#v", listenAddress)
 		}
 		group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
-			<-ctx.Done()
-			stopFunc()
+			if err := s.Serve(sock); err != nil {
+				return util.StatusWrapf(err, "gRPC server failed for %#v", listenAddress)
+			}
 			return nil
 		})
-		registrationFunc(s)
-
-		// Enable default services.
-		grpc_prometheus.Register(s)
-		reflection.Register(s)
-		h := health.NewServer()
-		grpc_health_v1.RegisterHealthServer(s, h)
-		// TODO: Construct an API for the caller to indicate
-		// when it is healthy and set this.
-		h.SetServingStatus(configuration.HealthCheckService, grpc_health_v1.HealthCheckResponse_SERVING)
-
-		if len(configuration.ListenAddresses)+len(configuration.ListenPaths) == 0 {
-			return status.Error(codes.InvalidArgument, "GRPC server configured without any listen addresses or paths")
-		}
+	}
 
-		// TCP sockets.
-		for _, listenAddressIter := range configuration.ListenAddresses {
-			listenAddress := listenAddressIter
-			sock, err := net.Listen("tcp", listenAddress)
-			if err != nil {
-				return util.StatusWrapf(err, "Failed to create listening socket for %#v", listenAddress)
-			}
-			group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
-				if err := s.Serve(sock); err != nil {
-					return util.StatusWrapf(err, "gRPC server failed for %#v", listenAddress)
-				}
-				return nil
-			})
+	// UNIX sockets.
+	for _, listenPathIter := range listener.ListenPaths {
+		listenPath := listenPathIter
+		if err := os.Remove(listenPath); err != nil && !os.IsNotExist(err) {
+			return util.StatusWrapf(err, "Could not remove stale socket %#v", listenPath)
 		}
-
-		// UNIX sockets.
-		for _, listenPathIter := range configuration.ListenPaths {
-			listenPath := listenPathIter
-			if err := os.Remove(listenPath); err != nil && !os.IsNotExist(err) {
-				return util.StatusWrapf(err, "Could not remove stale socket %#v", listenPath)
-			}
-			sock, err := net.Listen("unix", listenPath)
-			if err != nil {
-				return util.StatusWrapf(err, "Failed to create listening socket for %#v", listenPath)
-			}
-			group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
-				if err := s.Serve(sock); err != nil {
-					return util.StatusWrapf(err, "gRPC server failed for %#v", listenPath)
-				}
-				return nil
-			})
+		sock, err := net.Listen("unix", listenPath)
+		if err != nil {
+			return util.StatusWrapf(err, "Failed to create listening socket for %#v", listenPath)
 		}
+		group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			if err := s.Serve(sock); err != nil {
+				return util.StatusWrapf(err, "gRPC server failed for %#v", listenPath)
+			}
+			return nil
+		})
 	}
 	return nil
 }
@@ -0,0 +1,340 @@
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	remoteexecution "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	bb_grpc "github.com/buildbarn/bb-storage/pkg/grpc"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	auth_pb "github.com/buildbarn/bb-storage/pkg/proto/auth"
+	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
+	tls_pb "github.com/buildbarn/bb-storage/pkg/proto/configuration/tls"
+	"github.com/buildbarn/bb-storage/pkg/readiness"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newSelfSignedKeyPair generates a key pair for testing TLS servers.
+func newSelfSignedKeyPair(t *testing.T) *tls_pb.X509KeyPair {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	certificate, err := x509.CreateCertificate(
+		rand.Reader,
+		&x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "localhost"},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		},
+		&x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "localhost"},
+		},
+		&privateKey.PublicKey,
+		privateKey)
+	require.NoError(t, err)
+	marshaledPrivateKey, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	return &tls_pb.X509KeyPair{
+		KeyPair: &tls_pb.X509KeyPair_Inline_{
+			Inline: &tls_pb.X509KeyPair_Inline{
+				Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+				PrivateKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: marshaledPrivateKey})),
+			},
+		},
+	}
+}
+
+func TestNewServersFromConfigurationAndServeListeners(t *testing.T) {
+	socketDirectory := t.TempDir()
+	plaintextPath := filepath.Join(socketDirectory, "plaintext")
+	tlsPath := filepath.Join(socketDirectory, "tls")
+	allowPolicy := &configuration.AuthenticationPolicy{
+		Policy: &configuration.AuthenticationPolicy_Allow{
+			Allow: &auth_pb.AuthenticationMetadata{},
+		},
+	}
+
+	// Invoke a health check against a socket using the provided
+	// transport credentials.
+	checkHealth := func(ctx context.Context, path string, transportCredentials credentials.TransportCredentials) error {
+		client, err := grpc.NewClient("unix://"+path, grpc.WithTransportCredentials(transportCredentials))
+		require.NoError(t, err)
+		defer client.Close()
+
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		_, err = grpc_health_v1.NewHealthClient(client).Check(ctxWithTimeout, &grpc_health_v1.HealthCheckRequest{})
+		return err
+	}
+	tlsCredentials := credentials.NewTLS(&tls.Config{
+		InsecureSkipVerify: true,
+	})
+
+	require.NoError(t, program.RunLocal(context.Background(), func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		// Declare a server that uses the shorthand notation
+		// for a plaintext listener, and an additional listener
+		// that uses TLS.
+		require.NoError(t, bb_grpc.NewServersFromConfigurationAndServe(
+			[]*configuration.ServerConfiguration{{
+				ListenPaths:          []string{plaintextPath},
+				AuthenticationPolicy: allowPolicy,
+				Listeners: []*configuration.ListenerConfiguration{{
+					ListenPaths: []string{tlsPath},
+					Tls: &tls_pb.ServerConfiguration{
+						ServerKeyPair: newSelfSignedKeyPair(t),
+					},
+					AuthenticationPolicy: allowPolicy,
+				}},
+			}},
+			func(s grpc.ServiceRegistrar) {},
+			/* maximumMessageSizeBytes = */ 0,
+			dependenciesGroup))
+
+		// Each listener should only accept connections using
+		// the transport credentials that were configured.
+		require.NoError(t, checkHealth(ctx, plaintextPath, insecure.NewCredentials()))
+		require.Error(t, checkHealth(ctx, plaintextPath, tlsCredentials))
+		require.NoError(t, checkHealth(ctx, tlsPath, tlsCredentials))
+		require.Error(t, checkHealth(ctx, tlsPath, insecure.NewCredentials()))
+		return nil
+	}))
+}
+
+func TestNewServersFromConfigurationAndServeServerTiming(t *testing.T) {
+	socketDirectory := t.TempDir()
+	enabledPath := filepath.Join(socketDirectory, "enabled")
+	disabledPath := filepath.Join(socketDirectory, "disabled")
+	allowPolicy := &configuration.AuthenticationPolicy{
+		Policy: &configuration.AuthenticationPolicy_Allow{
+			Allow: &auth_pb.AuthenticationMetadata{},
+		},
+	}
+
+	// Invoke a health check against a socket, returning the
+	// response trailers.
+	checkHealth := func(ctx context.Context, path string) metadata.MD {
+		client, err := grpc.NewClient("unix://"+path, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer client.Close()
+
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		var trailer metadata.MD
+		_, err = grpc_health_v1.NewHealthClient(client).Check(ctxWithTimeout, &grpc_health_v1.HealthCheckRequest{}, grpc.Trailer(&trailer))
+		require.NoError(t, err)
+		return trailer
+	}
+
+	require.NoError(t, program.RunLocal(context.Background(), func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		require.NoError(t, bb_grpc.NewServersFromConfigurationAndServe(
+			[]*configuration.ServerConfiguration{
+				{
+					ListenPaths:              []string{enabledPath},
+					AuthenticationPolicy:     allowPolicy,
+					EmitServerTimingTrailers: true,
+				},
+				{
+					ListenPaths:          []string{disabledPath},
+					AuthenticationPolicy: allowPolicy,
+				},
+			},
+			func(s grpc.ServiceRegistrar) {},
+			/* maximumMessageSizeBytes = */ 0,
+			dependenciesGroup))
+
+		// Responses should only contain a trailer with timing
+		// information if enabled. As the health check service
+		// does not access any storage, no backend time should
+		// be reported.
+		serverTiming := checkHealth(ctx, enabledPath).Get(bb_grpc.ServerTimingTrailerKey)
+		require.Len(t, serverTiming, 1)
+		require.Regexp(t, `^total;dur=[0-9]+\.[0-9]{3}, backend;dur=0\.000$`, serverTiming[0])
+
+		require.Empty(t, checkHealth(ctx, disabledPath).Get(bb_grpc.ServerTimingTrailerKey))
+		return nil
+	}))
+}
+
+func TestNewServersFromConfigurationAndServeReadiness(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "socket")
+	allowPolicy := &configuration.AuthenticationPolicy{
+		Policy: &configuration.AuthenticationPolicy_Allow{
+			Allow: &auth_pb.AuthenticationMetadata{},
+		},
+	}
+
+	// Register a readiness check whose outcome can be controlled
+	// by the test. Let it succeed once the test completes, so that
+	// other tests are not affected.
+	var readinessErr atomic.Pointer[error]
+	setReadinessErr := func(err error) { readinessErr.Store(&err) }
+	setReadinessErr(nil)
+	defer setReadinessErr(nil)
+	require.NoError(t, readiness.RegisterCheck("TestNewServersFromConfigurationAndServeReadiness", func() error {
+		return *readinessErr.Load()
+	}))
+
+	// Obtain the status of the configured service from the health
+	// checking service.
+	getServingStatus := func(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+		client, err := grpc.NewClient("unix://"+socketPath, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer client.Close()
+
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		response, err := grpc_health_v1.NewHealthClient(client).Check(ctxWithTimeout, &grpc_health_v1.HealthCheckRequest{
+			Service: "my-service",
+		})
+		require.NoError(t, err)
+		return response.Status
+	}
+
+	require.NoError(t, program.RunLocal(context.Background(), func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		require.NoError(t, bb_grpc.NewServersFromConfigurationAndServe(
+			[]*configuration.ServerConfiguration{{
+				ListenPaths:          []string{socketPath},
+				AuthenticationPolicy: allowPolicy,
+				HealthCheckService:   "my-service",
+			}},
+			func(s grpc.ServiceRegistrar) {},
+			/* maximumMessageSizeBytes = */ 0,
+			dependenciesGroup))
+
+		// The server should initially be reported as serving,
+		// as the readiness check succeeds.
+		require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, getServingStatus(ctx))
+
+		// Once the readiness check fails, the server should
+		// eventually be reported as not serving.
+		setReadinessErr(status.Error(codes.Unavailable, "Backend is not writable"))
+		require.Eventually(t, func() bool {
+			return getServingStatus(ctx) == grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}, 10*time.Second, 100*time.Millisecond)
+
+		// It should recover once the readiness check succeeds
+		// again.
+		setReadinessErr(nil)
+		require.Eventually(t, func() bool {
+			return getServingStatus(ctx) == grpc_health_v1.HealthCheckResponse_SERVING
+		}, 10*time.Second, 100*time.Millisecond)
+		return nil
+	}))
+}
+
+// largeResponseContentAddressableStorageServer is an implementation of
+// the Content Addressable Storage that returns BatchReadBlobs()
+// responses containing a single blob of a given size.
+type largeResponseContentAddressableStorageServer struct {
+	remoteexecution.UnimplementedContentAddressableStorageServer
+	sizeBytes int
+}
+
+func (s largeResponseContentAddressableStorageServer) BatchReadBlobs(ctx context.Context, in *remoteexecution.BatchReadBlobsRequest) (*remoteexecution.BatchReadBlobsResponse, error) {
+	return &remoteexecution.BatchReadBlobsResponse{
+		Responses: []*remoteexecution.BatchReadBlobsResponse_Response{{
+			Data: make([]byte, s.sizeBytes),
+		}},
+	}, nil
+}
+
+func TestNewServersFromConfigurationAndServeMessageSizes(t *testing.T) {
+	socketDirectory := t.TempDir()
+	allowPolicy := &configuration.AuthenticationPolicy{
+		Policy: &configuration.AuthenticationPolicy_Allow{
+			Allow: &auth_pb.AuthenticationMetadata{},
+		},
+	}
+
+	// Call BatchReadBlobs() against a socket, requesting a response
+	// of a given size.
+	batchReadBlobs := func(ctx context.Context, path string, requestSizeBytes int) error {
+		client, err := grpc.NewClient(
+			"unix://"+path,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(1<<30)))
+		require.NoError(t, err)
+		defer client.Close()
+
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		_, err = remoteexecution.NewContentAddressableStorageClient(client).BatchReadBlobs(ctxWithTimeout, &remoteexecution.BatchReadBlobsRequest{
+			InstanceName: strings.Repeat("x", requestSizeBytes),
+		})
+		return err
+	}
+
+	serve := func(dependenciesGroup program.Group, path string, serverConfiguration *configuration.ServerConfiguration, maximumMessageSizeBytes int64, responseSizeBytes int) {
+		serverConfiguration.ListenPaths = []string{path}
+		serverConfiguration.AuthenticationPolicy = allowPolicy
+		require.NoError(t, bb_grpc.NewServersFromConfigurationAndServe(
+			[]*configuration.ServerConfiguration{serverConfiguration},
+			func(s grpc.ServiceRegistrar) {
+				remoteexecution.RegisterContentAddressableStorageServer(s, largeResponseContentAddressableStorageServer{
+					sizeBytes: responseSizeBytes,
+				})
+			},
+			maximumMessageSizeBytes,
+			dependenciesGroup))
+	}
+
+	require.NoError(t, program.RunLocal(context.Background(), func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+		t.Run("Explicit", func(t *testing.T) {
+			// Explicitly configured limits should be
+			// applied to both requests and responses.
+			smallPath := filepath.Join(socketDirectory, "explicit-small")
+			serve(dependenciesGroup, smallPath, &configuration.ServerConfiguration{
+				MaximumReceivedMessageSizeBytes: 1000,
+				MaximumSentMessageSizeBytes:     1000,
+			}, 0, 2000)
+			require.Equal(t, codes.ResourceExhausted, status.Code(batchReadBlobs(ctx, smallPath, 10)))
+			require.Equal(t, codes.ResourceExhausted, status.Code(batchReadBlobs(ctx, smallPath, 2000)))
+
+			largePath := filepath.Join(socketDirectory, "explicit-large")
+			serve(dependenciesGroup, largePath, &configuration.ServerConfiguration{
+				MaximumReceivedMessageSizeBytes: 10000,
+				MaximumSentMessageSizeBytes:     10000,
+			}, 0, 2000)
+			require.NoError(t, batchReadBlobs(ctx, largePath, 2000))
+		})
+
+		t.Run("Default", func(t *testing.T) {
+			// If no limits are configured, they should be
+			// derived from the application-level maximum
+			// message size, plus some overhead. The
+			// response is larger than gRPC's default
+			// receive limit of 4 MiB.
+			path := filepath.Join(socketDirectory, "default")
+			serve(dependenciesGroup, path, &configuration.ServerConfiguration{}, 8<<20, 8<<20)
+			require.NoError(t, batchReadBlobs(ctx, path, 8<<20))
+
+			tooLargePath := filepath.Join(socketDirectory, "default-too-large")
+			serve(dependenciesGroup, tooLargePath, &configuration.ServerConfiguration{}, 1<<20, 3<<20)
+			require.Equal(t, codes.ResourceExhausted, status.Code(batchReadBlobs(ctx, tooLargePath, 10)))
+		})
+		return nil
+	}))
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/clock"
+	"github.com/buildbarn/bb-storage/pkg/servertiming"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ServerTimingTrailerKey is the name of the gRPC response trailer in
+// which the server timing interceptors report the duration of an RPC.
+// Its value uses the same syntax as the HTTP Server-Timing header,
+// with durations expressed in milliseconds:
+//
+//	total;dur=12.345, backend;dur=10.000
+const ServerTimingTrailerKey = "server-timing"
+
+func formatServerTimingDuration(d time.Duration) string {
+	return strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', 3, 64)
+}
+
+func getServerTimingTrailer(serverTiming *servertiming.ServerTiming, totalDuration time.Duration) metadata.MD {
+	return metadata.Pairs(
+		ServerTimingTrailerKey,
+		"total;dur="+formatServerTimingDuration(totalDuration)+", backend;dur="+formatServerTimingDuration(serverTiming.GetBackendDuration()))
+}
+
+// NewServerTimingUnaryInterceptor creates a gRPC request interceptor
+// for unary calls that measures the duration of each call, and returns
+// it to the client as a response trailer. The time spent waiting for
+// storage backends is reported separately.
+func NewServerTimingUnaryInterceptor(clock clock.Clock) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		timeStart := clock.Now()
+		ctxWithServerTiming, serverTiming := servertiming.NewContextWithServerTiming(ctx)
+		resp, err := handler(ctxWithServerTiming, req)
+		grpc.SetTrailer(ctx, getServerTimingTrailer(serverTiming, clock.Now().Sub(timeStart)))
+		return resp, err
+	}
+}
+
+// NewServerTimingStreamInterceptor creates a gRPC request interceptor
+// for streaming calls that measures the duration of each call, and
+// returns it to the client as a response trailer. The time spent
+// waiting for storage backends is reported separately.
+func NewServerTimingStreamInterceptor(clock clock.Clock) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		timeStart := clock.Now()
+		wrappedServerStream := grpc_middleware.WrapServerStream(ss)
+		ctxWithServerTiming, serverTiming := servertiming.NewContextWithServerTiming(ss.Context())
+		wrappedServerStream.WrappedContext = ctxWithServerTiming
+		err := handler(srv, wrappedServerStream)
+		ss.SetTrailer(getServerTimingTrailer(serverTiming, clock.Now().Sub(timeStart)))
+		return err
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"net/http"
+	"time"
 
 	"github.com/buildbarn/bb-storage/pkg/auth"
 	"github.com/buildbarn/bb-storage/pkg/clock"
@@ -29,12 +30,35 @@ type Authenticator interface {
 	Authenticate(w http.ResponseWriter, r *http.Request) (*auth.AuthenticationMetadata, error)
 }
 
+// DefaultMaximumAuthenticationPolicyDepth is the maximum nesting depth
+// of authentication policies that is used by
+// NewAuthenticatorFromConfiguration() if none is provided.
+const DefaultMaximumAuthenticationPolicyDepth = 16
+
 // NewAuthenticatorFromConfiguration creates a tree of Authenticator
 // objects based on a configuration file.
-func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolicy, group program.Group) (Authenticator, error) {
+//
+// Authentication policies such as 'any' and 'accept_header' may contain
+// other policies. To prevent excessive recursion when processing
+// requests, policies that are nested more deeply than maximumDepth are
+// rejected. If maximumDepth is zero,
+// DefaultMaximumAuthenticationPolicyDepth is used.
+func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolicy, group program.Group, maximumDepth int) (Authenticator, error) {
+	if maximumDepth < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Maximum authentication policy depth cannot be negative")
+	} else if maximumDepth == 0 {
+		maximumDepth = DefaultMaximumAuthenticationPolicyDepth
+	}
+	return newAuthenticatorFromConfiguration(policy, group, maximumDepth, 1)
+}
+
+func newAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolicy, group program.Group, maximumDepth, depth int) (Authenticator, error) {
 	if policy == nil {
 		return nil, status.Error(codes.InvalidArgument, "Authentication policy not specified")
 	}
+	if depth > maximumDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "Authentication policy is nested more than %d levels deep", maximumDepth)
+	}
 	switch policyKind := policy.Policy.(type) {
 	case *configuration.AuthenticationPolicy_Allow:
 		authenticationMetadata, err := auth.NewAuthenticationMetadataFromProto(policyKind.Allow)
@@ -45,7 +69,7 @@ func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolic
 	case *configuration.AuthenticationPolicy_Any:
 		children := make([]Authenticator, 0, len(policyKind.Any.Policies))
 		for _, childConfiguration := range policyKind.Any.Policies {
-			child, err := NewAuthenticatorFromConfiguration(childConfiguration, group)
+			child, err := newAuthenticatorFromConfiguration(childConfiguration, group, maximumDepth, depth+1)
 			if err != nil {
 				return nil, err
 			}
@@ -97,6 +121,14 @@ func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolic
 		if err != nil {
 			return nil, util.StatusWrap(err, "Failed to create OIDC HTTP client")
 		}
+		userInfoFallback := policyKind.Oidc.UserInfoFallback
+		var userInfoCacheDuration time.Duration
+		if userInfoFallback != nil {
+			if err := userInfoFallback.CacheDuration.CheckValid(); err != nil {
+				return nil, util.StatusWrapWithCode(err, codes.InvalidArgument, "Invalid OIDC user info cache duration")
+			}
+			userInfoCacheDuration = userInfoFallback.CacheDuration.AsDuration()
+		}
 
 		return NewOIDCAuthenticator(
 			&oauth2.Config{
@@ -117,9 +149,11 @@ func NewAuthenticatorFromConfiguration(policy *configuration.AuthenticationPolic
 			random.CryptoThreadSafeGenerator,
 			cookieName,
 			cookieAEAD,
-			clock.SystemClock)
+			clock.SystemClock,
+			userInfoFallback != nil,
+			userInfoCacheDuration)
 	case *configuration.AuthenticationPolicy_AcceptHeader:
-		base, err := NewAuthenticatorFromConfiguration(policyKind.AcceptHeader.Policy, group)
+		base, err := newAuthenticatorFromConfiguration(policyKind.AcceptHeader.Policy, group, maximumDepth, depth+1)
 		if err != nil {
 			return nil, err
 		}
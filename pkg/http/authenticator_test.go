@@ -0,0 +1,81 @@
+package http_test
+
+import (
+	"testing"
+
+	bb_http "github.com/buildbarn/bb-storage/pkg/http"
+	"github.com/buildbarn/bb-storage/pkg/proto/auth"
+	configuration "github.com/buildbarn/bb-storage/pkg/proto/configuration/http"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newNestedAuthenticationPolicy creates an authentication policy that
+// consists of a given number of levels of 'any' policies, with an
+// 'allow' policy at the bottom.
+func newNestedAuthenticationPolicy(depth int) *configuration.AuthenticationPolicy {
+	policy := &configuration.AuthenticationPolicy{
+		Policy: &configuration.AuthenticationPolicy_Allow{
+			Allow: &auth.AuthenticationMetadata{},
+		},
+	}
+	for i := 1; i < depth; i++ {
+		policy = &configuration.AuthenticationPolicy{
+			Policy: &configuration.AuthenticationPolicy_Any{
+				Any: &configuration.AnyAuthenticationPolicy{
+					Policies: []*configuration.AuthenticationPolicy{
+						{
+							Policy: &configuration.AuthenticationPolicy_Deny{
+								Deny: "Not permitted",
+							},
+						},
+						policy,
+					},
+				},
+			},
+		}
+	}
+	return policy
+}
+
+func TestNewAuthenticatorFromConfigurationMaximumDepth(t *testing.T) {
+	t.Run("WithinLimit", func(t *testing.T) {
+		_, err := bb_http.NewAuthenticatorFromConfiguration(newNestedAuthenticationPolicy(5), nil, 5)
+		require.NoError(t, err)
+	})
+
+	t.Run("ExceedsLimit", func(t *testing.T) {
+		_, err := bb_http.NewAuthenticatorFromConfiguration(newNestedAuthenticationPolicy(6), nil, 5)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Authentication policy is nested more than 5 levels deep"), err)
+	})
+
+	t.Run("AcceptHeader", func(t *testing.T) {
+		// Nesting through 'accept_header' policies should also
+		// be taken into account.
+		_, err := bb_http.NewAuthenticatorFromConfiguration(&configuration.AuthenticationPolicy{
+			Policy: &configuration.AuthenticationPolicy_AcceptHeader{
+				AcceptHeader: &configuration.AcceptHeaderAuthenticationPolicy{
+					MediaTypes: []string{"text/html"},
+					Policy:     newNestedAuthenticationPolicy(5),
+				},
+			},
+		}, nil, 5)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Authentication policy is nested more than 5 levels deep"), err)
+	})
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		_, err := bb_http.NewAuthenticatorFromConfiguration(newNestedAuthenticationPolicy(bb_http.DefaultMaximumAuthenticationPolicyDepth), nil, 0)
+		require.NoError(t, err)
+
+		_, err = bb_http.NewAuthenticatorFromConfiguration(newNestedAuthenticationPolicy(bb_http.DefaultMaximumAuthenticationPolicyDepth+1), nil, 0)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Authentication policy is nested more than 16 levels deep"), err)
+	})
+
+	t.Run("NegativeLimit", func(t *testing.T) {
+		_, err := bb_http.NewAuthenticatorFromConfiguration(newNestedAuthenticationPolicy(1), nil, -1)
+		testutil.RequireEqualStatus(t, status.Error(codes.InvalidArgument, "Maximum authentication policy depth cannot be negative"), err)
+	})
+}
@@ -37,6 +37,9 @@ func NewRoundTripperFromConfiguration(configuration *pb.ClientConfiguration) (ht
 	if headerValues := configuration.GetAddHeaders(); len(headerValues) > 0 {
 		roundTripper = NewHeaderAddingRoundTripper(roundTripper, headerValues)
 	}
+	if userAgent := configuration.GetUserAgent(); userAgent != "" {
+		roundTripper = NewUserAgentPrependingRoundTripper(roundTripper, userAgent)
+	}
 
 	return roundTripper, nil
 }
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/buildbarn/bb-storage/pkg/auth"
@@ -37,12 +39,29 @@ type oidcAuthenticator struct {
 	cookieAEAD            cipher.AEAD
 	cookieNonceSize       int
 	clock                 clock.Clock
+
+	userInfoFallback      bool
+	userInfoCacheDuration time.Duration
+	userInfoCacheLock     sync.Mutex
+	userInfoCache         map[string]oidcCachedUserInfo
+}
+
+type oidcCachedUserInfo struct {
+	claims     interface{}
+	expiration time.Time
 }
 
 // NewOIDCAuthenticator creates an Authenticator that enforces that all
 // requests are authorized by an OAuth2 server. Authentication metadata
 // is constructed by obtaining claims through the OpenID Connect user
 // info endpoint, and transforming it using a JMESPath expression.
+//
+// If userInfoFallback is set, transient failures of the user info
+// endpoint don't cause authentication to fail. Instead, the claims
+// last returned by the user info endpoint for the same subject are
+// used, provided that they were obtained within userInfoCacheDuration.
+// If no such claims are available, the claims contained in the ID
+// token are used.
 func NewOIDCAuthenticator(
 	oauth2Config *oauth2.Config,
 	userInfoURL string,
@@ -52,6 +71,8 @@ func NewOIDCAuthenticator(
 	cookieName string,
 	cookieAEAD cipher.AEAD,
 	clock clock.Clock,
+	userInfoFallback bool,
+	userInfoCacheDuration time.Duration,
 ) (Authenticator, error) {
 	// Extract the path in the redirect URL of the OAuth2
 	// configuration, as we need to match it in incoming HTTP
@@ -71,6 +92,10 @@ func NewOIDCAuthenticator(
 		cookieAEAD:            cookieAEAD,
 		cookieNonceSize:       cookieAEAD.NonceSize(),
 		clock:                 clock,
+
+		userInfoFallback:      userInfoFallback,
+		userInfoCacheDuration: userInfoCacheDuration,
+		userInfoCache:         map[string]oidcCachedUserInfo{},
 	}, nil
 }
 
@@ -122,11 +147,13 @@ func (a *oidcAuthenticator) setCookieValue(w http.ResponseWriter, cookieValue *o
 	return nil
 }
 
-func (a *oidcAuthenticator) getClaimsAndSetCookie(ctx context.Context, token *oauth2.Token, defaultExpiration time.Duration, w http.ResponseWriter) (*auth.AuthenticationMetadata, error) {
-	// Obtain claims from the user info endpoint.
+// getUserInfoClaims obtains claims from the user info endpoint. In
+// addition to the claims, it returns whether a failure is transient,
+// meaning that it may succeed if retried.
+func (a *oidcAuthenticator) getUserInfoClaims(ctx context.Context, token *oauth2.Token) (interface{}, bool, error) {
 	claimsRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, a.userInfoURL, nil)
 	if err != nil {
-		return nil, util.StatusWrap(err, "Failed to create user info request")
+		return nil, false, util.StatusWrap(err, "Failed to create user info request")
 	}
 	claimsResponse, err := a.oauth2Config.Client(ctx, token).Do(claimsRequest)
 	if err != nil {
@@ -136,16 +163,106 @@ func (a *oidcAuthenticator) getClaimsAndSetCookie(ctx context.Context, token *oa
 		if errors.As(err, &urlErr) {
 			err = urlErr.Unwrap()
 		}
-		return nil, util.StatusWrap(err, "Failed to request claims")
+		return nil, true, util.StatusWrap(err, "Failed to request claims")
 	}
 	defer claimsResponse.Body.Close()
 	if claimsResponse.StatusCode != 200 {
-		return nil, status.Errorf(codes.Unavailable, "Requesting claims failed with HTTP status %#v", claimsResponse.Status)
+		transient := claimsResponse.StatusCode == http.StatusTooManyRequests || claimsResponse.StatusCode >= 500
+		return nil, transient, status.Errorf(codes.Unavailable, "Requesting claims failed with HTTP status %#v", claimsResponse.Status)
 	}
 	var claims interface{}
-	err = json.NewDecoder(claimsResponse.Body).Decode(&claims)
+	if err := json.NewDecoder(claimsResponse.Body).Decode(&claims); err != nil {
+		return nil, false, util.StatusWrap(err, "Failed to unmarshal claims")
+	}
+	return claims, false, nil
+}
+
+// getIDTokenClaims extracts the claims contained in the ID token that
+// is returned by the token endpoint, and the subject identifier
+// contained within.
+//
+// The signature of the ID token is not validated, as the ID token is
+// received from the token endpoint directly. As described in OpenID
+// Connect Core 1.0, section 3.1.3.7, TLS server validation may be used
+// to validate the issuer in place of checking the signature.
+func getIDTokenClaims(token *oauth2.Token) (interface{}, string, error) {
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		return nil, "", status.Error(codes.InvalidArgument, "Token response does not contain an ID token")
+	}
+	fields := strings.Split(idToken, ".")
+	if len(fields) != 3 {
+		return nil, "", status.Error(codes.InvalidArgument, "ID token is not a JSON Web Token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(fields[1])
 	if err != nil {
-		return nil, util.StatusWrap(err, "Failed to unmarshal claims")
+		return nil, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to decode ID token payload")
+	}
+	var claims interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, "", util.StatusWrapWithCode(err, codes.InvalidArgument, "Failed to unmarshal ID token claims")
+	}
+	claimsMap, ok := claims.(map[string]interface{})
+	if !ok {
+		return nil, "", status.Error(codes.InvalidArgument, "ID token claims are not a JSON object")
+	}
+	subject, ok := claimsMap["sub"].(string)
+	if !ok || subject == "" {
+		return nil, "", status.Error(codes.InvalidArgument, "ID token does not contain a subject")
+	}
+	issuer, _ := claimsMap["iss"].(string)
+	return claims, issuer + "\x00" + subject, nil
+}
+
+// getClaims obtains claims from the user info endpoint. If a fallback
+// is configured and the user info endpoint is unavailable, it returns
+// cached claims or claims contained in the ID token instead.
+func (a *oidcAuthenticator) getClaims(ctx context.Context, token *oauth2.Token) (interface{}, error) {
+	claims, transient, userInfoErr := a.getUserInfoClaims(ctx, token)
+	if !a.userInfoFallback {
+		return claims, userInfoErr
+	}
+	if userInfoErr != nil && !transient {
+		return nil, userInfoErr
+	}
+
+	idTokenClaims, cacheKey, idTokenErr := getIDTokenClaims(token)
+	now := a.clock.Now()
+	a.userInfoCacheLock.Lock()
+	defer a.userInfoCacheLock.Unlock()
+	if userInfoErr == nil {
+		// Store the claims, so that they can be used while
+		// the user info endpoint is unavailable. Remove cached
+		// claims that have expired.
+		if idTokenErr == nil {
+			for key, cachedUserInfo := range a.userInfoCache {
+				if !now.Before(cachedUserInfo.expiration) {
+					delete(a.userInfoCache, key)
+				}
+			}
+			a.userInfoCache[cacheKey] = oidcCachedUserInfo{
+				claims:     claims,
+				expiration: now.Add(a.userInfoCacheDuration),
+			}
+		}
+		return claims, nil
+	}
+
+	// The user info endpoint is unavailable. Fall back to the most
+	// recently obtained claims, or the claims in the ID token.
+	if idTokenErr != nil {
+		return nil, userInfoErr
+	}
+	if cachedUserInfo, ok := a.userInfoCache[cacheKey]; ok && now.Before(cachedUserInfo.expiration) {
+		return cachedUserInfo.claims, nil
+	}
+	return idTokenClaims, nil
+}
+
+func (a *oidcAuthenticator) getClaimsAndSetCookie(ctx context.Context, token *oauth2.Token, defaultExpiration time.Duration, w http.ResponseWriter) (*auth.AuthenticationMetadata, error) {
+	claims, err := a.getClaims(ctx, token)
+	if err != nil {
+		return nil, err
 	}
 
 	// Convert claims to authentication metadata.
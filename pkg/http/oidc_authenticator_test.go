@@ -3,6 +3,7 @@ package http_test
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -37,8 +38,8 @@ func protoMustMarshal(m proto.Message) []byte {
 	return data
 }
 
-func expectRead(r *mock.MockThreadSafeGenerator, dataToReturn []byte) {
-	r.EXPECT().
+func expectRead(r *mock.MockThreadSafeGenerator, dataToReturn []byte) *gomock.Call {
+	return r.EXPECT().
 		Read(gomock.Len(len(dataToReturn))).
 		DoAndReturn(func(p []byte) (int, error) { return copy(p, dataToReturn), nil })
 }
@@ -74,7 +75,9 @@ func TestOIDCAuthenticator(t *testing.T) {
 		randomNumberGenerator,
 		"CookieName",
 		cookieAEAD,
-		clock)
+		clock,
+		/* userInfoFallback = */ false,
+		/* userInfoCacheDuration = */ 0)
 	require.NoError(t, err)
 
 	t.Run("RegularRequestWithoutCookie", func(t *testing.T) {
@@ -476,3 +479,174 @@ func TestOIDCAuthenticator(t *testing.T) {
 		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Failed to obtain token: Connection reset by peer"), err)
 	})
 }
+
+func TestOIDCAuthenticatorUserInfoFallback(t *testing.T) {
+	ctrl, ctx := gomock.WithContext(context.Background(), t)
+
+	clock := mock.NewMockClock(ctrl)
+	now := time.Unix(1700000000, 0)
+	clock.EXPECT().Now().DoAndReturn(func() time.Time { return now }).AnyTimes()
+	oauth2.TimeNow = clock.Now
+	defer func() { oauth2.TimeNow = time.Now }()
+
+	roundTripper := mock.NewMockRoundTripper(ctrl)
+	randomNumberGenerator := mock.NewMockThreadSafeGenerator(ctrl)
+	cookieAEAD := mock.NewMockAEAD(ctrl)
+	cookieAEAD.EXPECT().NonceSize().Return(4)
+	authenticator, err := bb_http.NewOIDCAuthenticator(
+		&oauth2.Config{
+			ClientID:     "MyClientID",
+			ClientSecret: "MyClientSecret",
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   "https://login.com/authorize",
+				TokenURL:  "https://login.com/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+			RedirectURL: "https://myserver.com/callback",
+			Scopes:      []string{"openid", "email"},
+		},
+		"https://login.com/userinfo",
+		jmespath.MustCompile("{\"public\": {\"email\": email}}"),
+		&http.Client{Transport: roundTripper},
+		randomNumberGenerator,
+		"CookieName",
+		cookieAEAD,
+		clock,
+		/* userInfoFallback = */ true,
+		/* userInfoCacheDuration = */ time.Hour)
+	require.NoError(t, err)
+
+	// Header and payload of the ID token returned by the token
+	// endpoint. The signature is not validated.
+	idToken := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{
+		"iss": "https://login.com",
+		"sub": "1234",
+		"email": "john@idtoken.com"
+	}`)) + ".c2lnbmF0dXJl"
+
+	// Performs a callback request, where the token endpoint
+	// returns an ID token and the user info endpoint responds
+	// using the provided function. The authentication metadata
+	// stored in the resulting cookie is returned.
+	stateVerifier := []byte{0xf1, 0x57, 0x0d, 0xad, 0x3e, 0x38, 0xd8, 0x3d, 0xa4, 0x71, 0x09, 0x65, 0x9f, 0x85, 0xe5, 0x13}
+	performCallback := func(userInfoResponse func() (*http.Response, error)) (*auth.AuthenticationMetadata, error) {
+		cookieAEAD.EXPECT().Open(
+			gomock.Any(),
+			[]byte{0x82, 0xf9, 0x85, 0xf9},
+			[]byte{0xe8, 0xa7, 0x6f, 0x31, 0x58, 0x7e, 0xf0, 0x47},
+			nil,
+		).DoAndReturn(func(dst, nonce, ciphertext, additionalData []byte) ([]byte, error) {
+			return append(dst, protoMustMarshal(&oidc.CookieValue{
+				SessionState: &oidc.CookieValue_Authenticating_{
+					Authenticating: &oidc.CookieValue_Authenticating{
+						StateVerifier:      stateVerifier,
+						OriginalRequestUri: "/index.html",
+					},
+				},
+			})...), nil
+		})
+		roundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			require.Equal(t, "https://login.com/token", r.URL.String())
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"access_token": "AccessToken",
+					"expires_in": 3600,
+					"id_token": "` + idToken + `",
+					"token_type": "Bearer"
+				}`)),
+			}, nil
+		})
+		roundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(r *http.Request) (*http.Response, error) {
+			require.Equal(t, "https://login.com/userinfo", r.URL.String())
+			return userInfoResponse()
+		})
+
+		var cookieValue oidc.CookieValue
+		nonce := []byte{0xcf, 0xcc, 0x43, 0xbd}
+		expectRead(randomNumberGenerator, nonce).MaxTimes(1)
+		cookieAEAD.EXPECT().Seal(gomock.Any(), nonce, gomock.Any(), nil).
+			DoAndReturn(func(dst, nonce, plaintext, additionalData []byte) []byte {
+				require.NoError(t, proto.Unmarshal(plaintext, &cookieValue))
+				return append(dst, 0xf4, 0xf3, 0xe9, 0xd7, 0x19, 0x29, 0x23, 0x83)
+			}).
+			MaxTimes(1)
+
+		w := httptest.NewRecorder()
+		r, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://myserver.com/callback?code=MyCode&state=8VcNrT442D2kcQlln4XlEw", nil)
+		require.NoError(t, err)
+		r.AddCookie(&http.Cookie{
+			Name:  "CookieName",
+			Value: "gvmF-einbzFYfvBH",
+		})
+		if _, err := authenticator.Authenticate(w, r); err != nil {
+			return nil, err
+		}
+		require.Equal(t, http.StatusSeeOther, w.Code)
+		return cookieValue.GetAuthenticated().GetAuthenticationMetadata(), nil
+	}
+	newMetadata := func(email string) *auth.AuthenticationMetadata {
+		return &auth.AuthenticationMetadata{
+			Public: structpb.NewStructValue(&structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"email": structpb.NewStringValue(email),
+				},
+			}),
+		}
+	}
+
+	t.Run("UserInfoSuccess", func(t *testing.T) {
+		// Claims should be obtained through the user info
+		// endpoint if it is available.
+		metadata, err := performCallback(func() (*http.Response, error) {
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"email": "john@userinfo.com"}`)),
+			}, nil
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newMetadata("john@userinfo.com"), metadata)
+	})
+
+	t.Run("TransientFailureCached", func(t *testing.T) {
+		// If the user info endpoint is unavailable, the claims
+		// obtained previously should be used.
+		now = now.Add(30 * time.Minute)
+		metadata, err := performCallback(func() (*http.Response, error) {
+			return &http.Response{
+				Status:     "503 Service Unavailable",
+				StatusCode: 503,
+				Body:       io.NopCloser(bytes.NewBuffer(nil)),
+			}, nil
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newMetadata("john@userinfo.com"), metadata)
+	})
+
+	t.Run("TransientFailureIDToken", func(t *testing.T) {
+		// Once the cached claims have expired, the claims in
+		// the ID token should be used instead.
+		now = now.Add(time.Hour)
+		metadata, err := performCallback(func() (*http.Response, error) {
+			return nil, status.Error(codes.Unavailable, "Connection refused")
+		})
+		require.NoError(t, err)
+		testutil.RequireEqualProto(t, newMetadata("john@idtoken.com"), metadata)
+	})
+
+	t.Run("HardFailure", func(t *testing.T) {
+		// Errors that are not transient should cause
+		// authentication to fail.
+		_, err := performCallback(func() (*http.Response, error) {
+			return &http.Response{
+				Status:     "401 Unauthorized",
+				StatusCode: 401,
+				Body:       io.NopCloser(bytes.NewBuffer(nil)),
+			}, nil
+		})
+		testutil.RequireEqualStatus(t, status.Error(codes.Unavailable, "Requesting claims failed with HTTP status \"401 Unauthorized\""), err)
+	})
+}
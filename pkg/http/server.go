@@ -16,7 +16,10 @@ import (
 func NewServersFromConfigurationAndServe(configurations []*configuration.ServerConfiguration, handler http.Handler, group program.Group) {
 	group.Go(func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
 		for _, configuration := range configurations {
-			authenticator, err := NewAuthenticatorFromConfiguration(configuration.AuthenticationPolicy, dependenciesGroup)
+			authenticator, err := NewAuthenticatorFromConfiguration(
+				configuration.AuthenticationPolicy,
+				dependenciesGroup,
+				int(configuration.MaximumAuthenticationPolicyDepth))
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"net/http"
+)
+
+type userAgentPrependingRoundTripper struct {
+	base      http.RoundTripper
+	userAgent string
+}
+
+// NewUserAgentPrependingRoundTripper is a decorator for RoundTripper
+// that prepends a string to the User-Agent header of all outgoing
+// requests. Any User-Agent provided by the caller (e.g., the name and
+// version of a cloud provider's SDK) is retained, so that it remains
+// visible in access logs of the server.
+func NewUserAgentPrependingRoundTripper(base http.RoundTripper, userAgent string) http.RoundTripper {
+	return &userAgentPrependingRoundTripper{
+		base:      base,
+		userAgent: userAgent,
+	}
+}
+
+func (rt *userAgentPrependingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	newReq := *req
+	newReq.Header = req.Header.Clone()
+	if newReq.Header == nil {
+		newReq.Header = http.Header{}
+	}
+	if existing := req.Header.Get("User-Agent"); existing != "" {
+		newReq.Header.Set("User-Agent", rt.userAgent+" "+existing)
+	} else {
+		newReq.Header.Set("User-Agent", rt.userAgent)
+	}
+	return rt.base.RoundTrip(&newReq)
+}
@@ -0,0 +1,53 @@
+package http_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	bb_http "github.com/buildbarn/bb-storage/pkg/http"
+	"github.com/stretchr/testify/require"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserAgentPrependingRoundTripper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	baseRoundTripper := mock.NewMockRoundTripper(ctrl)
+	roundTripper := bb_http.NewUserAgentPrependingRoundTripper(baseRoundTripper, "buildbarn-cluster-eu")
+
+	t.Run("NoUserAgent", func(t *testing.T) {
+		// If the request does not contain a User-Agent, the
+		// configured value should be used as is.
+		req, err := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+		require.NoError(t, err)
+		baseRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				require.Equal(t, "buildbarn-cluster-eu", req.Header.Get("User-Agent"))
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			})
+
+		resp, err := roundTripper.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("ExistingUserAgent", func(t *testing.T) {
+		// User-Agents set by the caller should be retained. The
+		// original request should not be modified.
+		req, err := http.NewRequest(http.MethodGet, "https://example.com/", nil)
+		require.NoError(t, err)
+		req.Header.Set("User-Agent", "aws-sdk-go-v2/1.32.4")
+		baseRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(
+			func(req *http.Request) (*http.Response, error) {
+				require.Equal(t, "buildbarn-cluster-eu aws-sdk-go-v2/1.32.4", req.Header.Get("User-Agent"))
+				return &http.Response{StatusCode: http.StatusOK}, nil
+			})
+
+		resp, err := roundTripper.RoundTrip(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "aws-sdk-go-v2/1.32.4", req.Header.Get("User-Agent"))
+	})
+}
@@ -0,0 +1,57 @@
+package program
+
+import (
+	"context"
+	"time"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ShutdownHook is a best-effort callback that is invoked during
+// graceful shutdown. It can be used by components that buffer work in
+// memory (e.g., queues of pending updates) to flush that work before
+// the process exits.
+type ShutdownHook func(ctx context.Context) error
+
+// NewShutdownHookRoutine creates a Routine that blocks until shutdown
+// is initiated, after which it invokes a ShutdownHook. The hook is
+// provided a context that expires after the provided timeout. If the
+// hook does not return within this timeout, the routine terminates
+// regardless, so that a slow hook cannot prevent the process from
+// exiting.
+//
+// As hooks are best-effort, failures and timeouts are reported through
+// the provided ErrorLogger, as opposed to causing the routine to fail.
+// This prevents a failing hook from causing the process to terminate
+// with a non-zero exit code, after all other work has completed
+// successfully.
+//
+// The resulting routine should be launched as a sibling of the
+// routines that submit work to the component being flushed, or as a
+// dependency of the routines that still make use of it. That way the
+// hook is invoked at the right point during shutdown.
+func NewShutdownHookRoutine(name string, hook ShutdownHook, timeout time.Duration, errorLogger util.ErrorLogger) Routine {
+	return func(ctx context.Context, siblingsGroup, dependenciesGroup Group) error {
+		<-ctx.Done()
+
+		hookCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), timeout)
+		defer cancel()
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- hook(hookCtx)
+		}()
+
+		select {
+		case err := <-errChan:
+			if err != nil {
+				errorLogger.Log(util.StatusWrapf(err, "Shutdown hook %#v failed", name))
+			}
+		case <-hookCtx.Done():
+			errorLogger.Log(status.Errorf(codes.DeadlineExceeded, "Shutdown hook %#v did not complete within %s", name, timeout))
+		}
+		return nil
+	}
+}
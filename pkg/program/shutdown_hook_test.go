@@ -0,0 +1,76 @@
+package program_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/buildbarn/bb-storage/internal/mock"
+	"github.com/buildbarn/bb-storage/pkg/program"
+	"github.com/buildbarn/bb-storage/pkg/testutil"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestShutdownHookRoutine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	t.Run("Success", func(t *testing.T) {
+		// The hook should only be invoked after shutdown has
+		// been initiated.
+		ctx, cancel := context.WithCancel(context.Background())
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		flushed := make(chan struct{})
+		require.NoError(t, program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			siblingsGroup.Go(program.NewShutdownHookRoutine("queue", func(ctx context.Context) error {
+				close(flushed)
+				return nil
+			}, time.Minute, errorLogger))
+			select {
+			case <-flushed:
+				t.Error("Shutdown hook was invoked before shutdown was initiated")
+			default:
+			}
+			cancel()
+			return nil
+		}))
+		<-flushed
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		// Failures of hooks should be logged, but should not
+		// cause shutdown to fail.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.Unavailable, "Shutdown hook \"queue\" failed: Server offline")))
+		require.NoError(t, program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			siblingsGroup.Go(program.NewShutdownHookRoutine("queue", func(ctx context.Context) error {
+				return status.Error(codes.Unavailable, "Server offline")
+			}, time.Minute, errorLogger))
+			return nil
+		}))
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		// A hook that does not respect the deadline of its
+		// context should not prevent shutdown from completing.
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		errorLogger := mock.NewMockErrorLogger(ctrl)
+		errorLogger.EXPECT().Log(testutil.EqStatus(t, status.Error(codes.DeadlineExceeded, "Shutdown hook \"queue\" did not complete within 10ms")))
+		unblock := make(chan struct{})
+		defer close(unblock)
+		require.NoError(t, program.RunLocal(ctx, func(ctx context.Context, siblingsGroup, dependenciesGroup program.Group) error {
+			siblingsGroup.Go(program.NewShutdownHookRoutine("queue", func(ctx context.Context) error {
+				<-unblock
+				return nil
+			}, 10*time.Millisecond, errorLogger))
+			return nil
+		}))
+	})
+}
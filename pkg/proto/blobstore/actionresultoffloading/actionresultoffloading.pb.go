@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.29.1
+// source: pkg/proto/blobstore/actionresultoffloading/actionresultoffloading.proto
+
+package actionresultoffloading
+
+import (
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OffloadedActionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActionResultDigest *v2.Digest `protobuf:"bytes,1,opt,name=action_result_digest,json=actionResultDigest,proto3" json:"action_result_digest,omitempty"`
+}
+
+func (x *OffloadedActionResult) Reset() {
+	*x = OffloadedActionResult{}
+	mi := &file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffloadedActionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffloadedActionResult) ProtoMessage() {}
+
+func (x *OffloadedActionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffloadedActionResult.ProtoReflect.Descriptor instead.
+func (*OffloadedActionResult) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OffloadedActionResult) GetActionResultDigest() *v2.Digest {
+	if x != nil {
+		return x.ActionResultDigest
+	}
+	return nil
+}
+
+var File_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDesc = []byte{
+	0x0a, 0x47, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x2a, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x6f, 0x66, 0x66, 0x6c, 0x6f,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x36, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x62, 0x61, 0x7a,
+	0x65, 0x6c, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x72, 0x0a,
+	0x15, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x59, 0x0a, 0x14, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a,
+	0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x12, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescOnce sync.Once
+	file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescData = file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDesc
+)
+
+func file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescGZIP() []byte {
+	file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescData)
+	})
+	return file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDescData
+}
+
+var file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_goTypes = []any{
+	(*OffloadedActionResult)(nil), // 0: buildbarn.blobstore.actionresultoffloading.OffloadedActionResult
+	(*v2.Digest)(nil),             // 1: build.bazel.remote.execution.v2.Digest
+}
+var file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_depIdxs = []int32{
+	1, // 0: buildbarn.blobstore.actionresultoffloading.OffloadedActionResult.action_result_digest:type_name -> build.bazel.remote.execution.v2.Digest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_init() }
+func file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_init() {
+	if File_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto = out.File
+	file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_rawDesc = nil
+	file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_goTypes = nil
+	file_pkg_proto_blobstore_actionresultoffloading_actionresultoffloading_proto_depIdxs = nil
+}
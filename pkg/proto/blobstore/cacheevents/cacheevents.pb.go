@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.29.1
+// source: pkg/proto/blobstore/cacheevents/cacheevents.proto
+
+package cacheevents
+
+import (
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	auth "github.com/buildbarn/bb-storage/pkg/proto/auth"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CacheEvent_Operation int32
+
+const (
+	CacheEvent_UNKNOWN      CacheEvent_Operation = 0
+	CacheEvent_GET          CacheEvent_Operation = 1
+	CacheEvent_PUT          CacheEvent_Operation = 2
+	CacheEvent_FIND_MISSING CacheEvent_Operation = 3
+	CacheEvent_EVICT        CacheEvent_Operation = 4
+)
+
+// Enum value maps for CacheEvent_Operation.
+var (
+	CacheEvent_Operation_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "GET",
+		2: "PUT",
+		3: "FIND_MISSING",
+		4: "EVICT",
+	}
+	CacheEvent_Operation_value = map[string]int32{
+		"UNKNOWN":      0,
+		"GET":          1,
+		"PUT":          2,
+		"FIND_MISSING": 3,
+		"EVICT":        4,
+	}
+)
+
+func (x CacheEvent_Operation) Enum() *CacheEvent_Operation {
+	p := new(CacheEvent_Operation)
+	*p = x
+	return p
+}
+
+func (x CacheEvent_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CacheEvent_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_proto_blobstore_cacheevents_cacheevents_proto_enumTypes[0].Descriptor()
+}
+
+func (CacheEvent_Operation) Type() protoreflect.EnumType {
+	return &file_pkg_proto_blobstore_cacheevents_cacheevents_proto_enumTypes[0]
+}
+
+func (x CacheEvent_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CacheEvent_Operation.Descriptor instead.
+func (CacheEvent_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type CacheEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp      *timestamppb.Timestamp       `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	StorageType    string                       `protobuf:"bytes,2,opt,name=storage_type,json=storageType,proto3" json:"storage_type,omitempty"`
+	InstanceName   string                       `protobuf:"bytes,3,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	DigestFunction v2.DigestFunction_Value      `protobuf:"varint,4,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	Digest         *v2.Digest                   `protobuf:"bytes,5,opt,name=digest,proto3" json:"digest,omitempty"`
+	Operation      CacheEvent_Operation         `protobuf:"varint,6,opt,name=operation,proto3,enum=buildbarn.blobstore.cacheevents.CacheEvent_Operation" json:"operation,omitempty"`
+	Hit            bool                         `protobuf:"varint,7,opt,name=hit,proto3" json:"hit,omitempty"`
+	Principal      *auth.AuthenticationMetadata `protobuf:"bytes,8,opt,name=principal,proto3" json:"principal,omitempty"`
+	Age            *durationpb.Duration         `protobuf:"bytes,9,opt,name=age,proto3" json:"age,omitempty"`
+	Refreshed      bool                         `protobuf:"varint,10,opt,name=refreshed,proto3" json:"refreshed,omitempty"`
+}
+
+func (x *CacheEvent) Reset() {
+	*x = CacheEvent{}
+	mi := &file_pkg_proto_blobstore_cacheevents_cacheevents_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheEvent) ProtoMessage() {}
+
+func (x *CacheEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_cacheevents_cacheevents_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheEvent.ProtoReflect.Descriptor instead.
+func (*CacheEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CacheEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *CacheEvent) GetStorageType() string {
+	if x != nil {
+		return x.StorageType
+	}
+	return ""
+}
+
+func (x *CacheEvent) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *CacheEvent) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+func (x *CacheEvent) GetDigest() *v2.Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *CacheEvent) GetOperation() CacheEvent_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return CacheEvent_UNKNOWN
+}
+
+func (x *CacheEvent) GetHit() bool {
+	if x != nil {
+		return x.Hit
+	}
+	return false
+}
+
+func (x *CacheEvent) GetPrincipal() *auth.AuthenticationMetadata {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *CacheEvent) GetAge() *durationpb.Duration {
+	if x != nil {
+		return x.Age
+	}
+	return nil
+}
+
+func (x *CacheEvent) GetRefreshed() bool {
+	if x != nil {
+		return x.Refreshed
+	}
+	return false
+}
+
+var File_pkg_proto_blobstore_cacheevents_cacheevents_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x36, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x62, 0x61, 0x7a, 0x65,
+	0x6c, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x61, 0x75,
+	0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf0, 0x04, 0x0a, 0x0a, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c,
+	0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x06, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x10, 0x0a, 0x03, 0x68, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x68, 0x69,
+	0x74, 0x12, 0x44, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x03, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x65, 0x64, 0x22, 0x47, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x45, 0x54, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x46, 0x49, 0x4e, 0x44, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x03,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x56, 0x49, 0x43, 0x54, 0x10, 0x04, 0x42, 0x41, 0x5a, 0x3f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
+	0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescOnce sync.Once
+	file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescData = file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDesc
+)
+
+func file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescGZIP() []byte {
+	file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescData)
+	})
+	return file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDescData
+}
+
+var file_pkg_proto_blobstore_cacheevents_cacheevents_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_proto_blobstore_cacheevents_cacheevents_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_blobstore_cacheevents_cacheevents_proto_goTypes = []any{
+	(CacheEvent_Operation)(0),           // 0: buildbarn.blobstore.cacheevents.CacheEvent.Operation
+	(*CacheEvent)(nil),                  // 1: buildbarn.blobstore.cacheevents.CacheEvent
+	(*timestamppb.Timestamp)(nil),       // 2: google.protobuf.Timestamp
+	(v2.DigestFunction_Value)(0),        // 3: build.bazel.remote.execution.v2.DigestFunction.Value
+	(*v2.Digest)(nil),                   // 4: build.bazel.remote.execution.v2.Digest
+	(*auth.AuthenticationMetadata)(nil), // 5: buildbarn.auth.AuthenticationMetadata
+	(*durationpb.Duration)(nil),         // 6: google.protobuf.Duration
+}
+var file_pkg_proto_blobstore_cacheevents_cacheevents_proto_depIdxs = []int32{
+	2, // 0: buildbarn.blobstore.cacheevents.CacheEvent.timestamp:type_name -> google.protobuf.Timestamp
+	3, // 1: buildbarn.blobstore.cacheevents.CacheEvent.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	4, // 2: buildbarn.blobstore.cacheevents.CacheEvent.digest:type_name -> build.bazel.remote.execution.v2.Digest
+	0, // 3: buildbarn.blobstore.cacheevents.CacheEvent.operation:type_name -> buildbarn.blobstore.cacheevents.CacheEvent.Operation
+	5, // 4: buildbarn.blobstore.cacheevents.CacheEvent.principal:type_name -> buildbarn.auth.AuthenticationMetadata
+	6, // 5: buildbarn.blobstore.cacheevents.CacheEvent.age:type_name -> google.protobuf.Duration
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_blobstore_cacheevents_cacheevents_proto_init() }
+func file_pkg_proto_blobstore_cacheevents_cacheevents_proto_init() {
+	if File_pkg_proto_blobstore_cacheevents_cacheevents_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_blobstore_cacheevents_cacheevents_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_blobstore_cacheevents_cacheevents_proto_depIdxs,
+		EnumInfos:         file_pkg_proto_blobstore_cacheevents_cacheevents_proto_enumTypes,
+		MessageInfos:      file_pkg_proto_blobstore_cacheevents_cacheevents_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_blobstore_cacheevents_cacheevents_proto = out.File
+	file_pkg_proto_blobstore_cacheevents_cacheevents_proto_rawDesc = nil
+	file_pkg_proto_blobstore_cacheevents_cacheevents_proto_goTypes = nil
+	file_pkg_proto_blobstore_cacheevents_cacheevents_proto_depIdxs = nil
+}
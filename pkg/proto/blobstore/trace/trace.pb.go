@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.29.1
+// source: pkg/proto/blobstore/trace/trace.proto
+
+package trace
+
+import (
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimeSinceStart *durationpb.Duration    `protobuf:"bytes,1,opt,name=time_since_start,json=timeSinceStart,proto3" json:"time_since_start,omitempty"`
+	InstanceName   string                  `protobuf:"bytes,2,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	DigestFunction v2.DigestFunction_Value `protobuf:"varint,3,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	// Types that are assignable to Kind:
+	//
+	//	*Operation_Get
+	//	*Operation_Put
+	//	*Operation_FindMissing_
+	Kind isOperation_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	mi := &file_pkg_proto_blobstore_trace_trace_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_trace_trace_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_trace_trace_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Operation) GetTimeSinceStart() *durationpb.Duration {
+	if x != nil {
+		return x.TimeSinceStart
+	}
+	return nil
+}
+
+func (x *Operation) GetInstanceName() string {
+	if x != nil {
+		return x.InstanceName
+	}
+	return ""
+}
+
+func (x *Operation) GetDigestFunction() v2.DigestFunction_Value {
+	if x != nil {
+		return x.DigestFunction
+	}
+	return v2.DigestFunction_Value(0)
+}
+
+func (m *Operation) GetKind() isOperation_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Operation) GetGet() *v2.Digest {
+	if x, ok := x.GetKind().(*Operation_Get); ok {
+		return x.Get
+	}
+	return nil
+}
+
+func (x *Operation) GetPut() *v2.Digest {
+	if x, ok := x.GetKind().(*Operation_Put); ok {
+		return x.Put
+	}
+	return nil
+}
+
+func (x *Operation) GetFindMissing() *Operation_FindMissing {
+	if x, ok := x.GetKind().(*Operation_FindMissing_); ok {
+		return x.FindMissing
+	}
+	return nil
+}
+
+type isOperation_Kind interface {
+	isOperation_Kind()
+}
+
+type Operation_Get struct {
+	Get *v2.Digest `protobuf:"bytes,4,opt,name=get,proto3,oneof"`
+}
+
+type Operation_Put struct {
+	Put *v2.Digest `protobuf:"bytes,5,opt,name=put,proto3,oneof"`
+}
+
+type Operation_FindMissing_ struct {
+	FindMissing *Operation_FindMissing `protobuf:"bytes,6,opt,name=find_missing,json=findMissing,proto3,oneof"`
+}
+
+func (*Operation_Get) isOperation_Kind() {}
+
+func (*Operation_Put) isOperation_Kind() {}
+
+func (*Operation_FindMissing_) isOperation_Kind() {}
+
+type Operation_FindMissing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Digests []*v2.Digest `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty"`
+}
+
+func (x *Operation_FindMissing) Reset() {
+	*x = Operation_FindMissing{}
+	mi := &file_pkg_proto_blobstore_trace_trace_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operation_FindMissing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation_FindMissing) ProtoMessage() {}
+
+func (x *Operation_FindMissing) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_blobstore_trace_trace_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation_FindMissing.ProtoReflect.Descriptor instead.
+func (*Operation_FindMissing) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_blobstore_trace_trace_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Operation_FindMissing) GetDigests() []*v2.Digest {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+var File_pkg_proto_blobstore_trace_trace_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_blobstore_trace_trace_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
+	0x72, 0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x65, 0x1a, 0x36, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2f,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x04, 0x0a, 0x09, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x66, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x67, 0x65, 0x74, 0x12,
+	0x3b, 0x0a, 0x03, 0x70, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44,
+	0x69, 0x67, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x03, 0x70, 0x75, 0x74, 0x12, 0x55, 0x0a, 0x0c,
+	0x66, 0x69, 0x6e, 0x64, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x65, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x1a, 0x50, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x12, 0x41, 0x0a, 0x07, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65,
+	0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x07, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x3b, 0x5a,
+	0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_blobstore_trace_trace_proto_rawDescOnce sync.Once
+	file_pkg_proto_blobstore_trace_trace_proto_rawDescData = file_pkg_proto_blobstore_trace_trace_proto_rawDesc
+)
+
+func file_pkg_proto_blobstore_trace_trace_proto_rawDescGZIP() []byte {
+	file_pkg_proto_blobstore_trace_trace_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_blobstore_trace_trace_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_blobstore_trace_trace_proto_rawDescData)
+	})
+	return file_pkg_proto_blobstore_trace_trace_proto_rawDescData
+}
+
+var file_pkg_proto_blobstore_trace_trace_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_pkg_proto_blobstore_trace_trace_proto_goTypes = []any{
+	(*Operation)(nil),             // 0: buildbarn.blobstore.trace.Operation
+	(*Operation_FindMissing)(nil), // 1: buildbarn.blobstore.trace.Operation.FindMissing
+	(*durationpb.Duration)(nil),   // 2: google.protobuf.Duration
+	(v2.DigestFunction_Value)(0),  // 3: build.bazel.remote.execution.v2.DigestFunction.Value
+	(*v2.Digest)(nil),             // 4: build.bazel.remote.execution.v2.Digest
+}
+var file_pkg_proto_blobstore_trace_trace_proto_depIdxs = []int32{
+	2, // 0: buildbarn.blobstore.trace.Operation.time_since_start:type_name -> google.protobuf.Duration
+	3, // 1: buildbarn.blobstore.trace.Operation.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	4, // 2: buildbarn.blobstore.trace.Operation.get:type_name -> build.bazel.remote.execution.v2.Digest
+	4, // 3: buildbarn.blobstore.trace.Operation.put:type_name -> build.bazel.remote.execution.v2.Digest
+	1, // 4: buildbarn.blobstore.trace.Operation.find_missing:type_name -> buildbarn.blobstore.trace.Operation.FindMissing
+	4, // 5: buildbarn.blobstore.trace.Operation.FindMissing.digests:type_name -> build.bazel.remote.execution.v2.Digest
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_blobstore_trace_trace_proto_init() }
+func file_pkg_proto_blobstore_trace_trace_proto_init() {
+	if File_pkg_proto_blobstore_trace_trace_proto != nil {
+		return
+	}
+	file_pkg_proto_blobstore_trace_trace_proto_msgTypes[0].OneofWrappers = []any{
+		(*Operation_Get)(nil),
+		(*Operation_Put)(nil),
+		(*Operation_FindMissing_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_blobstore_trace_trace_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_blobstore_trace_trace_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_blobstore_trace_trace_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_blobstore_trace_trace_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_blobstore_trace_trace_proto = out.File
+	file_pkg_proto_blobstore_trace_trace_proto_rawDesc = nil
+	file_pkg_proto_blobstore_trace_trace_proto_goTypes = nil
+	file_pkg_proto_blobstore_trace_trace_proto_depIdxs = nil
+}
@@ -27,17 +27,20 @@ type ApplicationConfiguration struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source                  *blobstore.BlobAccessConfiguration     `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Sink                    *blobstore.BlobAccessConfiguration     `protobuf:"bytes,2,opt,name=sink,proto3" json:"sink,omitempty"`
-	Replicator              *blobstore.BlobReplicatorConfiguration `protobuf:"bytes,3,opt,name=replicator,proto3" json:"replicator,omitempty"`
-	InstanceName            string                                 `protobuf:"bytes,4,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
-	Actions                 []*v2.Digest                           `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
-	Blobs                   []*v2.Digest                           `protobuf:"bytes,6,rep,name=blobs,proto3" json:"blobs,omitempty"`
-	Directories             []*v2.Digest                           `protobuf:"bytes,7,rep,name=directories,proto3" json:"directories,omitempty"`
-	Trees                   []*v2.Digest                           `protobuf:"bytes,8,rep,name=trees,proto3" json:"trees,omitempty"`
-	MaximumMessageSizeBytes int64                                  `protobuf:"varint,9,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
-	TraversalConcurrency    int32                                  `protobuf:"varint,10,opt,name=traversal_concurrency,json=traversalConcurrency,proto3" json:"traversal_concurrency,omitempty"`
-	DigestFunction          v2.DigestFunction_Value                `protobuf:"varint,11,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	Source                        *blobstore.BlobAccessConfiguration     `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Sink                          *blobstore.BlobAccessConfiguration     `protobuf:"bytes,2,opt,name=sink,proto3" json:"sink,omitempty"`
+	Replicator                    *blobstore.BlobReplicatorConfiguration `protobuf:"bytes,3,opt,name=replicator,proto3" json:"replicator,omitempty"`
+	InstanceName                  string                                 `protobuf:"bytes,4,opt,name=instance_name,json=instanceName,proto3" json:"instance_name,omitempty"`
+	Actions                       []*v2.Digest                           `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	Blobs                         []*v2.Digest                           `protobuf:"bytes,6,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Directories                   []*v2.Digest                           `protobuf:"bytes,7,rep,name=directories,proto3" json:"directories,omitempty"`
+	Trees                         []*v2.Digest                           `protobuf:"bytes,8,rep,name=trees,proto3" json:"trees,omitempty"`
+	MaximumMessageSizeBytes       int64                                  `protobuf:"varint,9,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
+	TraversalConcurrency          int32                                  `protobuf:"varint,10,opt,name=traversal_concurrency,json=traversalConcurrency,proto3" json:"traversal_concurrency,omitempty"`
+	DigestFunction                v2.DigestFunction_Value                `protobuf:"varint,11,opt,name=digest_function,json=digestFunction,proto3,enum=build.bazel.remote.execution.v2.DigestFunction_Value" json:"digest_function,omitempty"`
+	ZipArchives                   []*ZIPArchiveConfiguration             `protobuf:"bytes,12,rep,name=zip_archives,json=zipArchives,proto3" json:"zip_archives,omitempty"`
+	BlobsConcurrency              int32                                  `protobuf:"varint,13,opt,name=blobs_concurrency,json=blobsConcurrency,proto3" json:"blobs_concurrency,omitempty"`
+	TraversalMaximumInFlightBytes int64                                  `protobuf:"varint,14,opt,name=traversal_maximum_in_flight_bytes,json=traversalMaximumInFlightBytes,proto3" json:"traversal_maximum_in_flight_bytes,omitempty"`
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -147,6 +150,80 @@ func (x *ApplicationConfiguration) GetDigestFunction() v2.DigestFunction_Value {
 	return v2.DigestFunction_Value(0)
 }
 
+func (x *ApplicationConfiguration) GetZipArchives() []*ZIPArchiveConfiguration {
+	if x != nil {
+		return x.ZipArchives
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetBlobsConcurrency() int32 {
+	if x != nil {
+		return x.BlobsConcurrency
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetTraversalMaximumInFlightBytes() int64 {
+	if x != nil {
+		return x.TraversalMaximumInFlightBytes
+	}
+	return 0
+}
+
+type ZIPArchiveConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path         string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	HashPrefixes []string `protobuf:"bytes,2,rep,name=hash_prefixes,json=hashPrefixes,proto3" json:"hash_prefixes,omitempty"`
+}
+
+func (x *ZIPArchiveConfiguration) Reset() {
+	*x = ZIPArchiveConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZIPArchiveConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZIPArchiveConfiguration) ProtoMessage() {}
+
+func (x *ZIPArchiveConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZIPArchiveConfiguration.ProtoReflect.Descriptor instead.
+func (*ZIPArchiveConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ZIPArchiveConfiguration) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ZIPArchiveConfiguration) GetHashPrefixes() []string {
+	if x != nil {
+		return x.HashPrefixes
+	}
+	return nil
+}
+
 var File_pkg_proto_configuration_bb_copy_bb_copy_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
@@ -161,7 +238,7 @@ var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x06, 0x0a, 0x18,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x07, 0x0a, 0x18,
 	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
@@ -211,8 +288,26 @@ var file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc = []byte{
 	0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78,
 	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73,
 	0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x5b, 0x0a, 0x0c, 0x7a, 0x69, 0x70, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72,
+	0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x62, 0x62, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x2e, 0x5a, 0x49, 0x50, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x7a, 0x69, 0x70, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x43, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x48, 0x0a, 0x21, 0x74, 0x72, 0x61,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x69,
+	0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x1d, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x4d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x17, 0x5a, 0x49, 0x50, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x61, 0x73, 0x68, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -227,28 +322,30 @@ func file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDescData
 }
 
-var file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_configuration_bb_copy_bb_copy_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_pkg_proto_configuration_bb_copy_bb_copy_proto_goTypes = []any{
 	(*ApplicationConfiguration)(nil),              // 0: buildbarn.configuration.bb_copy.ApplicationConfiguration
-	(*blobstore.BlobAccessConfiguration)(nil),     // 1: buildbarn.configuration.blobstore.BlobAccessConfiguration
-	(*blobstore.BlobReplicatorConfiguration)(nil), // 2: buildbarn.configuration.blobstore.BlobReplicatorConfiguration
-	(*v2.Digest)(nil),                             // 3: build.bazel.remote.execution.v2.Digest
-	(v2.DigestFunction_Value)(0),                  // 4: build.bazel.remote.execution.v2.DigestFunction.Value
+	(*ZIPArchiveConfiguration)(nil),               // 1: buildbarn.configuration.bb_copy.ZIPArchiveConfiguration
+	(*blobstore.BlobAccessConfiguration)(nil),     // 2: buildbarn.configuration.blobstore.BlobAccessConfiguration
+	(*blobstore.BlobReplicatorConfiguration)(nil), // 3: buildbarn.configuration.blobstore.BlobReplicatorConfiguration
+	(*v2.Digest)(nil),                             // 4: build.bazel.remote.execution.v2.Digest
+	(v2.DigestFunction_Value)(0),                  // 5: build.bazel.remote.execution.v2.DigestFunction.Value
 }
 var file_pkg_proto_configuration_bb_copy_bb_copy_proto_depIdxs = []int32{
-	1, // 0: buildbarn.configuration.bb_copy.ApplicationConfiguration.source:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
-	1, // 1: buildbarn.configuration.bb_copy.ApplicationConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
-	2, // 2: buildbarn.configuration.bb_copy.ApplicationConfiguration.replicator:type_name -> buildbarn.configuration.blobstore.BlobReplicatorConfiguration
-	3, // 3: buildbarn.configuration.bb_copy.ApplicationConfiguration.actions:type_name -> build.bazel.remote.execution.v2.Digest
-	3, // 4: buildbarn.configuration.bb_copy.ApplicationConfiguration.blobs:type_name -> build.bazel.remote.execution.v2.Digest
-	3, // 5: buildbarn.configuration.bb_copy.ApplicationConfiguration.directories:type_name -> build.bazel.remote.execution.v2.Digest
-	3, // 6: buildbarn.configuration.bb_copy.ApplicationConfiguration.trees:type_name -> build.bazel.remote.execution.v2.Digest
-	4, // 7: buildbarn.configuration.bb_copy.ApplicationConfiguration.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	2, // 0: buildbarn.configuration.bb_copy.ApplicationConfiguration.source:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	2, // 1: buildbarn.configuration.bb_copy.ApplicationConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	3, // 2: buildbarn.configuration.bb_copy.ApplicationConfiguration.replicator:type_name -> buildbarn.configuration.blobstore.BlobReplicatorConfiguration
+	4, // 3: buildbarn.configuration.bb_copy.ApplicationConfiguration.actions:type_name -> build.bazel.remote.execution.v2.Digest
+	4, // 4: buildbarn.configuration.bb_copy.ApplicationConfiguration.blobs:type_name -> build.bazel.remote.execution.v2.Digest
+	4, // 5: buildbarn.configuration.bb_copy.ApplicationConfiguration.directories:type_name -> build.bazel.remote.execution.v2.Digest
+	4, // 6: buildbarn.configuration.bb_copy.ApplicationConfiguration.trees:type_name -> build.bazel.remote.execution.v2.Digest
+	5, // 7: buildbarn.configuration.bb_copy.ApplicationConfiguration.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
+	1, // 8: buildbarn.configuration.bb_copy.ApplicationConfiguration.zip_archives:type_name -> buildbarn.configuration.bb_copy.ZIPArchiveConfiguration
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_configuration_bb_copy_bb_copy_proto_init() }
@@ -262,7 +359,7 @@ func file_pkg_proto_configuration_bb_copy_bb_copy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_configuration_bb_copy_bb_copy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v5.29.1
+// source: pkg/proto/configuration/bb_replay/bb_replay.proto
+
+package bb_replay
+
+import (
+	blobstore "github.com/buildbarn/bb-storage/pkg/proto/configuration/blobstore"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApplicationConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backend                 *blobstore.BlobAccessConfiguration `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	RecordingPath           string                             `protobuf:"bytes,2,opt,name=recording_path,json=recordingPath,proto3" json:"recording_path,omitempty"`
+	Speed                   float64                            `protobuf:"fixed64,3,opt,name=speed,proto3" json:"speed,omitempty"`
+	Concurrency             int32                              `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	MaximumMessageSizeBytes int64                              `protobuf:"varint,5,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
+}
+
+func (x *ApplicationConfiguration) Reset() {
+	*x = ApplicationConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_replay_bb_replay_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplicationConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplicationConfiguration) ProtoMessage() {}
+
+func (x *ApplicationConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_replay_bb_replay_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplicationConfiguration.ProtoReflect.Descriptor instead.
+func (*ApplicationConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplicationConfiguration) GetBackend() *blobstore.BlobAccessConfiguration {
+	if x != nil {
+		return x.Backend
+	}
+	return nil
+}
+
+func (x *ApplicationConfiguration) GetRecordingPath() string {
+	if x != nil {
+		return x.RecordingPath
+	}
+	return ""
+}
+
+func (x *ApplicationConfiguration) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetConcurrency() int32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+func (x *ApplicationConfiguration) GetMaximumMessageSizeBytes() int64 {
+	if x != nil {
+		return x.MaximumMessageSizeBytes
+	}
+	return 0
+}
+
+var File_pkg_proto_configuration_bb_replay_bb_replay_proto protoreflect.FileDescriptor
+
+var file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDesc = []byte{
+	0x0a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x2f, 0x62, 0x62, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x21, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x18, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62,
+	0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x62,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x1a, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x17, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e,
+	0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescOnce sync.Once
+	file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescData = file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDesc
+)
+
+func file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescGZIP() []byte {
+	file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescOnce.Do(func() {
+		file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescData)
+	})
+	return file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDescData
+}
+
+var file_pkg_proto_configuration_bb_replay_bb_replay_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_configuration_bb_replay_bb_replay_proto_goTypes = []any{
+	(*ApplicationConfiguration)(nil),          // 0: buildbarn.configuration.bb_replay.ApplicationConfiguration
+	(*blobstore.BlobAccessConfiguration)(nil), // 1: buildbarn.configuration.blobstore.BlobAccessConfiguration
+}
+var file_pkg_proto_configuration_bb_replay_bb_replay_proto_depIdxs = []int32{
+	1, // 0: buildbarn.configuration.bb_replay.ApplicationConfiguration.backend:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_pkg_proto_configuration_bb_replay_bb_replay_proto_init() }
+func file_pkg_proto_configuration_bb_replay_bb_replay_proto_init() {
+	if File_pkg_proto_configuration_bb_replay_bb_replay_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pkg_proto_configuration_bb_replay_bb_replay_proto_goTypes,
+		DependencyIndexes: file_pkg_proto_configuration_bb_replay_bb_replay_proto_depIdxs,
+		MessageInfos:      file_pkg_proto_configuration_bb_replay_bb_replay_proto_msgTypes,
+	}.Build()
+	File_pkg_proto_configuration_bb_replay_bb_replay_proto = out.File
+	file_pkg_proto_configuration_bb_replay_bb_replay_proto_rawDesc = nil
+	file_pkg_proto_configuration_bb_replay_bb_replay_proto_goTypes = nil
+	file_pkg_proto_configuration_bb_replay_bb_replay_proto_depIdxs = nil
+}
@@ -12,6 +12,7 @@ import (
 	grpc "github.com/buildbarn/bb-storage/pkg/proto/configuration/grpc"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -28,12 +29,13 @@ type ApplicationConfiguration struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GrpcServers             []*grpc.ServerConfiguration            `protobuf:"bytes,2,rep,name=grpc_servers,json=grpcServers,proto3" json:"grpc_servers,omitempty"`
-	Source                  *blobstore.BlobAccessConfiguration     `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Sink                    *blobstore.BlobAccessConfiguration     `protobuf:"bytes,4,opt,name=sink,proto3" json:"sink,omitempty"`
-	Replicator              *blobstore.BlobReplicatorConfiguration `protobuf:"bytes,5,opt,name=replicator,proto3" json:"replicator,omitempty"`
-	MaximumMessageSizeBytes int64                                  `protobuf:"varint,6,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
-	Global                  *global.Configuration                  `protobuf:"bytes,7,opt,name=global,proto3" json:"global,omitempty"`
+	GrpcServers               []*grpc.ServerConfiguration             `protobuf:"bytes,2,rep,name=grpc_servers,json=grpcServers,proto3" json:"grpc_servers,omitempty"`
+	Source                    *blobstore.BlobAccessConfiguration      `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Sink                      *blobstore.BlobAccessConfiguration      `protobuf:"bytes,4,opt,name=sink,proto3" json:"sink,omitempty"`
+	Replicator                *blobstore.BlobReplicatorConfiguration  `protobuf:"bytes,5,opt,name=replicator,proto3" json:"replicator,omitempty"`
+	MaximumMessageSizeBytes   int64                                   `protobuf:"varint,6,opt,name=maximum_message_size_bytes,json=maximumMessageSizeBytes,proto3" json:"maximum_message_size_bytes,omitempty"`
+	Global                    *global.Configuration                   `protobuf:"bytes,7,opt,name=global,proto3" json:"global,omitempty"`
+	ContinuousSynchronization *ContinuousSynchronizationConfiguration `protobuf:"bytes,8,opt,name=continuous_synchronization,json=continuousSynchronization,proto3" json:"continuous_synchronization,omitempty"`
 }
 
 func (x *ApplicationConfiguration) Reset() {
@@ -108,6 +110,82 @@ func (x *ApplicationConfiguration) GetGlobal() *global.Configuration {
 	return nil
 }
 
+func (x *ApplicationConfiguration) GetContinuousSynchronization() *ContinuousSynchronizationConfiguration {
+	if x != nil {
+		return x.ContinuousSynchronization
+	}
+	return nil
+}
+
+type ContinuousSynchronizationConfiguration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DigestListPath     string               `protobuf:"bytes,1,opt,name=digest_list_path,json=digestListPath,proto3" json:"digest_list_path,omitempty"`
+	BatchSize          int32                `protobuf:"varint,2,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	MaximumConcurrency int32                `protobuf:"varint,3,opt,name=maximum_concurrency,json=maximumConcurrency,proto3" json:"maximum_concurrency,omitempty"`
+	ScanInterval       *durationpb.Duration `protobuf:"bytes,4,opt,name=scan_interval,json=scanInterval,proto3" json:"scan_interval,omitempty"`
+}
+
+func (x *ContinuousSynchronizationConfiguration) Reset() {
+	*x = ContinuousSynchronizationConfiguration{}
+	mi := &file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContinuousSynchronizationConfiguration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContinuousSynchronizationConfiguration) ProtoMessage() {}
+
+func (x *ContinuousSynchronizationConfiguration) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContinuousSynchronizationConfiguration.ProtoReflect.Descriptor instead.
+func (*ContinuousSynchronizationConfiguration) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ContinuousSynchronizationConfiguration) GetDigestListPath() string {
+	if x != nil {
+		return x.DigestListPath
+	}
+	return ""
+}
+
+func (x *ContinuousSynchronizationConfiguration) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ContinuousSynchronizationConfiguration) GetMaximumConcurrency() int32 {
+	if x != nil {
+		return x.MaximumConcurrency
+	}
+	return 0
+}
+
+func (x *ContinuousSynchronizationConfiguration) GetScanInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ScanInterval
+	}
+	return nil
+}
+
 var File_pkg_proto_configuration_bb_replicator_bb_replicator_proto protoreflect.FileDescriptor
 
 var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc = []byte{
@@ -117,7 +195,9 @@ var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc = []b
 	0x63, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x62, 0x75, 0x69,
 	0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x6f, 0x72, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x6f, 0x72, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x31, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2b, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -125,7 +205,7 @@ var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc = []b
 	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x03, 0x0a, 0x18,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x05, 0x0a, 0x18,
 	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x0c, 0x67, 0x72, 0x70, 0x63,
 	0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31,
@@ -157,12 +237,36 @@ var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc = []b
 	0x2d, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
 	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
-	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x42, 0x47, 0x5a, 0x45,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x8c, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x62, 0x62, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x6f, 0x75, 0x73, 0x53, 0x79,
+	0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x63, 0x6f, 0x6e, 0x74,
+	0x69, 0x6e, 0x75, 0x6f, 0x75, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0xe2, 0x01, 0x0a, 0x26,
+	0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x6f, 0x75, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x68, 0x72,
+	0x6f, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x2f, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x3e, 0x0a, 0x0d, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x63, 0x61, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x62, 0x62, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -177,25 +281,29 @@ func file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDescGZIP(
 	return file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDescData
 }
 
-var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_goTypes = []any{
-	(*ApplicationConfiguration)(nil),              // 0: buildbarn.configuration.bb_replicator.ApplicationConfiguration
-	(*grpc.ServerConfiguration)(nil),              // 1: buildbarn.configuration.grpc.ServerConfiguration
-	(*blobstore.BlobAccessConfiguration)(nil),     // 2: buildbarn.configuration.blobstore.BlobAccessConfiguration
-	(*blobstore.BlobReplicatorConfiguration)(nil), // 3: buildbarn.configuration.blobstore.BlobReplicatorConfiguration
-	(*global.Configuration)(nil),                  // 4: buildbarn.configuration.global.Configuration
+	(*ApplicationConfiguration)(nil),               // 0: buildbarn.configuration.bb_replicator.ApplicationConfiguration
+	(*ContinuousSynchronizationConfiguration)(nil), // 1: buildbarn.configuration.bb_replicator.ContinuousSynchronizationConfiguration
+	(*grpc.ServerConfiguration)(nil),               // 2: buildbarn.configuration.grpc.ServerConfiguration
+	(*blobstore.BlobAccessConfiguration)(nil),      // 3: buildbarn.configuration.blobstore.BlobAccessConfiguration
+	(*blobstore.BlobReplicatorConfiguration)(nil),  // 4: buildbarn.configuration.blobstore.BlobReplicatorConfiguration
+	(*global.Configuration)(nil),                   // 5: buildbarn.configuration.global.Configuration
+	(*durationpb.Duration)(nil),                    // 6: google.protobuf.Duration
 }
 var file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_depIdxs = []int32{
-	1, // 0: buildbarn.configuration.bb_replicator.ApplicationConfiguration.grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
-	2, // 1: buildbarn.configuration.bb_replicator.ApplicationConfiguration.source:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
-	2, // 2: buildbarn.configuration.bb_replicator.ApplicationConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
-	3, // 3: buildbarn.configuration.bb_replicator.ApplicationConfiguration.replicator:type_name -> buildbarn.configuration.blobstore.BlobReplicatorConfiguration
-	4, // 4: buildbarn.configuration.bb_replicator.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	2, // 0: buildbarn.configuration.bb_replicator.ApplicationConfiguration.grpc_servers:type_name -> buildbarn.configuration.grpc.ServerConfiguration
+	3, // 1: buildbarn.configuration.bb_replicator.ApplicationConfiguration.source:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	3, // 2: buildbarn.configuration.bb_replicator.ApplicationConfiguration.sink:type_name -> buildbarn.configuration.blobstore.BlobAccessConfiguration
+	4, // 3: buildbarn.configuration.bb_replicator.ApplicationConfiguration.replicator:type_name -> buildbarn.configuration.blobstore.BlobReplicatorConfiguration
+	5, // 4: buildbarn.configuration.bb_replicator.ApplicationConfiguration.global:type_name -> buildbarn.configuration.global.Configuration
+	1, // 5: buildbarn.configuration.bb_replicator.ApplicationConfiguration.continuous_synchronization:type_name -> buildbarn.configuration.bb_replicator.ContinuousSynchronizationConfiguration
+	6, // 6: buildbarn.configuration.bb_replicator.ContinuousSynchronizationConfiguration.scan_interval:type_name -> google.protobuf.Duration
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_init() }
@@ -209,7 +317,7 @@ func file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_proto_configuration_bb_replicator_bb_replicator_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
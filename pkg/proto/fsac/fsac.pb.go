@@ -263,7 +263,7 @@ var file_pkg_proto_fsac_fsac_proto_rawDesc = []byte{
 	0x2e, 0x66, 0x73, 0x61, 0x63, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
 	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x17, 0x66,
 	0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x32, 0x80, 0x02, 0x0a, 0x15, 0x46, 0x69, 0x6c, 0x65, 0x53,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x32, 0x9d, 0x03, 0x0a, 0x15, 0x46, 0x69, 0x6c, 0x65, 0x53,
 	0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65,
 	0x12, 0x78, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
 	0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x31,
@@ -279,11 +279,20 @@ var file_pkg_proto_fsac_fsac_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x41, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72,
-	0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x73, 0x61, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x46, 0x69,
+	0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x38, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x42,
+	0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62,
+	0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x73, 0x61, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -305,7 +314,9 @@ var file_pkg_proto_fsac_fsac_proto_goTypes = []any{
 	(*UpdateFileSystemAccessProfileRequest)(nil), // 2: buildbarn.fsac.UpdateFileSystemAccessProfileRequest
 	(v2.DigestFunction_Value)(0),                 // 3: build.bazel.remote.execution.v2.DigestFunction.Value
 	(*v2.Digest)(nil),                            // 4: build.bazel.remote.execution.v2.Digest
-	(*emptypb.Empty)(nil),                        // 5: google.protobuf.Empty
+	(*v2.FindMissingBlobsRequest)(nil),           // 5: build.bazel.remote.execution.v2.FindMissingBlobsRequest
+	(*emptypb.Empty)(nil),                        // 6: google.protobuf.Empty
+	(*v2.FindMissingBlobsResponse)(nil),          // 7: build.bazel.remote.execution.v2.FindMissingBlobsResponse
 }
 var file_pkg_proto_fsac_fsac_proto_depIdxs = []int32{
 	3, // 0: buildbarn.fsac.GetFileSystemAccessProfileRequest.digest_function:type_name -> build.bazel.remote.execution.v2.DigestFunction.Value
@@ -315,10 +326,12 @@ var file_pkg_proto_fsac_fsac_proto_depIdxs = []int32{
 	0, // 4: buildbarn.fsac.UpdateFileSystemAccessProfileRequest.file_system_access_profile:type_name -> buildbarn.fsac.FileSystemAccessProfile
 	1, // 5: buildbarn.fsac.FileSystemAccessCache.GetFileSystemAccessProfile:input_type -> buildbarn.fsac.GetFileSystemAccessProfileRequest
 	2, // 6: buildbarn.fsac.FileSystemAccessCache.UpdateFileSystemAccessProfile:input_type -> buildbarn.fsac.UpdateFileSystemAccessProfileRequest
-	0, // 7: buildbarn.fsac.FileSystemAccessCache.GetFileSystemAccessProfile:output_type -> buildbarn.fsac.FileSystemAccessProfile
-	5, // 8: buildbarn.fsac.FileSystemAccessCache.UpdateFileSystemAccessProfile:output_type -> google.protobuf.Empty
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
+	5, // 7: buildbarn.fsac.FileSystemAccessCache.FindMissingFileSystemAccessProfiles:input_type -> build.bazel.remote.execution.v2.FindMissingBlobsRequest
+	0, // 8: buildbarn.fsac.FileSystemAccessCache.GetFileSystemAccessProfile:output_type -> buildbarn.fsac.FileSystemAccessProfile
+	6, // 9: buildbarn.fsac.FileSystemAccessCache.UpdateFileSystemAccessProfile:output_type -> google.protobuf.Empty
+	7, // 10: buildbarn.fsac.FileSystemAccessCache.FindMissingFileSystemAccessProfiles:output_type -> build.bazel.remote.execution.v2.FindMissingBlobsResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
 	5, // [5:5] is the sub-list for extension type_name
 	5, // [5:5] is the sub-list for extension extendee
 	0, // [0:5] is the sub-list for field type_name
@@ -8,6 +8,7 @@ package fsac
 
 import (
 	context "context"
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	FileSystemAccessCache_GetFileSystemAccessProfile_FullMethodName    = "/buildbarn.fsac.FileSystemAccessCache/GetFileSystemAccessProfile"
-	FileSystemAccessCache_UpdateFileSystemAccessProfile_FullMethodName = "/buildbarn.fsac.FileSystemAccessCache/UpdateFileSystemAccessProfile"
+	FileSystemAccessCache_GetFileSystemAccessProfile_FullMethodName          = "/buildbarn.fsac.FileSystemAccessCache/GetFileSystemAccessProfile"
+	FileSystemAccessCache_UpdateFileSystemAccessProfile_FullMethodName       = "/buildbarn.fsac.FileSystemAccessCache/UpdateFileSystemAccessProfile"
+	FileSystemAccessCache_FindMissingFileSystemAccessProfiles_FullMethodName = "/buildbarn.fsac.FileSystemAccessCache/FindMissingFileSystemAccessProfiles"
 )
 
 // FileSystemAccessCacheClient is the client API for FileSystemAccessCache service.
@@ -30,6 +32,7 @@ const (
 type FileSystemAccessCacheClient interface {
 	GetFileSystemAccessProfile(ctx context.Context, in *GetFileSystemAccessProfileRequest, opts ...grpc.CallOption) (*FileSystemAccessProfile, error)
 	UpdateFileSystemAccessProfile(ctx context.Context, in *UpdateFileSystemAccessProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FindMissingFileSystemAccessProfiles(ctx context.Context, in *v2.FindMissingBlobsRequest, opts ...grpc.CallOption) (*v2.FindMissingBlobsResponse, error)
 }
 
 type fileSystemAccessCacheClient struct {
@@ -58,12 +61,22 @@ func (c *fileSystemAccessCacheClient) UpdateFileSystemAccessProfile(ctx context.
 	return out, nil
 }
 
+func (c *fileSystemAccessCacheClient) FindMissingFileSystemAccessProfiles(ctx context.Context, in *v2.FindMissingBlobsRequest, opts ...grpc.CallOption) (*v2.FindMissingBlobsResponse, error) {
+	out := new(v2.FindMissingBlobsResponse)
+	err := c.cc.Invoke(ctx, FileSystemAccessCache_FindMissingFileSystemAccessProfiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileSystemAccessCacheServer is the server API for FileSystemAccessCache service.
 // All implementations should embed UnimplementedFileSystemAccessCacheServer
 // for forward compatibility
 type FileSystemAccessCacheServer interface {
 	GetFileSystemAccessProfile(context.Context, *GetFileSystemAccessProfileRequest) (*FileSystemAccessProfile, error)
 	UpdateFileSystemAccessProfile(context.Context, *UpdateFileSystemAccessProfileRequest) (*emptypb.Empty, error)
+	FindMissingFileSystemAccessProfiles(context.Context, *v2.FindMissingBlobsRequest) (*v2.FindMissingBlobsResponse, error)
 }
 
 // UnimplementedFileSystemAccessCacheServer should be embedded to have forward compatible implementations.
@@ -76,6 +89,9 @@ func (UnimplementedFileSystemAccessCacheServer) GetFileSystemAccessProfile(conte
 func (UnimplementedFileSystemAccessCacheServer) UpdateFileSystemAccessProfile(context.Context, *UpdateFileSystemAccessProfileRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateFileSystemAccessProfile not implemented")
 }
+func (UnimplementedFileSystemAccessCacheServer) FindMissingFileSystemAccessProfiles(context.Context, *v2.FindMissingBlobsRequest) (*v2.FindMissingBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingFileSystemAccessProfiles not implemented")
+}
 
 // UnsafeFileSystemAccessCacheServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to FileSystemAccessCacheServer will
@@ -124,6 +140,24 @@ func _FileSystemAccessCache_UpdateFileSystemAccessProfile_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FileSystemAccessCache_FindMissingFileSystemAccessProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v2.FindMissingBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileSystemAccessCacheServer).FindMissingFileSystemAccessProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FileSystemAccessCache_FindMissingFileSystemAccessProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileSystemAccessCacheServer).FindMissingFileSystemAccessProfiles(ctx, req.(*v2.FindMissingBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FileSystemAccessCache_ServiceDesc is the grpc.ServiceDesc for FileSystemAccessCache service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -139,6 +173,10 @@ var FileSystemAccessCache_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateFileSystemAccessProfile",
 			Handler:    _FileSystemAccessCache_UpdateFileSystemAccessProfile_Handler,
 		},
+		{
+			MethodName: "FindMissingFileSystemAccessProfiles",
+			Handler:    _FileSystemAccessCache_FindMissingFileSystemAccessProfiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/fsac/fsac.proto",
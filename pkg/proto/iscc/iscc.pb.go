@@ -449,7 +449,7 @@ var file_pkg_proto_iscc_iscc_proto_rawDesc = []byte{
 	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x69, 0x67,
 	0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x61, 0x6c, 0x75,
 	0x65, 0x52, 0x0e, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x32, 0xfb, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x6e, 0x32, 0x96, 0x03, 0x0a, 0x15, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x69, 0x7a,
 	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x75, 0x0a, 0x19, 0x47,
 	0x65, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
 	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64,
@@ -464,11 +464,21 @@ var file_pkg_proto_iscc_iscc_proto_rawDesc = []byte{
 	0x73, 0x63, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
 	0x75, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x62, 0x61, 0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x63,
-	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x98, 0x01, 0x0a, 0x21, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x38, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61,
+	0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2e, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x2e, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x2e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f,
+	0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x61,
+	0x72, 0x6e, 0x2f, 0x62, 0x62, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x73, 0x63, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -490,12 +500,14 @@ var file_pkg_proto_iscc_iscc_proto_goTypes = []any{
 	(*PreviousExecutionStats)(nil),              // 2: buildbarn.iscc.PreviousExecutionStats
 	(*GetPreviousExecutionStatsRequest)(nil),    // 3: buildbarn.iscc.GetPreviousExecutionStatsRequest
 	(*UpdatePreviousExecutionStatsRequest)(nil), // 4: buildbarn.iscc.UpdatePreviousExecutionStatsRequest
-	nil,                           // 5: buildbarn.iscc.PreviousExecutionStats.SizeClassesEntry
-	(*emptypb.Empty)(nil),         // 6: google.protobuf.Empty
-	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
-	(*v2.Digest)(nil),             // 9: build.bazel.remote.execution.v2.Digest
-	(v2.DigestFunction_Value)(0),  // 10: build.bazel.remote.execution.v2.DigestFunction.Value
+	nil,                                 // 5: buildbarn.iscc.PreviousExecutionStats.SizeClassesEntry
+	(*emptypb.Empty)(nil),               // 6: google.protobuf.Empty
+	(*durationpb.Duration)(nil),         // 7: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 8: google.protobuf.Timestamp
+	(*v2.Digest)(nil),                   // 9: build.bazel.remote.execution.v2.Digest
+	(v2.DigestFunction_Value)(0),        // 10: build.bazel.remote.execution.v2.DigestFunction.Value
+	(*v2.FindMissingBlobsRequest)(nil),  // 11: build.bazel.remote.execution.v2.FindMissingBlobsRequest
+	(*v2.FindMissingBlobsResponse)(nil), // 12: build.bazel.remote.execution.v2.FindMissingBlobsResponse
 }
 var file_pkg_proto_iscc_iscc_proto_depIdxs = []int32{
 	6,  // 0: buildbarn.iscc.PreviousExecution.failed:type_name -> google.protobuf.Empty
@@ -512,10 +524,12 @@ var file_pkg_proto_iscc_iscc_proto_depIdxs = []int32{
 	1,  // 11: buildbarn.iscc.PreviousExecutionStats.SizeClassesEntry.value:type_name -> buildbarn.iscc.PerSizeClassStats
 	3,  // 12: buildbarn.iscc.InitialSizeClassCache.GetPreviousExecutionStats:input_type -> buildbarn.iscc.GetPreviousExecutionStatsRequest
 	4,  // 13: buildbarn.iscc.InitialSizeClassCache.UpdatePreviousExecutionStats:input_type -> buildbarn.iscc.UpdatePreviousExecutionStatsRequest
-	2,  // 14: buildbarn.iscc.InitialSizeClassCache.GetPreviousExecutionStats:output_type -> buildbarn.iscc.PreviousExecutionStats
-	6,  // 15: buildbarn.iscc.InitialSizeClassCache.UpdatePreviousExecutionStats:output_type -> google.protobuf.Empty
-	14, // [14:16] is the sub-list for method output_type
-	12, // [12:14] is the sub-list for method input_type
+	11, // 14: buildbarn.iscc.InitialSizeClassCache.FindMissingPreviousExecutionStats:input_type -> build.bazel.remote.execution.v2.FindMissingBlobsRequest
+	2,  // 15: buildbarn.iscc.InitialSizeClassCache.GetPreviousExecutionStats:output_type -> buildbarn.iscc.PreviousExecutionStats
+	6,  // 16: buildbarn.iscc.InitialSizeClassCache.UpdatePreviousExecutionStats:output_type -> google.protobuf.Empty
+	12, // 17: buildbarn.iscc.InitialSizeClassCache.FindMissingPreviousExecutionStats:output_type -> build.bazel.remote.execution.v2.FindMissingBlobsResponse
+	15, // [15:18] is the sub-list for method output_type
+	12, // [12:15] is the sub-list for method input_type
 	12, // [12:12] is the sub-list for extension type_name
 	12, // [12:12] is the sub-list for extension extendee
 	0,  // [0:12] is the sub-list for field type_name
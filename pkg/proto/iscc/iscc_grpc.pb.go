@@ -8,6 +8,7 @@ package iscc
 
 import (
 	context "context"
+	v2 "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,8 +21,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	InitialSizeClassCache_GetPreviousExecutionStats_FullMethodName    = "/buildbarn.iscc.InitialSizeClassCache/GetPreviousExecutionStats"
-	InitialSizeClassCache_UpdatePreviousExecutionStats_FullMethodName = "/buildbarn.iscc.InitialSizeClassCache/UpdatePreviousExecutionStats"
+	InitialSizeClassCache_GetPreviousExecutionStats_FullMethodName         = "/buildbarn.iscc.InitialSizeClassCache/GetPreviousExecutionStats"
+	InitialSizeClassCache_UpdatePreviousExecutionStats_FullMethodName      = "/buildbarn.iscc.InitialSizeClassCache/UpdatePreviousExecutionStats"
+	InitialSizeClassCache_FindMissingPreviousExecutionStats_FullMethodName = "/buildbarn.iscc.InitialSizeClassCache/FindMissingPreviousExecutionStats"
 )
 
 // InitialSizeClassCacheClient is the client API for InitialSizeClassCache service.
@@ -30,6 +32,7 @@ const (
 type InitialSizeClassCacheClient interface {
 	GetPreviousExecutionStats(ctx context.Context, in *GetPreviousExecutionStatsRequest, opts ...grpc.CallOption) (*PreviousExecutionStats, error)
 	UpdatePreviousExecutionStats(ctx context.Context, in *UpdatePreviousExecutionStatsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FindMissingPreviousExecutionStats(ctx context.Context, in *v2.FindMissingBlobsRequest, opts ...grpc.CallOption) (*v2.FindMissingBlobsResponse, error)
 }
 
 type initialSizeClassCacheClient struct {
@@ -58,12 +61,22 @@ func (c *initialSizeClassCacheClient) UpdatePreviousExecutionStats(ctx context.C
 	return out, nil
 }
 
+func (c *initialSizeClassCacheClient) FindMissingPreviousExecutionStats(ctx context.Context, in *v2.FindMissingBlobsRequest, opts ...grpc.CallOption) (*v2.FindMissingBlobsResponse, error) {
+	out := new(v2.FindMissingBlobsResponse)
+	err := c.cc.Invoke(ctx, InitialSizeClassCache_FindMissingPreviousExecutionStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InitialSizeClassCacheServer is the server API for InitialSizeClassCache service.
 // All implementations should embed UnimplementedInitialSizeClassCacheServer
 // for forward compatibility
 type InitialSizeClassCacheServer interface {
 	GetPreviousExecutionStats(context.Context, *GetPreviousExecutionStatsRequest) (*PreviousExecutionStats, error)
 	UpdatePreviousExecutionStats(context.Context, *UpdatePreviousExecutionStatsRequest) (*emptypb.Empty, error)
+	FindMissingPreviousExecutionStats(context.Context, *v2.FindMissingBlobsRequest) (*v2.FindMissingBlobsResponse, error)
 }
 
 // UnimplementedInitialSizeClassCacheServer should be embedded to have forward compatible implementations.
@@ -76,6 +89,9 @@ func (UnimplementedInitialSizeClassCacheServer) GetPreviousExecutionStats(contex
 func (UnimplementedInitialSizeClassCacheServer) UpdatePreviousExecutionStats(context.Context, *UpdatePreviousExecutionStatsRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreviousExecutionStats not implemented")
 }
+func (UnimplementedInitialSizeClassCacheServer) FindMissingPreviousExecutionStats(context.Context, *v2.FindMissingBlobsRequest) (*v2.FindMissingBlobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingPreviousExecutionStats not implemented")
+}
 
 // UnsafeInitialSizeClassCacheServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to InitialSizeClassCacheServer will
@@ -124,6 +140,24 @@ func _InitialSizeClassCache_UpdatePreviousExecutionStats_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InitialSizeClassCache_FindMissingPreviousExecutionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v2.FindMissingBlobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InitialSizeClassCacheServer).FindMissingPreviousExecutionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InitialSizeClassCache_FindMissingPreviousExecutionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InitialSizeClassCacheServer).FindMissingPreviousExecutionStats(ctx, req.(*v2.FindMissingBlobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InitialSizeClassCache_ServiceDesc is the grpc.ServiceDesc for InitialSizeClassCache service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -139,6 +173,10 @@ var InitialSizeClassCache_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePreviousExecutionStats",
 			Handler:    _InitialSizeClassCache_UpdatePreviousExecutionStats_Handler,
 		},
+		{
+			MethodName: "FindMissingPreviousExecutionStats",
+			Handler:    _InitialSizeClassCache_FindMissingPreviousExecutionStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/iscc/iscc.proto",
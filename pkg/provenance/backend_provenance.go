@@ -0,0 +1,60 @@
+package provenance
+
+import (
+	"context"
+	"sync"
+)
+
+// BackendProvenance is used to accumulate the names of storage
+// backends that served reads as part of an RPC. Instances are attached
+// to the Context object of an RPC by the gRPC backend provenance
+// interceptors, and are filled by decorators for BlobAccess.
+//
+// This type is placed in a separate package, so that storage backends
+// can record their names without depending on the gRPC server code.
+type BackendProvenance struct {
+	lock     sync.Mutex
+	backends []string
+}
+
+type backendProvenanceKey struct{}
+
+// NewContextWithBackendProvenance attaches a new BackendProvenance
+// object to a Context, so that the names of storage backends that
+// served reads can be accumulated.
+func NewContextWithBackendProvenance(ctx context.Context) (context.Context, *BackendProvenance) {
+	backendProvenance := &BackendProvenance{}
+	return context.WithValue(ctx, backendProvenanceKey{}, backendProvenance), backendProvenance
+}
+
+// BackendProvenanceFromContext returns the BackendProvenance object
+// that is attached to a Context. This function returns nil if the
+// backend provenance interceptors are not enabled.
+func BackendProvenanceFromContext(ctx context.Context) *BackendProvenance {
+	if backendProvenance, ok := ctx.Value(backendProvenanceKey{}).(*BackendProvenance); ok {
+		return backendProvenance
+	}
+	return nil
+}
+
+// AddBackend records that a storage backend served a read as part of
+// the RPC. Backends that were already recorded are ignored.
+func (bp *BackendProvenance) AddBackend(name string) {
+	bp.lock.Lock()
+	defer bp.lock.Unlock()
+	for _, backend := range bp.backends {
+		if backend == name {
+			return
+		}
+	}
+	bp.backends = append(bp.backends, name)
+}
+
+// GetBackends returns the names of the storage backends that served
+// reads as part of the RPC, in the order in which they were first
+// recorded.
+func (bp *BackendProvenance) GetBackends() []string {
+	bp.lock.Lock()
+	defer bp.lock.Unlock()
+	return append([]string(nil), bp.backends...)
+}
@@ -0,0 +1,57 @@
+package readiness
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	checksLock sync.Mutex
+	checks     = map[string]func() error{}
+)
+
+// RegisterCheck registers a function that is called to determine
+// whether the program is ready to receive traffic. The results of all
+// registered checks are exposed by the diagnostics HTTP server under
+// /-/ready and by the health checking service of gRPC servers. This can
+// be used by load balancers to stop routing requests to instances that
+// are degraded, while liveness continues to be reported through
+// /-/healthy.
+func RegisterCheck(name string, check func() error) error {
+	checksLock.Lock()
+	defer checksLock.Unlock()
+
+	if _, ok := checks[name]; ok {
+		return status.Errorf(codes.AlreadyExists, "A readiness check with name %#v has already been registered", name)
+	}
+	checks[name] = check
+	return nil
+}
+
+// Check calls all registered readiness checks in order of name. The
+// first error returned by any of them is returned.
+func Check() error {
+	checksLock.Lock()
+	names := make([]string, 0, len(checks))
+	for name := range checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	checksToCall := make([]func() error, 0, len(names))
+	for _, name := range names {
+		checksToCall = append(checksToCall, checks[name])
+	}
+	checksLock.Unlock()
+
+	for i, check := range checksToCall {
+		if err := check(); err != nil {
+			return util.StatusWrapf(err, "Readiness check %#v", names[i])
+		}
+	}
+	return nil
+}
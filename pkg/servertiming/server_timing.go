@@ -0,0 +1,50 @@
+package servertiming
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// ServerTiming is used to accumulate the amount of time an RPC spent
+// waiting for storage backends. Instances are attached to the Context
+// object of an RPC by the gRPC server timing interceptors, and are
+// filled by decorators for BlobAccess.
+//
+// This type is placed in a separate package, so that storage backends
+// can report their timing without depending on the gRPC server code.
+type ServerTiming struct {
+	backendNanoseconds atomic.Int64
+}
+
+type serverTimingKey struct{}
+
+// NewContextWithServerTiming attaches a new ServerTiming object to a
+// Context, so that the time spent waiting for storage backends can be
+// accumulated.
+func NewContextWithServerTiming(ctx context.Context) (context.Context, *ServerTiming) {
+	serverTiming := &ServerTiming{}
+	return context.WithValue(ctx, serverTimingKey{}, serverTiming), serverTiming
+}
+
+// ServerTimingFromContext returns the ServerTiming object that is
+// attached to a Context. This function returns nil if the server
+// timing interceptors are not enabled.
+func ServerTimingFromContext(ctx context.Context) *ServerTiming {
+	if serverTiming, ok := ctx.Value(serverTimingKey{}).(*ServerTiming); ok {
+		return serverTiming
+	}
+	return nil
+}
+
+// AddBackendDuration increases the amount of time the RPC spent
+// waiting for storage backends.
+func (st *ServerTiming) AddBackendDuration(d time.Duration) {
+	st.backendNanoseconds.Add(int64(d))
+}
+
+// GetBackendDuration returns the amount of time the RPC spent waiting
+// for storage backends.
+func (st *ServerTiming) GetBackendDuration() time.Duration {
+	return time.Duration(st.backendNanoseconds.Load())
+}
@@ -1,11 +1,15 @@
 package util
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/google/go-jsonnet"
+	"github.com/google/go-jsonnet/ast"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -13,8 +17,38 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+var environmentVariableReferencePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// expandEnvironmentVariables replaces all ${NAME} references in a
+// string with the value of the corresponding environment variable.
+// Expansion fails if one of the referenced environment variables is
+// not set. Plain errors are returned, as Jsonnet embeds them into
+// errors of its own.
+func expandEnvironmentVariables(input string) (string, error) {
+	var err error
+	output := environmentVariableReferencePattern.ReplaceAllStringFunc(input, func(reference string) string {
+		name := environmentVariableReferencePattern.FindStringSubmatch(reference)[1]
+		value, ok := os.LookupEnv(name)
+		if !ok && err == nil {
+			err = fmt.Errorf("Environment variable %#v referenced by the configuration is not set", name)
+		}
+		return value
+	})
+	if err != nil {
+		return "", err
+	}
+	return output, nil
+}
+
 // UnmarshalConfigurationFromFile reads a Jsonnet file, evaluates it and
 // unmarshals the output into a Protobuf message.
+//
+// Environment variables are made available to the configuration in two
+// ways. First, they can be obtained through std.extVar(). Second,
+// strings containing ${NAME} references can be expanded explicitly by
+// calling std.native('expandEnvironmentVariables'). Strings that are
+// not passed to this function are left untouched, meaning that
+// expansion is disabled by default.
 func UnmarshalConfigurationFromFile(path string, configuration proto.Message) error {
 	// Read configuration file from disk or from stdin.
 	var jsonnetInput []byte
@@ -38,6 +72,17 @@ func UnmarshalConfigurationFromFile(path string, configuration proto.Message) er
 		}
 		vm.ExtVar(parts[0], parts[1])
 	}
+	vm.NativeFunction(&jsonnet.NativeFunction{
+		Name:   "expandEnvironmentVariables",
+		Params: ast.Identifiers{"input"},
+		Func: func(args []interface{}) (interface{}, error) {
+			input, ok := args[0].(string)
+			if !ok {
+				return nil, errors.New("Input must be a string")
+			}
+			return expandEnvironmentVariables(input)
+		},
+	})
 
 	jsonnetOutput, err := vm.EvaluateSnippet(path, string(jsonnetInput))
 	if err != nil {
@@ -0,0 +1,58 @@
+package util_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildbarn/bb-storage/pkg/util"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestUnmarshalConfigurationFromFile(t *testing.T) {
+	writeConfiguration := func(t *testing.T, contents string) string {
+		path := filepath.Join(t.TempDir(), "config.jsonnet")
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+		return path
+	}
+
+	t.Setenv("BB_STORAGE_TEST_HOST", "storage.example.com")
+	t.Setenv("BB_STORAGE_TEST_PORT", "8980")
+
+	t.Run("ExpansionDisabledByDefault", func(t *testing.T) {
+		// Strings containing references to environment
+		// variables should be left untouched, unless expansion
+		// is requested explicitly.
+		var configuration wrapperspb.StringValue
+		require.NoError(t, util.UnmarshalConfigurationFromFile(
+			writeConfiguration(t, `'${BB_STORAGE_TEST_HOST}:${BB_STORAGE_TEST_PORT}'`),
+			&configuration))
+		require.Equal(t, "${BB_STORAGE_TEST_HOST}:${BB_STORAGE_TEST_PORT}", configuration.Value)
+	})
+
+	t.Run("ExtVar", func(t *testing.T) {
+		var configuration wrapperspb.StringValue
+		require.NoError(t, util.UnmarshalConfigurationFromFile(
+			writeConfiguration(t, `std.extVar('BB_STORAGE_TEST_HOST')`),
+			&configuration))
+		require.Equal(t, "storage.example.com", configuration.Value)
+	})
+
+	t.Run("ExpansionSuccess", func(t *testing.T) {
+		var configuration wrapperspb.StringValue
+		require.NoError(t, util.UnmarshalConfigurationFromFile(
+			writeConfiguration(t, `std.native('expandEnvironmentVariables')('grpcs://${BB_STORAGE_TEST_HOST}:${BB_STORAGE_TEST_PORT}/$HOME')`),
+			&configuration))
+		require.Equal(t, "grpcs://storage.example.com:8980/$HOME", configuration.Value)
+	})
+
+	t.Run("ExpansionUnsetVariable", func(t *testing.T) {
+		var configuration wrapperspb.StringValue
+		err := util.UnmarshalConfigurationFromFile(
+			writeConfiguration(t, `std.native('expandEnvironmentVariables')('${BB_STORAGE_TEST_UNSET}')`),
+			&configuration)
+		require.ErrorContains(t, err, "Environment variable \"BB_STORAGE_TEST_UNSET\" referenced by the configuration is not set")
+	})
+}